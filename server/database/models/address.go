@@ -0,0 +1,133 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/go-openapi/swag"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+// AddressFields are the postal address fields shared by the address book entries and the order address snapshots
+type AddressFields struct {
+
+	// city
+	City string `json:"city,omitempty"`
+
+	// ISO 3166-1 alpha-2 country code
+	Country string `json:"country,omitempty"`
+
+	// line1
+	Line1 string `json:"line1,omitempty"`
+
+	// line2
+	Line2 string `json:"line2,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// phone
+	Phone string `json:"phone,omitempty"`
+
+	// postal code
+	PostalCode string `json:"postalCode,omitempty"`
+
+	// state, province or county code
+	Region string `json:"region,omitempty"`
+}
+
+type Address struct {
+	AddressFields
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// is default billing
+	IsDefaultBilling bool `json:"isDefaultBilling,omitempty"`
+
+	// is default shipping
+	IsDefaultShipping bool `json:"isDefaultShipping,omitempty"`
+
+	// Read Only: true
+	UserID int64 `json:"userId,omitempty"`
+	User   *User `bun:"rel:belongs-to,join:user_id=id"`
+}
+
+var _ bun.BeforeCreateTableHook = (*Address)(nil)
+
+func (m *Address) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("user_id") REFERENCES "users" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func NewAddressFieldsFrom(dto *models.Address) AddressFields {
+	if dto == nil {
+		return AddressFields{}
+	}
+	return AddressFields{
+		City:       swag.StringValue(dto.City),
+		Country:    swag.StringValue(dto.Country),
+		Line1:      swag.StringValue(dto.Line1),
+		Line2:      dto.Line2,
+		Name:       swag.StringValue(dto.Name),
+		Phone:      dto.Phone,
+		PostalCode: dto.PostalCode,
+		Region:     dto.Region,
+	}
+}
+
+// IsEmpty checks whether the address is not set, e. g., in the orders placed before addresses were introduced
+func (m AddressFields) IsEmpty() bool {
+	return m.Line1 == "" && m.City == "" && m.Country == ""
+}
+
+// ToDTO returns nil for empty addresses
+func (m AddressFields) ToDTO() *models.Address {
+	if m.IsEmpty() {
+		return nil
+	}
+	return &models.Address{
+		City:       swag.String(m.City),
+		Country:    swag.String(m.Country),
+		Line1:      swag.String(m.Line1),
+		Line2:      m.Line2,
+		Name:       swag.String(m.Name),
+		Phone:      m.Phone,
+		PostalCode: m.PostalCode,
+		Region:     m.Region,
+	}
+}
+
+func NewAddressFrom(dto *models.Address) *Address {
+	return &Address{
+		AddressFields:     NewAddressFieldsFrom(dto),
+		DateCreated:       dto.DateCreated,
+		DateUpdated:       dto.DateUpdated,
+		ID:                dto.ID,
+		IsDefaultBilling:  dto.IsDefaultBilling,
+		IsDefaultShipping: dto.IsDefaultShipping,
+		UserID:            dto.UserID,
+	}
+}
+
+func (m *Address) ToDTO() *models.Address {
+	result := m.AddressFields.ToDTO()
+	if result == nil {
+		result = &models.Address{}
+	}
+	result.DateCreated = m.DateCreated
+	result.DateUpdated = m.DateUpdated
+	result.ID = m.ID
+	result.IsDefaultBilling = m.IsDefaultBilling
+	result.IsDefaultShipping = m.IsDefaultShipping
+	result.UserID = m.UserID
+	return result
+}
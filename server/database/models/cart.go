@@ -0,0 +1,74 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+type Cart struct {
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// ISO 4217 code of the store currency the cart is priced in; empty for the base one
+	Currency string `json:"currency,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// items
+	Items []*CartItem `json:"items" bun:"rel:has-many,join:id=cart_id"`
+
+	// user Id; zero for anonymous carts
+	UserID int64 `json:"userId,omitempty"`
+}
+
+type CartItem struct {
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	CartID int64 `json:"cartId,omitempty"`
+	Cart   *Cart `bun:"rel:belongs-to,join:cart_id=id"`
+
+	// product Id
+	// Required: true
+	ProductID int64 `json:"productId"`
+
+	// quantity
+	// Required: true
+	Quantity int64 `json:"quantity"`
+}
+
+var _ bun.BeforeCreateTableHook = (*CartItem)(nil)
+
+func (m *CartItem) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("cart_id") REFERENCES "carts" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func CartItemsFromCartItemDTOs(items []*models.CartItem) []*CartItem {
+	if items == nil {
+		return nil
+	}
+	result := make([]*CartItem, len(items))
+	for i, item := range items {
+		result[i] = &CartItem{
+			ProductID: *item.ProductID,
+			Quantity:  *item.Quantity,
+		}
+	}
+	return result
+}
+
+func (m *CartItem) ToDTO() *models.CartItem {
+	return &models.CartItem{
+		ProductID: &m.ProductID,
+		Quantity:  &m.Quantity,
+	}
+}
@@ -18,6 +18,9 @@ type Category struct {
 	// Required: true
 	// Min Length: 1
 	Title *string `json:"title"`
+
+	// version increased on every change, returned as the ETag
+	Version int64 `json:"-"`
 }
 
 func NewCategoryFrom(dto *models.Category) *Category {
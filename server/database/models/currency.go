@@ -0,0 +1,52 @@
+package models
+
+import (
+	"estore-backend/server/models"
+)
+
+// ExchangeRate converts the base currency prices to another store currency
+type ExchangeRate struct {
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// ISO 4217 code of the currency
+	// Required: true
+	Currency *string `json:"currency" bun:",unique"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// minor units the converted prices end with
+	PriceEnding int64 `json:"priceEnding,omitempty"`
+
+	// units of the currency a unit of the base currency is worth
+	// Required: true
+	Rate *float64 `json:"rate"`
+
+	// minor units the converted prices are rounded to
+	RoundingIncrement int64 `json:"roundingIncrement,omitempty"`
+
+	// rounding mode of the converted prices
+	RoundingMode string `json:"roundingMode,omitempty"`
+}
+
+func NewExchangeRateFrom(dto *models.ExchangeRate) *ExchangeRate {
+	return &ExchangeRate{
+		Currency:          dto.Currency,
+		PriceEnding:       dto.PriceEnding,
+		Rate:              dto.Rate,
+		RoundingIncrement: dto.RoundingIncrement,
+		RoundingMode:      dto.RoundingMode,
+	}
+}
+
+func (m *ExchangeRate) ToDTO() *models.ExchangeRate {
+	return &models.ExchangeRate{
+		Currency:          m.Currency,
+		DateUpdated:       m.DateUpdated,
+		PriceEnding:       m.PriceEnding,
+		Rate:              m.Rate,
+		RoundingIncrement: m.RoundingIncrement,
+		RoundingMode:      m.RoundingMode,
+	}
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+// Invoice is an invoice or a credit note issued for an order; the rendered PDF is kept as issued
+type Invoice struct {
+
+	// amount
+	// Read Only: true
+	Amount int64 `json:"amount,omitempty" bun:"amount_minor"`
+
+	// ISO 4217 code of the currency of the order
+	// Read Only: true
+	Currency string `json:"currency,omitempty"`
+
+	// date issued
+	// Read Only: true
+	DateIssued int64 `json:"dateIssued,omitempty"`
+
+	// rendered PDF document
+	Document []byte `json:"-"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// kind
+	// Read Only: true
+	Kind string `json:"kind,omitempty" bun:",unique:kind_year_sequence"`
+
+	// number
+	// Read Only: true
+	Number string `json:"number,omitempty"`
+
+	// Read Only: true
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	// Read Only: true
+	ReturnID int64 `json:"returnId,omitempty"`
+
+	// Read Only: true
+	RefundID int64 `json:"refundId,omitempty"`
+
+	// sequence number within the kind and the year
+	Sequence int64 `json:"-" bun:",unique:kind_year_sequence"`
+
+	// tax total
+	// Read Only: true
+	TaxTotal int64 `json:"taxTotal,omitempty" bun:"tax_total_minor"`
+
+	// year of issue
+	Year int `json:"-" bun:",unique:kind_year_sequence"`
+}
+
+var _ bun.BeforeCreateTableHook = (*Invoice)(nil)
+
+func (m *Invoice) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func (m *Invoice) ToDTO() *models.Invoice {
+	return &models.Invoice{
+		Amount:     MoneyDTO(m.Amount, m.Currency),
+		DateIssued: m.DateIssued,
+		ID:         m.ID,
+		Kind:       m.Kind,
+		Number:     m.Number,
+		OrderID:    m.OrderID,
+		ReturnID:   m.ReturnID,
+		RefundID:   m.RefundID,
+		TaxTotal:   MoneyDTO(m.TaxTotal, m.Currency),
+	}
+}
+
+func InvoiceDTOsFromInvoices(invoices []*Invoice) []*models.Invoice {
+	if invoices == nil {
+		return nil
+	}
+	result := make([]*models.Invoice, len(invoices))
+	for i, invoice := range invoices {
+		result[i] = invoice.ToDTO()
+	}
+	return result
+}
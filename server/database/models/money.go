@@ -0,0 +1,34 @@
+package models
+
+import (
+	"estore-backend/server/models"
+)
+
+// MoneyDTO returns the amount in the minor units of the currency as a money DTO
+func MoneyDTO(amount int64, currency string) *models.Money {
+	return &models.Money{Amount: &amount, Currency: &currency}
+}
+
+// MoneyAmount returns the amount of the money DTO in the minor units of its currency; zero if there is none
+func MoneyAmount(dto *models.Money) int64 {
+	if dto == nil || dto.Amount == nil {
+		return 0
+	}
+	return *dto.Amount
+}
+
+// MoneyCurrency returns the currency of the money DTO; an empty string if there is none
+func MoneyCurrency(dto *models.Money) string {
+	if dto == nil || dto.Currency == nil {
+		return ""
+	}
+	return *dto.Currency
+}
+
+// OptionalMoneyDTO returns the amount as a money DTO, nil if it is zero; used for the optional settings
+func OptionalMoneyDTO(amount int64, currency string) *models.Money {
+	if amount == 0 {
+		return nil
+	}
+	return MoneyDTO(amount, currency)
+}
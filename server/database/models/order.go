@@ -8,6 +8,12 @@ import (
 
 type Order struct {
 
+	// billing address snapshot
+	BillingAddress AddressFields `json:"billingAddress" bun:"embed:billing_"`
+
+	// coupon code
+	CouponCode string `json:"couponCode,omitempty"`
+
 	// date created
 	// Read Only: true
 	DateCreated int64 `json:"dateCreated,omitempty"`
@@ -15,29 +21,83 @@ type Order struct {
 	// date updated
 	DateUpdated int64 `json:"dateUpdated,omitempty"`
 
-	// delivery info
-	// Required: true
-	// Min Length: 10
-	DeliveryInfo *string `json:"deliveryInfo"`
+	// free-text delivery instructions
+	DeliveryInfo string `json:"deliveryInfo,omitempty"`
+
+	// ISO 3166-1 alpha-2 code of the delivery country
+	DeliveryCountry string `json:"deliveryCountry,omitempty"`
+
+	// delivery region code within the country
+	DeliveryRegion string `json:"deliveryRegion,omitempty"`
+
+	// ISO 4217 code of the currency of the order amounts
+	// Read Only: true
+	Currency string `json:"currency,omitempty"`
+
+	// discount total
+	// Read Only: true
+	DiscountTotal int64 `json:"discountTotal,omitempty" bun:"discount_total_minor"`
+
+	Discounts []*OrderDiscount `json:"discounts,omitempty" bun:"rel:has-many,join:id=order_id"`
 
 	// id
 	// Read Only: true
 	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
 
+	// prices include tax
+	// Read Only: true
+	PricesIncludeTax bool `json:"pricesIncludeTax,omitempty"`
+
 	// products
 	// Required: true
 	Products []*OrderedProduct `json:"products" bun:"rel:has-many,join:id=order_id"`
 
+	// refunded total
+	// Read Only: true
+	RefundedTotal int64 `json:"refundedTotal,omitempty" bun:"refunded_total_minor"`
+
+	Returns []*OrderReturn `json:"returns,omitempty" bun:"rel:has-many,join:id=order_id"`
+
+	// shipping address snapshot
+	ShippingAddress AddressFields `json:"shippingAddress" bun:"embed:shipping_"`
+
+	// shipping method Id
+	ShippingMethodID int64 `json:"shippingMethodId,omitempty"`
+
+	// shipping method name snapshot
+	// Read Only: true
+	ShippingMethodName string `json:"shippingMethodName,omitempty"`
+
+	// shipping price
+	// Read Only: true
+	ShippingPrice int64 `json:"shippingPrice,omitempty" bun:"shipping_price_minor"`
+
 	// status
 	Status string `json:"status,omitempty"`
 
+	StatusHistory []*OrderStatusHistory `json:"statusHistory,omitempty" bun:"rel:has-many,join:id=order_id"`
+
+	// tax total
+	// Read Only: true
+	TaxTotal int64 `json:"taxTotal,omitempty" bun:"tax_total_minor"`
+
+	Taxes []*OrderTaxLine `json:"taxes,omitempty" bun:"rel:has-many,join:id=order_id"`
+
+	// email of the customer who placed the order without an account
+	// Read Only: true
+	GuestEmail string `json:"guestEmail,omitempty"`
+
 	// total price
 	// Required: true
-	TotalPrice *float64 `json:"totalPrice"`
+	TotalPrice int64 `json:"totalPrice" bun:"total_price_minor"`
 
-	UserID int64
+	// guest orders have no user until they are claimed into an account
+	UserID int64 `bun:",nullzero"`
 
 	User *User `json:"user,omitempty" bun:"rel:belongs-to,join:user_id=id"`
+
+	// version increased on every change, returned as the ETag
+	Version int64 `json:"-"`
 }
 
 var _ bun.BeforeCreateTableHook = (*Order)(nil)
@@ -49,15 +109,20 @@ func (m *Order) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQue
 
 func NewOrderFrom(dto *models.Order) *Order {
 	return &Order{
-		DateCreated:  dto.DateCreated,
-		DateUpdated:  dto.DateUpdated,
-		DeliveryInfo: dto.DeliveryInfo,
-		ID:           dto.ID,
-		Products:     OrderedProductsFromOrderedProductDTOs(dto.Products),
-		Status:       dto.Status,
-		TotalPrice:   dto.TotalPrice,
-		UserID:       dto.UserID,
-		User:         &User{ID: dto.UserID},
+		BillingAddress:   NewAddressFieldsFrom(dto.BillingAddress),
+		CouponCode:       dto.CouponCode,
+		Currency:         MoneyCurrency(dto.TotalPrice),
+		DateCreated:      dto.DateCreated,
+		DateUpdated:      dto.DateUpdated,
+		DeliveryInfo:     dto.DeliveryInfo,
+		ID:               dto.ID,
+		Products:         OrderedProductsFromOrderedProductDTOs(dto.Products),
+		ShippingAddress:  NewAddressFieldsFrom(dto.ShippingAddress),
+		ShippingMethodID: dto.ShippingMethodID,
+		Status:           dto.Status,
+		TotalPrice:       MoneyAmount(dto.TotalPrice),
+		UserID:           dto.UserID,
+		User:             &User{ID: dto.UserID},
 	}
 }
 
@@ -74,24 +139,41 @@ func OrderedProductsFromOrderedProductDTOs(orderedProducts []*models.OrderedProd
 
 func (m *Order) ToDTO() *models.Order {
 	return &models.Order{
-		DateCreated:  m.DateCreated,
-		DateUpdated:  m.DateUpdated,
-		DeliveryInfo: m.DeliveryInfo,
-		ID:           m.ID,
-		Products:     OrderedProductsDTOsFromOrderedProducts(m.Products),
-		Status:       m.Status,
-		TotalPrice:   m.TotalPrice,
-		UserID:       m.UserID,
+		BillingAddress:     m.BillingAddress.ToDTO(),
+		CouponCode:         m.CouponCode,
+		DateCreated:        m.DateCreated,
+		DateUpdated:        m.DateUpdated,
+		DeliveryCountry:    m.DeliveryCountry,
+		DeliveryInfo:       m.DeliveryInfo,
+		DeliveryRegion:     m.DeliveryRegion,
+		DiscountTotal:      MoneyDTO(m.DiscountTotal, m.Currency),
+		Discounts:          OrderDiscountDTOsFromOrderDiscounts(m.Discounts, m.Currency),
+		GuestEmail:         m.GuestEmail,
+		ID:                 m.ID,
+		PricesIncludeTax:   &m.PricesIncludeTax,
+		Products:           OrderedProductsDTOsFromOrderedProducts(m.Products, m.Currency),
+		RefundedTotal:      MoneyDTO(m.RefundedTotal, m.Currency),
+		Returns:            OrderReturnDTOsFromOrderReturns(m.Returns),
+		ShippingAddress:    m.ShippingAddress.ToDTO(),
+		ShippingMethodID:   m.ShippingMethodID,
+		ShippingMethodName: m.ShippingMethodName,
+		ShippingPrice:      MoneyDTO(m.ShippingPrice, m.Currency),
+		Status:             m.Status,
+		StatusHistory:      OrderStatusHistoryDTOsFromOrderStatusHistory(m.StatusHistory),
+		TaxTotal:           MoneyDTO(m.TaxTotal, m.Currency),
+		Taxes:              OrderTaxLineDTOsFromOrderTaxLines(m.Taxes, m.Currency),
+		TotalPrice:         MoneyDTO(m.TotalPrice, m.Currency),
+		UserID:             m.UserID,
 	}
 }
 
-func OrderedProductsDTOsFromOrderedProducts(orderedProducts []*OrderedProduct) []*models.OrderedProduct {
+func OrderedProductsDTOsFromOrderedProducts(orderedProducts []*OrderedProduct, currency string) []*models.OrderedProduct {
 	if orderedProducts == nil {
 		return nil
 	}
 	result := make([]*models.OrderedProduct, len(orderedProducts))
 	for i, product := range orderedProducts {
-		result[i] = product.ToDTO()
+		result[i] = product.ToDTO(currency)
 	}
 	return result
 }
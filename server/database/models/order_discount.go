@@ -0,0 +1,64 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+// OrderDiscount is a discount line of an order; a snapshot of the promotion applied to the order
+type OrderDiscount struct {
+
+	// amount in the minor units of the order currency
+	Amount int64 `json:"amount,omitempty" bun:"amount_minor"`
+
+	// code
+	Code string `json:"code,omitempty"`
+
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// kind
+	Kind string `json:"kind,omitempty"`
+
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	// product Id; zero for the discounts of the whole order
+	ProductID int64 `json:"productId,omitempty"`
+
+	// promotion Id
+	PromotionID int64 `json:"promotionId,omitempty"`
+
+	// title
+	Title string `json:"title,omitempty"`
+}
+
+var _ bun.BeforeCreateTableHook = (*OrderDiscount)(nil)
+
+func (m *OrderDiscount) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+// ToDTO converts the discount line; its amount is in the currency of its order
+func (m *OrderDiscount) ToDTO(currency string) *models.OrderDiscount {
+	return &models.OrderDiscount{
+		Amount:      MoneyDTO(m.Amount, currency),
+		Code:        m.Code,
+		Kind:        m.Kind,
+		ProductID:   m.ProductID,
+		PromotionID: m.PromotionID,
+		Title:       m.Title,
+	}
+}
+
+func OrderDiscountDTOsFromOrderDiscounts(discounts []*OrderDiscount, currency string) []*models.OrderDiscount {
+	if discounts == nil {
+		return nil
+	}
+	result := make([]*models.OrderDiscount, len(discounts))
+	for i, discount := range discounts {
+		result[i] = discount.ToDTO(currency)
+	}
+	return result
+}
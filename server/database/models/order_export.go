@@ -0,0 +1,117 @@
+package models
+
+import (
+	"estore-backend/server/money"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OrderExportRow is an order flattened for the spreadsheets; the products are listed as text
+type OrderExportRow struct {
+	ID int64 `json:"id"`
+
+	DateCreated int64 `json:"dateCreated"`
+
+	Status string `json:"status"`
+
+	CustomerEmail string `json:"customerEmail"`
+
+	CustomerName string `json:"customerName"`
+
+	// products as "title x quantity" separated by semicolons
+	Products string `json:"products" bun:"-"`
+
+	ItemCount int64 `json:"itemCount" bun:"-"`
+
+	// ISO 4217 code of the currency of the amounts
+	Currency string `json:"currency"`
+
+	// amounts in the minor units of the currency
+	DiscountTotal int64 `json:"discountTotal" bun:"discount_total_minor"`
+
+	ShippingPrice int64 `json:"shippingPrice" bun:"shipping_price_minor"`
+
+	TaxTotal int64 `json:"taxTotal" bun:"tax_total_minor"`
+
+	TotalPrice int64 `json:"totalPrice" bun:"total_price_minor"`
+
+	RefundedTotal int64 `json:"refundedTotal" bun:"refunded_total_minor"`
+
+	// status of the latest payment
+	PaymentStatus string `json:"paymentStatus"`
+
+	ShippingMethodName string `json:"shippingMethodName"`
+
+	ShippingName string `json:"shippingName"`
+
+	ShippingLine1 string `json:"shippingLine1"`
+
+	ShippingLine2 string `json:"shippingLine2"`
+
+	ShippingCity string `json:"shippingCity"`
+
+	ShippingPostalCode string `json:"shippingPostalCode"`
+
+	ShippingRegion string `json:"shippingRegion"`
+
+	ShippingCountry string `json:"shippingCountry"`
+
+	ShippingPhone string `json:"shippingPhone"`
+
+	DeliveryInfo string `json:"deliveryInfo"`
+
+	// the ordered product of the joined row
+	ProductTitle string `json:"-"`
+
+	// the ordered quantity of the joined row
+	ProductQuantity int64 `json:"-"`
+}
+
+// OrderExportHeader lists the CSV columns in the order of OrderExportRow.CSVRecord
+var OrderExportHeader = []string{"id", "date_created", "status", "customer_email", "customer_name", "products",
+	"item_count", "currency", "discount_total", "shipping_price", "tax_total", "total_price", "refunded_total", "payment_status",
+	"shipping_method", "shipping_name", "shipping_line1", "shipping_line2", "shipping_city", "shipping_postal_code",
+	"shipping_region", "shipping_country", "shipping_phone", "delivery_info"}
+
+// CSVRecord formats the row for the spreadsheets: the dates in UTC and the money with the decimals of its currency;
+// the text entered by the customers and the admins is quoted so that the spreadsheets do not evaluate it as a formula
+func (m *OrderExportRow) CSVRecord() []string {
+	format := func(amount int64) string {
+		return money.Format(amount, m.Currency)
+	}
+	return []string{
+		strconv.FormatInt(m.ID, 10),
+		time.Unix(m.DateCreated, 0).In(time.UTC).Format("2006-01-02 15:04:05"),
+		m.Status,
+		spreadsheetText(m.CustomerEmail),
+		spreadsheetText(m.CustomerName),
+		spreadsheetText(m.Products),
+		strconv.FormatInt(m.ItemCount, 10),
+		m.Currency,
+		format(m.DiscountTotal),
+		format(m.ShippingPrice),
+		format(m.TaxTotal),
+		format(m.TotalPrice),
+		format(m.RefundedTotal),
+		m.PaymentStatus,
+		spreadsheetText(m.ShippingMethodName),
+		spreadsheetText(m.ShippingName),
+		spreadsheetText(m.ShippingLine1),
+		spreadsheetText(m.ShippingLine2),
+		spreadsheetText(m.ShippingCity),
+		spreadsheetText(m.ShippingPostalCode),
+		spreadsheetText(m.ShippingRegion),
+		spreadsheetText(m.ShippingCountry),
+		spreadsheetText(m.ShippingPhone),
+		spreadsheetText(m.DeliveryInfo),
+	}
+}
+
+// spreadsheetText prefixes the text starting like a formula with an apostrophe, which the spreadsheets do not show
+func spreadsheetText(text string) string {
+	if text != "" && strings.ContainsAny(text[:1], "=+-@\t\r") {
+		return "'" + text
+	}
+	return text
+}
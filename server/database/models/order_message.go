@@ -0,0 +1,145 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+// OrderMessage is a message of the order thread between the customer and the staff or an internal staff note
+type OrderMessage struct {
+
+	// attachments
+	Attachments []*OrderMessageAttachment `json:"attachments,omitempty" bun:"rel:has-many,join:id=message_id"`
+
+	// Read Only: true
+	AuthorID int64 `json:"authorId,omitempty"`
+
+	// author role
+	// Read Only: true
+	AuthorRole string `json:"authorRole,omitempty"`
+
+	// body
+	// Required: true
+	Body string `json:"body"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// when the message has been read by the customer
+	DateReadByCustomer int64 `json:"-"`
+
+	// when the message has been read by any of the staff
+	DateReadByStaff int64 `json:"-"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// internal notes are visible to admins only
+	Internal bool `json:"internal,omitempty"`
+
+	// Read Only: true
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+}
+
+// OrderMessageAttachment is a file attached to an order message
+type OrderMessageAttachment struct {
+
+	// content
+	Content []byte `json:"-"`
+
+	// content type
+	ContentType string `json:"contentType,omitempty"`
+
+	// file name
+	// Required: true
+	FileName string `json:"fileName"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	MessageID int64         `json:"messageId,omitempty"`
+	Message   *OrderMessage `bun:"rel:belongs-to,join:message_id=id"`
+
+	OrderID int64 `json:"orderId,omitempty"`
+
+	// size
+	// Read Only: true
+	Size int64 `json:"size,omitempty"`
+}
+
+var _ bun.BeforeCreateTableHook = (*OrderMessage)(nil)
+
+func (m *OrderMessage) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*OrderMessageAttachment)(nil)
+
+func (m *OrderMessageAttachment) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("message_id") REFERENCES "order_messages" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+// IsAuthoredBy checks whether the message comes from the side of the given role
+func (m *OrderMessage) IsAuthoredBy(role string) bool {
+	return m.AuthorRole == role
+}
+
+// ToDTO converts the message as it is seen by the side of the given role (models.OrderMessageAuthorRoleCustomer
+// or models.OrderMessageAuthorRoleAdmin): the own side's messages are read, the other side's ones are read
+// once the side has marked them
+func (m *OrderMessage) ToDTO(viewerRole string) *models.OrderMessage {
+	attachments := make([]*models.OrderMessageAttachment, len(m.Attachments))
+	for i, attachment := range m.Attachments {
+		attachments[i] = attachment.ToDTO()
+	}
+	dateRead := m.DateReadByCustomer
+	if m.IsAuthoredBy(models.OrderMessageAuthorRoleCustomer) {
+		dateRead = m.DateReadByStaff
+	}
+	read := m.IsAuthoredBy(viewerRole)
+	if !read && viewerRole == models.OrderMessageAuthorRoleAdmin {
+		read = m.DateReadByStaff > 0
+	}
+	if !read && viewerRole == models.OrderMessageAuthorRoleCustomer {
+		read = m.DateReadByCustomer > 0
+	}
+	return &models.OrderMessage{
+		Attachments: attachments,
+		AuthorID:    m.AuthorID,
+		AuthorRole:  m.AuthorRole,
+		Body:        &m.Body,
+		DateCreated: m.DateCreated,
+		DateRead:    dateRead,
+		ID:          m.ID,
+		Internal:    m.Internal,
+		OrderID:     m.OrderID,
+		Read:        &read,
+	}
+}
+
+func (m *OrderMessageAttachment) ToDTO() *models.OrderMessageAttachment {
+	return &models.OrderMessageAttachment{
+		ContentType: m.ContentType,
+		FileName:    &m.FileName,
+		ID:          m.ID,
+		Size:        m.Size,
+	}
+}
+
+func OrderMessageDTOsFromOrderMessages(messages []*OrderMessage, viewerRole string) []*models.OrderMessage {
+	if messages == nil {
+		return nil
+	}
+	result := make([]*models.OrderMessage, len(messages))
+	for i, message := range messages {
+		result[i] = message.ToDTO(viewerRole)
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+// OrderReturn is a return of order lines requested by the customer (RMA)
+type OrderReturn struct {
+
+	// customer comment
+	Comment string `json:"comment,omitempty"`
+
+	// ISO 4217 code of the currency of the order
+	// Read Only: true
+	Currency string `json:"currency,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// returned items
+	// Required: true
+	Items []*ReturnItem `json:"items"`
+
+	// Read Only: true
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	// payment the refund is issued for; zero for refunds settled outside the payment provider
+	PaymentID int64 `json:"paymentId,omitempty"`
+
+	// refund amount
+	// Read Only: true
+	RefundAmount int64 `json:"refundAmount,omitempty" bun:"refund_amount_minor"`
+
+	// payment provider refund ID
+	// Read Only: true
+	RefundID string `json:"refundId,omitempty"`
+
+	// admin comment on the decision or inspection
+	// Read Only: true
+	ResolutionComment string `json:"resolutionComment,omitempty"`
+
+	// return merchandise authorization number issued on approval
+	// Read Only: true
+	RmaNumber string `json:"rmaNumber,omitempty"`
+
+	// status
+	// Read Only: true
+	Status string `json:"status,omitempty"`
+
+	// Read Only: true
+	UserID int64 `json:"userId,omitempty"`
+}
+
+// ReturnItem is stored as a part of the return items JSON
+type ReturnItem struct {
+	ProductID         int64  `json:"productId"`
+	Quantity          int64  `json:"quantity"`
+	Reason            string `json:"reason"`
+	ReceivedQuantity  int64  `json:"receivedQuantity"`
+	RestockedQuantity int64  `json:"restockedQuantity"`
+}
+
+var _ bun.BeforeCreateTableHook = (*OrderReturn)(nil)
+
+func (m *OrderReturn) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func NewOrderReturnFrom(dto *models.OrderReturn) *OrderReturn {
+	var items []*ReturnItem
+	if dto.Items != nil {
+		items = make([]*ReturnItem, len(dto.Items))
+		for i, item := range dto.Items {
+			items[i] = &ReturnItem{ProductID: *item.ProductID, Quantity: *item.Quantity, Reason: *item.Reason}
+		}
+	}
+	return &OrderReturn{
+		Comment:           dto.Comment,
+		DateCreated:       dto.DateCreated,
+		DateUpdated:       dto.DateUpdated,
+		ID:                dto.ID,
+		Items:             items,
+		OrderID:           dto.OrderID,
+		RefundAmount:      MoneyAmount(dto.RefundAmount),
+		Currency:          MoneyCurrency(dto.RefundAmount),
+		RefundID:          dto.RefundID,
+		ResolutionComment: dto.ResolutionComment,
+		RmaNumber:         dto.RmaNumber,
+		Status:            dto.Status,
+		UserID:            dto.UserID,
+	}
+}
+
+func (m *OrderReturn) ToDTO() *models.OrderReturn {
+	items := make([]*models.ReturnItem, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = &models.ReturnItem{
+			ProductID:         &item.ProductID,
+			Quantity:          &item.Quantity,
+			Reason:            &item.Reason,
+			ReceivedQuantity:  item.ReceivedQuantity,
+			RestockedQuantity: item.RestockedQuantity,
+		}
+	}
+	return &models.OrderReturn{
+		Comment:           m.Comment,
+		DateCreated:       m.DateCreated,
+		DateUpdated:       m.DateUpdated,
+		ID:                m.ID,
+		Items:             items,
+		OrderID:           m.OrderID,
+		RefundAmount:      MoneyDTO(m.RefundAmount, m.Currency),
+		RefundID:          m.RefundID,
+		ResolutionComment: m.ResolutionComment,
+		RmaNumber:         m.RmaNumber,
+		Status:            m.Status,
+		UserID:            m.UserID,
+	}
+}
+
+func OrderReturnDTOsFromOrderReturns(returns []*OrderReturn) []*models.OrderReturn {
+	if returns == nil {
+		return nil
+	}
+	result := make([]*models.OrderReturn, len(returns))
+	for i, r := range returns {
+		result[i] = r.ToDTO()
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+type OrderStatusHistory struct {
+	bun.BaseModel `bun:"table:order_status_history"`
+
+	// actor Id; zero for system actors (e. g., payment webhooks)
+	ActorID int64 `json:"actorId,omitempty"`
+
+	// actor role: customer, admin or system
+	ActorRole string `json:"actorRole,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// from status; empty for the initial status of an order
+	FromStatus string `json:"fromStatus,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// Read Only: true
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	// reason
+	Reason string `json:"reason,omitempty"`
+
+	// to status
+	ToStatus string `json:"toStatus,omitempty"`
+}
+
+var _ bun.BeforeCreateTableHook = (*OrderStatusHistory)(nil)
+
+func (m *OrderStatusHistory) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func (m *OrderStatusHistory) ToDTO() *models.OrderStatusHistoryEntry {
+	return &models.OrderStatusHistoryEntry{
+		ActorID:     m.ActorID,
+		ActorRole:   m.ActorRole,
+		DateCreated: m.DateCreated,
+		FromStatus:  m.FromStatus,
+		ID:          m.ID,
+		OrderID:     m.OrderID,
+		Reason:      m.Reason,
+		ToStatus:    m.ToStatus,
+	}
+}
+
+func OrderStatusHistoryDTOsFromOrderStatusHistory(history []*OrderStatusHistory) []*models.OrderStatusHistoryEntry {
+	if history == nil {
+		return nil
+	}
+	result := make([]*models.OrderStatusHistoryEntry, len(history))
+	for i, entry := range history {
+		result[i] = entry.ToDTO()
+	}
+	return result
+}
@@ -27,9 +27,13 @@ type OrderedProduct struct {
 	// Required: true
 	Quantity *int64 `json:"quantity"`
 
-	// total price
+	// tax amount
+	// Read Only: true
+	TaxAmount int64 `json:"taxAmount,omitempty" bun:"tax_amount_minor"`
+
+	// total price in the minor units of the order currency
 	// Required: true
-	TotalPrice *float64 `json:"totalPrice"`
+	TotalPrice int64 `json:"totalPrice" bun:"total_price_minor"`
 }
 
 var _ bun.BeforeCreateTableHook = (*OrderedProduct)(nil)
@@ -45,17 +49,19 @@ func NewOrderedProductFrom(dto *models.OrderedProduct) *OrderedProduct {
 		OrderID:    dto.OrderID,
 		ProductID:  dto.ProductID,
 		Quantity:   dto.Quantity,
-		TotalPrice: dto.TotalPrice,
+		TotalPrice: MoneyAmount(dto.TotalPrice),
 	}
 }
 
-func (m *OrderedProduct) ToDTO() *models.OrderedProduct {
+// ToDTO converts the ordered product; its amounts are in the currency of its order
+func (m *OrderedProduct) ToDTO(currency string) *models.OrderedProduct {
 	return &models.OrderedProduct{
 		OrderID:     m.OrderID,
 		ProductID:   m.ProductID,
 		ProductName: m.ProductName,
 		InStock:     m.InStock,
 		Quantity:    m.Quantity,
-		TotalPrice:  m.TotalPrice,
+		TaxAmount:   MoneyDTO(m.TaxAmount, currency),
+		TotalPrice:  MoneyDTO(m.TotalPrice, currency),
 	}
 }
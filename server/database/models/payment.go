@@ -8,7 +8,10 @@ type Payment struct {
 
 	// amount
 	// Required: true
-	Amount *float64 `json:"amount"`
+	Amount int64 `json:"amount" bun:"amount_minor"`
+
+	// ISO 4217 code of the currency of the amounts
+	Currency string `json:"currency,omitempty"`
 
 	// date created
 	// Read Only: true
@@ -17,12 +20,27 @@ type Payment struct {
 	// date updated
 	DateUpdated int64 `json:"dateUpdated,omitempty"`
 
+	// reason of the last failed or canceled payment attempt as reported by the payment gateway
+	// Read Only: true
+	FailureCode string `json:"failureCode,omitempty"`
+
+	// message of the last failed payment attempt as reported by the payment gateway
+	// Read Only: true
+	FailureMessage string `json:"failureMessage,omitempty"`
+
 	// id
 	// Read Only: true
 	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
 
+	// how the payment is made; empty for the payments made online before the methods were recorded
+	Method string `json:"method,omitempty"`
+
 	OrderID int64
 
+	// refunded amount
+	// Read Only: true
+	RefundedAmount int64 `json:"refundedAmount,omitempty" bun:"refunded_amount_minor"`
+
 	Order *Order `json:"order,omitempty" bun:"rel:belongs-to,join:order_id=id"`
 
 	// status
@@ -32,31 +50,84 @@ type Payment struct {
 
 	User *User `json:"user,omitempty" bun:"rel:belongs-to,join:user_id=id"`
 
+	// payment gateway the payment has been made through; empty for the payments made through Stripe
+	// before the gateways were named
+	Gateway string `json:"gateway"`
+
 	CheckoutSessionID string `json:"checkout_session_id"`
 
 	PaymentIntentId string `json:"payment_intent_id"`
+
+	// time of the payment gateway event the status has been set from; the older events are ignored
+	StatusEventAt int64 `json:"-"`
+
+	// stock taken out for the order while the payment is open; released if the payment is canceled
+	ReservedStock []*StockReservation `json:"-"`
+
+	// reference the customer gives with the bank transfer
+	// Read Only: true
+	Reference string `json:"reference,omitempty"`
+
+	// how the customer pays the offline payment
+	// Read Only: true
+	Instructions string `json:"instructions,omitempty"`
+
+	// deadline of the bank transfer
+	// Read Only: true
+	DueAt int64 `json:"dueAt,omitempty"`
+}
+
+// StockReservation is stored as a part of the payment reserved stock JSON
+type StockReservation struct {
+	ProductID int64 `json:"productId"`
+	Quantity  int64 `json:"quantity"`
 }
 
 func NewPaymentFrom(dto *models.Payment) *Payment {
 	return &Payment{
-		Amount:      dto.Amount,
+		Amount:      MoneyAmount(dto.Amount),
+		Currency:    MoneyCurrency(dto.Amount),
 		DateCreated: dto.DateCreated,
 		DateUpdated: dto.DateUpdated,
 		ID:          dto.ID,
+		Method:      dto.Method,
+		OrderID:     *dto.OrderID,
 		Order:       &Order{ID: *dto.OrderID},
 		Status:      dto.Status,
+		UserID:      dto.UserID,
 		User:        &User{ID: dto.UserID},
 	}
 }
 
+// PaymentMethod returns the method of the payment; the payments without one have been made online
+func (m *Payment) PaymentMethod() string {
+	if m.Method == "" {
+		return models.PaymentMethodOnline
+	}
+	return m.Method
+}
+
 func (m *Payment) ToDTO() *models.Payment {
+	gateway := m.Gateway
+	if gateway == "" && m.PaymentMethod() == models.PaymentMethodOnline {
+		// the payments recorded before the gateways were named have been made through Stripe
+		gateway = "stripe"
+	}
 	return &models.Payment{
-		Amount:      m.Amount,
-		DateCreated: m.DateCreated,
-		DateUpdated: m.DateUpdated,
-		ID:          m.ID,
-		OrderID:     &m.Order.ID,
-		Status:      m.Status,
-		UserID:      m.User.ID,
+		Amount:         MoneyDTO(m.Amount, m.Currency),
+		DateCreated:    m.DateCreated,
+		DateUpdated:    m.DateUpdated,
+		DueAt:          m.DueAt,
+		FailureCode:    m.FailureCode,
+		FailureMessage: m.FailureMessage,
+		Gateway:        gateway,
+		ID:             m.ID,
+		Instructions:   m.Instructions,
+		Method:         m.PaymentMethod(),
+		OrderID:        &m.OrderID,
+		Reference:      m.Reference,
+		RefundedAmount: MoneyDTO(m.RefundedAmount, m.Currency),
+		Status:         m.Status,
+		UserID:         m.UserID,
 	}
 }
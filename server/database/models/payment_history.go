@@ -0,0 +1,64 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+)
+
+// PaymentHistory is a change of a payment made by an admin; the entries outlive the deleted payments
+type PaymentHistory struct {
+	bun.BaseModel `bun:"table:payment_history"`
+
+	// created, updated or deleted
+	Action string `json:"action,omitempty"`
+
+	// admin who has changed the payment
+	ActorID int64 `json:"actorId,omitempty"`
+
+	// changed properties with their previous and new values
+	Changes []*PaymentChange `json:"changes"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// Read Only: true
+	PaymentID int64 `json:"paymentId,omitempty"`
+}
+
+// PaymentChange is stored as a part of the payment history changes JSON
+type PaymentChange struct {
+	Property string `json:"property"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+}
+
+func (m *PaymentHistory) ToDTO() *models.PaymentHistoryEntry {
+	changes := make([]*models.PaymentChange, len(m.Changes))
+	for i, change := range m.Changes {
+		changes[i] = &models.PaymentChange{Property: change.Property, From: change.From, To: change.To}
+	}
+	return &models.PaymentHistoryEntry{
+		Action:      m.Action,
+		ActorID:     m.ActorID,
+		Changes:     changes,
+		DateCreated: m.DateCreated,
+		ID:          m.ID,
+		PaymentID:   m.PaymentID,
+	}
+}
+
+func PaymentHistoryDTOsFromPaymentHistory(history []*PaymentHistory) []*models.PaymentHistoryEntry {
+	if history == nil {
+		return nil
+	}
+	result := make([]*models.PaymentHistoryEntry, len(history))
+	for i, entry := range history {
+		result[i] = entry.ToDTO()
+	}
+	return result
+}
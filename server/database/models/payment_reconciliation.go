@@ -0,0 +1,108 @@
+package models
+
+import (
+	"estore-backend/server/models"
+)
+
+// PaymentReconciliation is a report of a reconciliation of the payments with their gateways
+type PaymentReconciliation struct {
+
+	// admin who has run the manual reconciliation
+	// Read Only: true
+	ActorID int64 `json:"actorId,omitempty"`
+
+	// payments checked against their gateways
+	// Read Only: true
+	Checked int64 `json:"checked,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date finished
+	// Read Only: true
+	DateFinished int64 `json:"dateFinished,omitempty"`
+
+	// payments differing from their gateways
+	// Read Only: true
+	Discrepancies []*PaymentDiscrepancy `json:"discrepancies"`
+
+	// payments which could not be checked
+	// Read Only: true
+	Errors int64 `json:"errors,omitempty"`
+
+	// stale checkout sessions expired
+	// Read Only: true
+	Expired int64 `json:"expired,omitempty"`
+
+	// payments whose status has been set to the one of the gateway
+	// Read Only: true
+	Fixed int64 `json:"fixed,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// scheduled or manual
+	// Read Only: true
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// PaymentDiscrepancy is stored as a part of the reconciliation discrepancies JSON
+type PaymentDiscrepancy struct {
+	PaymentID         int64  `json:"paymentId"`
+	OrderID           int64  `json:"orderId"`
+	Gateway           string `json:"gateway"`
+	CheckoutSessionID string `json:"checkoutSessionId"`
+	PaymentIntentID   string `json:"paymentIntentId,omitempty"`
+	Status            string `json:"status"`
+	GatewayStatus     string `json:"gatewayStatus,omitempty"`
+	Amount            int64  `json:"amount"`
+	Currency          string `json:"currency"`
+	GatewayAmount     int64  `json:"gatewayAmount,omitempty"`
+	GatewayCurrency   string `json:"gatewayCurrency,omitempty"`
+	Resolution        string `json:"resolution"`
+	Message           string `json:"message,omitempty"`
+}
+
+func (m *PaymentReconciliation) ToDTO() *models.PaymentReconciliation {
+	discrepancies := make([]*models.PaymentDiscrepancy, len(m.Discrepancies))
+	for i, d := range m.Discrepancies {
+		discrepancies[i] = &models.PaymentDiscrepancy{
+			Amount:            MoneyDTO(d.Amount, d.Currency),
+			CheckoutSessionID: d.CheckoutSessionID,
+			Gateway:           d.Gateway,
+			GatewayAmount:     OptionalMoneyDTO(d.GatewayAmount, d.GatewayCurrency),
+			GatewayStatus:     d.GatewayStatus,
+			Message:           d.Message,
+			OrderID:           d.OrderID,
+			PaymentID:         d.PaymentID,
+			PaymentIntentID:   d.PaymentIntentID,
+			Resolution:        d.Resolution,
+			Status:            d.Status,
+		}
+	}
+	return &models.PaymentReconciliation{
+		ActorID:       m.ActorID,
+		Checked:       m.Checked,
+		DateCreated:   m.DateCreated,
+		DateFinished:  m.DateFinished,
+		Discrepancies: discrepancies,
+		Errors:        m.Errors,
+		Expired:       m.Expired,
+		Fixed:         m.Fixed,
+		ID:            m.ID,
+		Trigger:       m.Trigger,
+	}
+}
+
+func PaymentReconciliationDTOsFromPaymentReconciliations(reconciliations []*PaymentReconciliation) []*models.PaymentReconciliation {
+	if reconciliations == nil {
+		return nil
+	}
+	result := make([]*models.PaymentReconciliation, len(reconciliations))
+	for i, r := range reconciliations {
+		result[i] = r.ToDTO()
+	}
+	return result
+}
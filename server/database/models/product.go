@@ -14,6 +14,9 @@ type Product struct {
 	// Min Length: 1
 	Description *string `json:"description"`
 
+	// height in centimeters
+	Height float64 `json:"height,omitempty"`
+
 	// id
 	// Read Only: true
 	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
@@ -21,27 +24,79 @@ type Product struct {
 	// images
 	Images []string `json:"images"`
 
+	// length in centimeters
+	Length float64 `json:"length,omitempty"`
+
 	// number in stock
 	NumberInStock int64 `json:"numberInStock,omitempty"`
 
-	// price
-	Price float64 `json:"price,omitempty"`
+	// price in the minor units of the currency
+	Price int64 `json:"price,omitempty" bun:"price_minor"`
+
+	// ISO 4217 currency code of the price
+	Currency string `json:"currency,omitempty"`
+
+	// prices set by hand in the other store currencies
+	Prices []*ProductPrice `json:"prices"`
+
+	// tax class; empty for the standard class
+	TaxClass string `json:"taxClass,omitempty"`
 
 	// title
 	// Required: true
 	// Min Length: 1
 	Title *string `json:"title"`
+
+	// weight in kilograms
+	Weight float64 `json:"weight,omitempty"`
+
+	// width in centimeters
+	Width float64 `json:"width,omitempty"`
+
+	// version increased on every change, returned as the ETag
+	Version int64 `json:"-"`
+}
+
+// ProductPrice is the price of a product set by hand in a store currency other than the base one
+type ProductPrice struct {
+	Currency string `json:"currency"`
+	// price in the minor units of the currency
+	Amount int64 `json:"amount"`
+}
+
+// PriceIn returns the price set by hand in the currency, if any
+func (m *Product) PriceIn(currency string) (int64, bool) {
+	for _, price := range m.Prices {
+		if price.Currency == currency {
+			return price.Amount, true
+		}
+	}
+	return 0, false
 }
 
 func NewProductFrom(dto *models.Product) *Product {
+	var prices []*ProductPrice
+	if dto.Prices != nil {
+		prices = make([]*ProductPrice, len(dto.Prices))
+		for i, price := range dto.Prices {
+			prices[i] = &ProductPrice{Currency: MoneyCurrency(price), Amount: MoneyAmount(price)}
+		}
+	}
 	return &Product{
 		Categories:    CategoriesFrom(dto.CategoryIds),
 		Description:   dto.Description,
+		Height:        dto.Height,
 		ID:            dto.ID,
 		Images:        dto.Images,
+		Length:        dto.Length,
 		NumberInStock: dto.NumberInStock,
-		Price:         dto.Price,
+		Price:         MoneyAmount(dto.Price),
+		Currency:      MoneyCurrency(dto.Price),
+		Prices:        prices,
+		TaxClass:      dto.TaxClass,
 		Title:         dto.Title,
+		Weight:        dto.Weight,
+		Width:         dto.Width,
 	}
 }
 
@@ -56,15 +111,30 @@ func CategoriesFrom(categoryIds []int64) []Category {
 	return result
 }
 
+// ToDTO converts the product to the DTO; its selling price is the base one
 func (m *Product) ToDTO() *models.Product {
+	var prices []*models.Money
+	if m.Prices != nil {
+		prices = make([]*models.Money, len(m.Prices))
+		for i, price := range m.Prices {
+			prices[i] = MoneyDTO(price.Amount, price.Currency)
+		}
+	}
 	return &models.Product{
 		CategoryIds:   CategoryIdsFrom(m.Categories),
 		Description:   m.Description,
+		Height:        m.Height,
 		ID:            m.ID,
 		Images:        m.Images,
+		Length:        m.Length,
 		NumberInStock: m.NumberInStock,
-		Price:         m.Price,
+		Price:         MoneyDTO(m.Price, m.Currency),
+		Prices:        prices,
+		SellingPrice:  MoneyDTO(m.Price, m.Currency),
+		TaxClass:      m.TaxClass,
 		Title:         m.Title,
+		Weight:        m.Weight,
+		Width:         m.Width,
 	}
 }
 
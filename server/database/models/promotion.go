@@ -0,0 +1,147 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+type Promotion struct {
+
+	// active
+	Active bool `json:"active,omitempty"`
+
+	// buy quantity
+	BuyQuantity int64 `json:"buyQuantity,omitempty"`
+
+	// category ids
+	CategoryIds []int64 `json:"categoryIds"`
+
+	// coupon code; promotions without a code are applied automatically
+	Code string `json:"code,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// get quantity
+	GetQuantity int64 `json:"getQuantity,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// kind
+	// Required: true
+	Kind *string `json:"kind"`
+
+	// min order value
+	MinOrderValue int64 `json:"minOrderValue,omitempty" bun:"min_order_value_minor"`
+
+	// product ids
+	ProductIds []int64 `json:"productIds"`
+
+	// title
+	// Required: true
+	// Min Length: 1
+	Title *string `json:"title"`
+
+	// usage limit; zero for unlimited
+	UsageLimit int64 `json:"usageLimit,omitempty"`
+
+	// usage limit per customer; zero for unlimited
+	UsageLimitPerCustomer int64 `json:"usageLimitPerCustomer,omitempty"`
+
+	// valid from; zero for no start date
+	ValidFrom int64 `json:"validFrom,omitempty"`
+
+	// valid to; zero for no end date
+	ValidTo int64 `json:"validTo,omitempty"`
+
+	// percentage off
+	Value float64 `json:"value,omitempty"`
+
+	// amount off of the fixed promotions
+	AmountOff int64 `json:"amountOff,omitempty" bun:"amount_off_minor"`
+
+	// ISO 4217 code of the currency of the amounts
+	Currency string `json:"currency,omitempty"`
+}
+
+// PromotionRedemption records an order a promotion has been applied to; used for the usage limits
+type PromotionRedemption struct {
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	PromotionID int64      `json:"promotionId,omitempty"`
+	Promotion   *Promotion `bun:"rel:belongs-to,join:promotion_id=id"`
+
+	UserID int64 `json:"userId,omitempty"`
+}
+
+var _ bun.BeforeCreateTableHook = (*PromotionRedemption)(nil)
+
+func (m *PromotionRedemption) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	query.ForeignKey(`("promotion_id") REFERENCES "promotions" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func NewPromotionFrom(dto *models.Promotion) *Promotion {
+	currency := MoneyCurrency(dto.AmountOff)
+	if currency == "" {
+		currency = MoneyCurrency(dto.MinOrderValue)
+	}
+	return &Promotion{
+		Active:                dto.Active,
+		AmountOff:             MoneyAmount(dto.AmountOff),
+		BuyQuantity:           dto.BuyQuantity,
+		CategoryIds:           dto.CategoryIds,
+		Code:                  dto.Code,
+		Currency:              currency,
+		DateCreated:           dto.DateCreated,
+		DateUpdated:           dto.DateUpdated,
+		GetQuantity:           dto.GetQuantity,
+		ID:                    dto.ID,
+		Kind:                  dto.Kind,
+		MinOrderValue:         MoneyAmount(dto.MinOrderValue),
+		ProductIds:            dto.ProductIds,
+		Title:                 dto.Title,
+		UsageLimit:            dto.UsageLimit,
+		UsageLimitPerCustomer: dto.UsageLimitPerCustomer,
+		ValidFrom:             dto.ValidFrom,
+		ValidTo:               dto.ValidTo,
+		Value:                 dto.Value,
+	}
+}
+
+func (m *Promotion) ToDTO() *models.Promotion {
+	return &models.Promotion{
+		Active:                m.Active,
+		AmountOff:             OptionalMoneyDTO(m.AmountOff, m.Currency),
+		BuyQuantity:           m.BuyQuantity,
+		CategoryIds:           m.CategoryIds,
+		Code:                  m.Code,
+		DateCreated:           m.DateCreated,
+		DateUpdated:           m.DateUpdated,
+		GetQuantity:           m.GetQuantity,
+		ID:                    m.ID,
+		Kind:                  m.Kind,
+		MinOrderValue:         OptionalMoneyDTO(m.MinOrderValue, m.Currency),
+		ProductIds:            m.ProductIds,
+		Title:                 m.Title,
+		UsageLimit:            m.UsageLimit,
+		UsageLimitPerCustomer: m.UsageLimitPerCustomer,
+		ValidFrom:             m.ValidFrom,
+		ValidTo:               m.ValidTo,
+		Value:                 m.Value,
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+// Refund is a full or partial refund of a payment issued through its payment gateway
+type Refund struct {
+
+	// refunded amount
+	Amount int64 `json:"amount,omitempty" bun:"amount_minor"`
+
+	// ISO 4217 code of the currency of the payment
+	// Read Only: true
+	Currency string `json:"currency,omitempty"`
+
+	// admin who issued the refund; zero for the refunds made through the payment gateway dashboard
+	// Read Only: true
+	CreatedBy int64 `json:"createdBy,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// payment gateway refund ID
+	// Read Only: true
+	GatewayRefundID string `json:"gatewayRefundId,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// refunded order items
+	Items []*RefundItem `json:"items"`
+
+	// Read Only: true
+	OrderID int64 `json:"orderId,omitempty"`
+
+	// Read Only: true
+	PaymentID int64    `json:"paymentId,omitempty"`
+	Payment   *Payment `bun:"rel:belongs-to,join:payment_id=id"`
+
+	// reason
+	// Required: true
+	Reason string `json:"reason"`
+
+	// status
+	// Read Only: true
+	Status string `json:"status,omitempty"`
+}
+
+// RefundItem is stored as a part of the refund items JSON
+type RefundItem struct {
+	ProductID int64 `json:"productId"`
+	Quantity  int64 `json:"quantity"`
+	// amount in the minor units of the refund currency
+	Amount int64 `json:"amount"`
+}
+
+var _ bun.BeforeCreateTableHook = (*Refund)(nil)
+
+func (m *Refund) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("payment_id") REFERENCES "payments" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func (m *Refund) ToDTO() *models.Refund {
+	items := make([]*models.RefundItem, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = &models.RefundItem{
+			Amount:    MoneyDTO(item.Amount, m.Currency),
+			ProductID: &item.ProductID,
+			Quantity:  &item.Quantity,
+		}
+	}
+	return &models.Refund{
+		Amount:          MoneyDTO(m.Amount, m.Currency),
+		CreatedBy:       m.CreatedBy,
+		DateCreated:     m.DateCreated,
+		DateUpdated:     m.DateUpdated,
+		GatewayRefundID: m.GatewayRefundID,
+		ID:              m.ID,
+		Items:           items,
+		OrderID:         m.OrderID,
+		PaymentID:       m.PaymentID,
+		Reason:          &m.Reason,
+		Status:          m.Status,
+	}
+}
+
+func RefundDTOsFromRefunds(refunds []*Refund) []*models.Refund {
+	if refunds == nil {
+		return nil
+	}
+	result := make([]*models.Refund, len(refunds))
+	for i, r := range refunds {
+		result[i] = r.ToDTO()
+	}
+	return result
+}
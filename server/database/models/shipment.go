@@ -0,0 +1,120 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+type Shipment struct {
+
+	// carrier name
+	// Required: true
+	Carrier *string `json:"carrier"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date delivered
+	// Read Only: true
+	DateDelivered int64 `json:"dateDelivered,omitempty"`
+
+	// date shipped
+	// Read Only: true
+	DateShipped int64 `json:"dateShipped,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// shipped items
+	// Required: true
+	Items []*ShipmentItem `json:"items"`
+
+	// label Url
+	// Read Only: true
+	LabelURL string `json:"labelUrl,omitempty"`
+
+	// Read Only: true
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	// status
+	// Read Only: true
+	Status string `json:"status,omitempty"`
+
+	// status details reported by the carrier
+	// Read Only: true
+	StatusDetails string `json:"statusDetails,omitempty"`
+
+	// tracking number
+	TrackingNumber string `json:"trackingNumber,omitempty"`
+
+	// tracking Url
+	TrackingURL string `json:"trackingUrl,omitempty"`
+}
+
+// ShipmentItem is stored as a part of the shipment items JSON
+type ShipmentItem struct {
+	ProductID int64 `json:"productId"`
+	Quantity  int64 `json:"quantity"`
+}
+
+var _ bun.BeforeCreateTableHook = (*Shipment)(nil)
+
+func (m *Shipment) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func NewShipmentFrom(dto *models.Shipment) *Shipment {
+	var items []*ShipmentItem
+	if dto.Items != nil {
+		items = make([]*ShipmentItem, len(dto.Items))
+		for i, item := range dto.Items {
+			items[i] = &ShipmentItem{ProductID: *item.ProductID, Quantity: *item.Quantity}
+		}
+	}
+	return &Shipment{
+		Carrier:        dto.Carrier,
+		DateCreated:    dto.DateCreated,
+		DateDelivered:  dto.DateDelivered,
+		DateShipped:    dto.DateShipped,
+		DateUpdated:    dto.DateUpdated,
+		ID:             dto.ID,
+		Items:          items,
+		LabelURL:       dto.LabelURL,
+		OrderID:        dto.OrderID,
+		Status:         dto.Status,
+		StatusDetails:  dto.StatusDetails,
+		TrackingNumber: dto.TrackingNumber,
+		TrackingURL:    dto.TrackingURL,
+	}
+}
+
+func (m *Shipment) ToDTO() *models.Shipment {
+	items := make([]*models.ShipmentItem, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = &models.ShipmentItem{ProductID: &item.ProductID, Quantity: &item.Quantity}
+	}
+	return &models.Shipment{
+		Carrier:        m.Carrier,
+		DateCreated:    m.DateCreated,
+		DateDelivered:  m.DateDelivered,
+		DateShipped:    m.DateShipped,
+		DateUpdated:    m.DateUpdated,
+		ID:             m.ID,
+		Items:          items,
+		LabelURL:       m.LabelURL,
+		OrderID:        m.OrderID,
+		Status:         m.Status,
+		StatusDetails:  m.StatusDetails,
+		TrackingNumber: m.TrackingNumber,
+		TrackingURL:    m.TrackingURL,
+	}
+}
@@ -0,0 +1,175 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+type ShippingZone struct {
+
+	// ISO 3166-1 alpha-2 country codes, optionally with a region (e.g., "US-CA"); empty for the rest of the world
+	Countries []string `json:"countries"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	Methods []*ShippingMethod `json:"methods,omitempty" bun:"rel:has-many,join:id=zone_id"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+}
+
+type ShippingMethod struct {
+
+	// only active methods are offered
+	Active bool `json:"active,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// ISO 4217 code of the currency of the prices
+	Currency string `json:"currency,omitempty"`
+
+	// order subtotal starting from which free-over methods are free
+	FreeOverAmount int64 `json:"freeOverAmount,omitempty" bun:"free_over_amount_minor"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// kind
+	// Required: true
+	Kind *string `json:"kind"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// flat price; base price of weight-based methods; price below the threshold of free-over methods
+	Price int64 `json:"price,omitempty" bun:"price_minor"`
+
+	// price per kilogram of weight-based methods without tiers
+	RatePerKg int64 `json:"ratePerKg,omitempty" bun:"rate_per_kg_minor"`
+
+	// prices starting from the weight (weight-based methods) or the order subtotal (price-tiered methods)
+	Tiers []*ShippingRateTier `json:"tiers"`
+
+	// zone Id
+	// Required: true
+	ZoneID int64         `json:"zoneId"`
+	Zone   *ShippingZone `bun:"rel:belongs-to,join:zone_id=id"`
+}
+
+// ShippingRateTier is stored as a part of the shipping method tiers JSON
+type ShippingRateTier struct {
+	// weight in kilograms the tier of a weight-based method starts from
+	MinWeight float64 `json:"minWeight,omitempty"`
+	// order subtotal in the minor units the tier of a price-tiered method starts from
+	MinSubtotal int64 `json:"minSubtotal,omitempty"`
+	Price       int64 `json:"price"`
+}
+
+var _ bun.BeforeCreateTableHook = (*ShippingMethod)(nil)
+
+func (m *ShippingMethod) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("zone_id") REFERENCES "shipping_zones" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func NewShippingZoneFrom(dto *models.ShippingZone) *ShippingZone {
+	return &ShippingZone{
+		Countries:   dto.Countries,
+		DateCreated: dto.DateCreated,
+		DateUpdated: dto.DateUpdated,
+		ID:          dto.ID,
+		Name:        dto.Name,
+	}
+}
+
+func (m *ShippingZone) ToDTO() *models.ShippingZone {
+	return &models.ShippingZone{
+		Countries:   m.Countries,
+		DateCreated: m.DateCreated,
+		DateUpdated: m.DateUpdated,
+		ID:          m.ID,
+		Name:        m.Name,
+	}
+}
+
+func NewShippingMethodFrom(dto *models.ShippingMethod) *ShippingMethod {
+	currency := MoneyCurrency(dto.Price)
+	var tiers []*ShippingRateTier
+	if dto.Tiers != nil {
+		tiers = make([]*ShippingRateTier, len(dto.Tiers))
+		for i, tier := range dto.Tiers {
+			tiers[i] = &ShippingRateTier{
+				MinWeight:   tier.MinWeight,
+				MinSubtotal: MoneyAmount(tier.MinSubtotal),
+				Price:       MoneyAmount(tier.Price),
+			}
+			if currency == "" {
+				currency = MoneyCurrency(tier.Price)
+			}
+		}
+	}
+	var zoneID int64 = 0
+	if dto.ZoneID != nil {
+		zoneID = *dto.ZoneID
+	}
+	return &ShippingMethod{
+		Active:         dto.Active,
+		Currency:       currency,
+		DateCreated:    dto.DateCreated,
+		DateUpdated:    dto.DateUpdated,
+		FreeOverAmount: MoneyAmount(dto.FreeOverAmount),
+		ID:             dto.ID,
+		Kind:           dto.Kind,
+		Name:           dto.Name,
+		Price:          MoneyAmount(dto.Price),
+		RatePerKg:      MoneyAmount(dto.RatePerKg),
+		Tiers:          tiers,
+		ZoneID:         zoneID,
+	}
+}
+
+func (m *ShippingMethod) ToDTO() *models.ShippingMethod {
+	tiers := make([]*models.ShippingRateTier, len(m.Tiers))
+	for i, tier := range m.Tiers {
+		tiers[i] = &models.ShippingRateTier{
+			MinWeight:   tier.MinWeight,
+			MinSubtotal: OptionalMoneyDTO(tier.MinSubtotal, m.Currency),
+			Price:       MoneyDTO(tier.Price, m.Currency),
+		}
+	}
+	return &models.ShippingMethod{
+		Active:         m.Active,
+		DateCreated:    m.DateCreated,
+		DateUpdated:    m.DateUpdated,
+		FreeOverAmount: OptionalMoneyDTO(m.FreeOverAmount, m.Currency),
+		ID:             m.ID,
+		Kind:           m.Kind,
+		Name:           m.Name,
+		Price:          MoneyDTO(m.Price, m.Currency),
+		RatePerKg:      OptionalMoneyDTO(m.RatePerKg, m.Currency),
+		Tiers:          tiers,
+		ZoneID:         &m.ZoneID,
+	}
+}
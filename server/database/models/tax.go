@@ -0,0 +1,179 @@
+package models
+
+import (
+	"estore-backend/server/models"
+	"github.com/uptrace/bun"
+	"golang.org/x/net/context"
+)
+
+type TaxZone struct {
+
+	// ISO 3166-1 alpha-2 country code; empty for the default zone
+	Country string `json:"country,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// rates
+	Rates []*TaxRate `json:"rates" bun:"rel:has-many,join:id=zone_id"`
+
+	// region code within the country; empty for the whole country
+	Region string `json:"region,omitempty"`
+}
+
+type TaxRate struct {
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// rate in percent
+	// Required: true
+	Rate *float64 `json:"rate"`
+
+	// tax class; empty for the standard class
+	TaxClass string `json:"taxClass,omitempty"`
+
+	ZoneID int64    `json:"zoneId,omitempty"`
+	Zone   *TaxZone `bun:"rel:belongs-to,join:zone_id=id"`
+}
+
+var _ bun.BeforeCreateTableHook = (*TaxRate)(nil)
+
+func (m *TaxRate) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("zone_id") REFERENCES "tax_zones" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+// OrderTaxLine is a tax charged for an order line; a snapshot of the tax rate applied to the order
+type OrderTaxLine struct {
+
+	// amount in the minor units of the order currency
+	Amount int64 `json:"amount,omitempty" bun:"amount_minor"`
+
+	// country
+	Country string `json:"country,omitempty"`
+
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// inclusive: the amount is included in the product price
+	Inclusive bool `json:"inclusive,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	OrderID int64  `json:"orderId,omitempty"`
+	Order   *Order `bun:"rel:belongs-to,join:order_id=id"`
+
+	// product Id
+	ProductID int64 `json:"productId,omitempty"`
+
+	// rate
+	Rate float64 `json:"rate,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
+	// tax class
+	TaxClass string `json:"taxClass,omitempty"`
+
+	// taxable amount
+	TaxableAmount int64 `json:"taxableAmount,omitempty" bun:"taxable_amount_minor"`
+
+	ZoneID int64 `json:"zoneId,omitempty"`
+}
+
+var _ bun.BeforeCreateTableHook = (*OrderTaxLine)(nil)
+
+func (m *OrderTaxLine) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE`)
+	return nil
+}
+
+func NewTaxZoneFrom(dto *models.TaxZone) *TaxZone {
+	return &TaxZone{
+		Country:     dto.Country,
+		DateCreated: dto.DateCreated,
+		DateUpdated: dto.DateUpdated,
+		ID:          dto.ID,
+		Name:        dto.Name,
+		Rates:       TaxRatesFromTaxRateDTOs(dto.Rates),
+		Region:      dto.Region,
+	}
+}
+
+func TaxRatesFromTaxRateDTOs(rates []*models.TaxRate) []*TaxRate {
+	if rates == nil {
+		return nil
+	}
+	result := make([]*TaxRate, len(rates))
+	for i, rate := range rates {
+		result[i] = &TaxRate{
+			Name:     rate.Name,
+			Rate:     rate.Rate,
+			TaxClass: rate.TaxClass,
+		}
+	}
+	return result
+}
+
+func (m *TaxZone) ToDTO() *models.TaxZone {
+	rates := make([]*models.TaxRate, len(m.Rates))
+	for i, rate := range m.Rates {
+		rates[i] = &models.TaxRate{
+			Name:     rate.Name,
+			Rate:     rate.Rate,
+			TaxClass: rate.TaxClass,
+		}
+	}
+	return &models.TaxZone{
+		Country:     m.Country,
+		DateCreated: m.DateCreated,
+		DateUpdated: m.DateUpdated,
+		ID:          m.ID,
+		Name:        m.Name,
+		Rates:       rates,
+		Region:      m.Region,
+	}
+}
+
+// ToDTO converts the tax line; its amounts are in the currency of its order
+func (m *OrderTaxLine) ToDTO(currency string) *models.OrderTaxLine {
+	return &models.OrderTaxLine{
+		Amount:        MoneyDTO(m.Amount, currency),
+		Country:       m.Country,
+		Inclusive:     m.Inclusive,
+		Name:          m.Name,
+		ProductID:     m.ProductID,
+		Rate:          m.Rate,
+		Region:        m.Region,
+		TaxClass:      m.TaxClass,
+		TaxableAmount: MoneyDTO(m.TaxableAmount, currency),
+	}
+}
+
+func OrderTaxLineDTOsFromOrderTaxLines(lines []*OrderTaxLine, currency string) []*models.OrderTaxLine {
+	if lines == nil {
+		return nil
+	}
+	result := make([]*models.OrderTaxLine, len(lines))
+	for i, line := range lines {
+		result[i] = line.ToDTO(currency)
+	}
+	return result
+}
@@ -26,6 +26,9 @@ type User struct {
 
 	// name
 	Name string `json:"name,omitempty"`
+
+	// version increased on every change, returned as the ETag
+	Version int64 `json:"-"`
 }
 
 func NewUserFrom(dto *models.User) *User {
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"estore-backend/server/models"
+)
+
+// WebhookEvent is a payment gateway webhook event stored on receipt and processed in background with retries
+type WebhookEvent struct {
+
+	// processing attempts made
+	// Read Only: true
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// date created
+	// Read Only: true
+	DateCreated int64 `json:"dateCreated,omitempty"`
+
+	// when the event has been processed successfully
+	// Read Only: true
+	DateProcessed int64 `json:"dateProcessed,omitempty"`
+
+	// date updated
+	// Read Only: true
+	DateUpdated int64 `json:"dateUpdated,omitempty"`
+
+	// event parsed and verified on receipt, so it is processed without the signature header
+	Event string `json:"-"`
+
+	// payment gateway event ID; the repeated deliveries of the event are ignored
+	// Read Only: true
+	EventID string `json:"eventId,omitempty" bun:",unique:gateway_event"`
+
+	// Read Only: true
+	Gateway string `json:"gateway,omitempty" bun:",unique:gateway_event"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty" bun:",pk,autoincrement,unique"`
+
+	// error of the last failed attempt
+	// Read Only: true
+	LastError string `json:"lastError,omitempty"`
+
+	// when the pending or failed event is processed next
+	// Read Only: true
+	NextAttemptAt int64 `json:"nextAttemptAt,omitempty"`
+
+	// received payload
+	// Read Only: true
+	Payload string `json:"payload,omitempty"`
+
+	// status
+	// Read Only: true
+	Status string `json:"status,omitempty"`
+
+	// event type
+	// Read Only: true
+	Type string `json:"type,omitempty"`
+}
+
+// ToDTO converts the event; the payload is included on request only as it may be large
+func (m *WebhookEvent) ToDTO(withPayload bool) *models.WebhookEvent {
+	result := &models.WebhookEvent{
+		Attempts:      m.Attempts,
+		DateCreated:   m.DateCreated,
+		DateProcessed: m.DateProcessed,
+		DateUpdated:   m.DateUpdated,
+		EventID:       m.EventID,
+		Gateway:       m.Gateway,
+		ID:            m.ID,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		Status:        m.Status,
+		Type:          m.Type,
+	}
+	if withPayload {
+		result.Payload = m.Payload
+	}
+	return result
+}
+
+func WebhookEventDTOsFromWebhookEvents(events []*WebhookEvent) []*models.WebhookEvent {
+	if events == nil {
+		return nil
+	}
+	result := make([]*models.WebhookEvent, len(events))
+	for i, e := range events {
+		result[i] = e.ToDTO(false)
+	}
+	return result
+}
@@ -19,6 +19,15 @@ type CheckoutSessionSecret struct {
 
 	// client secret
 	ClientSecret string `json:"client_secret,omitempty"`
+
+	// Payment gateway of the checkout session
+	Gateway string `json:"gateway,omitempty"`
+
+	// session id
+	SessionID string `json:"session_id,omitempty"`
+
+	// Hosted payment page to redirect the customer to; empty for the embedded checkout
+	URL string `json:"url,omitempty"`
 }
 
 // Validate validates this checkout session secret
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 
 	"github.com/go-openapi/errors"
@@ -20,32 +21,103 @@ import (
 // swagger:model order
 type Order struct {
 
+	// billing address
+	BillingAddress *Address `json:"billingAddress,omitempty"`
+
+	// Address book entry to use as the billing address if no billing address is given
+	BillingAddressID int64 `json:"billingAddressId,omitempty"`
+
+	// coupon code
+	CouponCode string `json:"couponCode,omitempty"`
+
 	// date created
 	// Read Only: true
 	DateCreated int64 `json:"dateCreated,omitempty"`
 
 	// date updated
+	// Read Only: true
 	DateUpdated int64 `json:"dateUpdated,omitempty"`
 
-	// delivery info
-	// Required: true
-	// Min Length: 10
-	DeliveryInfo *string `json:"deliveryInfo"`
+	// ISO 3166-1 alpha-2 code of the delivery country taken from the shipping address
+	// Read Only: true
+	DeliveryCountry string `json:"deliveryCountry,omitempty"`
+
+	// Free-text delivery instructions
+	DeliveryInfo string `json:"deliveryInfo,omitempty"`
+
+	// Code of the delivery region (state, province) taken from the shipping address
+	// Read Only: true
+	DeliveryRegion string `json:"deliveryRegion,omitempty"`
+
+	// discount total
+	// Read Only: true
+	DiscountTotal *Money `json:"discountTotal,omitempty"`
+
+	// discounts
+	// Read Only: true
+	Discounts []*OrderDiscount `json:"discounts"`
+
+	// Email of the customer who placed the order without an account
+	// Read Only: true
+	GuestEmail string `json:"guestEmail,omitempty"`
 
 	// id
 	// Read Only: true
 	ID int64 `json:"id,omitempty"`
 
+	// prices include tax
+	// Read Only: true
+	PricesIncludeTax *bool `json:"pricesIncludeTax,omitempty"`
+
 	// products
 	// Required: true
 	Products []*OrderedProduct `json:"products"`
 
+	// refunded total
+	// Read Only: true
+	RefundedTotal *Money `json:"refundedTotal,omitempty"`
+
+	// returns
+	// Read Only: true
+	Returns []*OrderReturn `json:"returns"`
+
+	// shipping address
+	ShippingAddress *Address `json:"shippingAddress,omitempty"`
+
+	// Address book entry to use as the shipping address if no shipping address is given
+	ShippingAddressID int64 `json:"shippingAddressId,omitempty"`
+
+	// shipping method Id
+	ShippingMethodID int64 `json:"shippingMethodId,omitempty"`
+
+	// shipping method name
+	// Read Only: true
+	ShippingMethodName string `json:"shippingMethodName,omitempty"`
+
+	// shipping price
+	// Read Only: true
+	ShippingPrice *Money `json:"shippingPrice,omitempty"`
+
 	// status
+	// Read Only: true
+	// Enum: [pending_payment paid processing shipped delivered cancelled partially_refunded refunded]
 	Status string `json:"status,omitempty"`
 
+	// status history
+	// Read Only: true
+	StatusHistory []*OrderStatusHistoryEntry `json:"statusHistory"`
+
+	// tax total
+	// Read Only: true
+	TaxTotal *Money `json:"taxTotal,omitempty"`
+
+	// taxes
+	// Read Only: true
+	Taxes []*OrderTaxLine `json:"taxes"`
+
 	// total price
 	// Required: true
-	TotalPrice *float64 `json:"totalPrice"`
+	TotalPrice *Money `json:"totalPrice"`
 
 	// user Id
 	UserID int64 `json:"userId,omitempty"`
@@ -55,7 +127,15 @@ type Order struct {
 func (m *Order) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateDeliveryInfo(formats); err != nil {
+	if err := m.validateBillingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDiscountTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDiscounts(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -63,6 +143,38 @@ func (m *Order) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRefundedTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReturns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShippingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShippingPrice(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatusHistory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTaxTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTaxes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTotalPrice(formats); err != nil {
 		res = append(res, err)
 	}
@@ -73,14 +185,65 @@ func (m *Order) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *Order) validateDeliveryInfo(formats strfmt.Registry) error {
+func (m *Order) validateBillingAddress(formats strfmt.Registry) error {
+	if swag.IsZero(m.BillingAddress) { // not required
+		return nil
+	}
 
-	if err := validate.Required("deliveryInfo", "body", m.DeliveryInfo); err != nil {
-		return err
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
 	}
 
-	if err := validate.MinLength("deliveryInfo", "body", *m.DeliveryInfo, 10); err != nil {
-		return err
+	return nil
+}
+
+func (m *Order) validateDiscountTotal(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiscountTotal) { // not required
+		return nil
+	}
+
+	if m.DiscountTotal != nil {
+		if err := m.DiscountTotal.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("discountTotal")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("discountTotal")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) validateDiscounts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Discounts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Discounts); i++ {
+		if swag.IsZero(m.Discounts[i]) { // not required
+			continue
+		}
+
+		if m.Discounts[i] != nil {
+			if err := m.Discounts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("discounts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("discounts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
 	}
 
 	return nil
@@ -113,12 +276,237 @@ func (m *Order) validateProducts(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Order) validateRefundedTotal(formats strfmt.Registry) error {
+	if swag.IsZero(m.RefundedTotal) { // not required
+		return nil
+	}
+
+	if m.RefundedTotal != nil {
+		if err := m.RefundedTotal.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("refundedTotal")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("refundedTotal")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) validateReturns(formats strfmt.Registry) error {
+	if swag.IsZero(m.Returns) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Returns); i++ {
+		if swag.IsZero(m.Returns[i]) { // not required
+			continue
+		}
+
+		if m.Returns[i] != nil {
+			if err := m.Returns[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("returns" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("returns" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) validateShippingAddress(formats strfmt.Registry) error {
+	if swag.IsZero(m.ShippingAddress) { // not required
+		return nil
+	}
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) validateShippingPrice(formats strfmt.Registry) error {
+	if swag.IsZero(m.ShippingPrice) { // not required
+		return nil
+	}
+
+	if m.ShippingPrice != nil {
+		if err := m.ShippingPrice.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingPrice")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var orderTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending_payment","paid","processing","shipped","delivered","cancelled","partially_refunded","refunded"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		orderTypeStatusPropEnum = append(orderTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// OrderStatusPendingPayment captures enum value "pending_payment"
+	OrderStatusPendingPayment string = "pending_payment"
+
+	// OrderStatusPaid captures enum value "paid"
+	OrderStatusPaid string = "paid"
+
+	// OrderStatusProcessing captures enum value "processing"
+	OrderStatusProcessing string = "processing"
+
+	// OrderStatusShipped captures enum value "shipped"
+	OrderStatusShipped string = "shipped"
+
+	// OrderStatusDelivered captures enum value "delivered"
+	OrderStatusDelivered string = "delivered"
+
+	// OrderStatusCancelled captures enum value "cancelled"
+	OrderStatusCancelled string = "cancelled"
+
+	// OrderStatusPartiallyRefunded captures enum value "partially_refunded"
+	OrderStatusPartiallyRefunded string = "partially_refunded"
+
+	// OrderStatusRefunded captures enum value "refunded"
+	OrderStatusRefunded string = "refunded"
+)
+
+// prop value enum
+func (m *Order) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, orderTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Order) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) validateStatusHistory(formats strfmt.Registry) error {
+	if swag.IsZero(m.StatusHistory) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.StatusHistory); i++ {
+		if swag.IsZero(m.StatusHistory[i]) { // not required
+			continue
+		}
+
+		if m.StatusHistory[i] != nil {
+			if err := m.StatusHistory[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("statusHistory" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("statusHistory" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) validateTaxTotal(formats strfmt.Registry) error {
+	if swag.IsZero(m.TaxTotal) { // not required
+		return nil
+	}
+
+	if m.TaxTotal != nil {
+		if err := m.TaxTotal.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("taxTotal")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("taxTotal")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) validateTaxes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Taxes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Taxes); i++ {
+		if swag.IsZero(m.Taxes[i]) { // not required
+			continue
+		}
+
+		if m.Taxes[i] != nil {
+			if err := m.Taxes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("taxes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("taxes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Order) validateTotalPrice(formats strfmt.Registry) error {
 
 	if err := validate.Required("totalPrice", "body", m.TotalPrice); err != nil {
 		return err
 	}
 
+	if m.TotalPrice != nil {
+		if err := m.TotalPrice.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("totalPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("totalPrice")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -126,24 +514,117 @@ func (m *Order) validateTotalPrice(formats strfmt.Registry) error {
 func (m *Order) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBillingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDateCreated(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDateUpdated(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDeliveryCountry(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDeliveryRegion(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDiscountTotal(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDiscounts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGuestEmail(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidatePricesIncludeTax(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProducts(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRefundedTotal(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReturns(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShippingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShippingMethodName(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShippingPrice(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatusHistory(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTaxTotal(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTaxes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTotalPrice(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Order) contextValidateBillingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BillingAddress != nil {
+
+		if swag.IsZero(m.BillingAddress) { // not required
+			return nil
+		}
+
+		if err := m.BillingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Order) contextValidateDateCreated(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "dateCreated", "body", int64(m.DateCreated)); err != nil {
@@ -153,6 +634,92 @@ func (m *Order) contextValidateDateCreated(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Order) contextValidateDateUpdated(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "dateUpdated", "body", int64(m.DateUpdated)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateDeliveryCountry(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "deliveryCountry", "body", string(m.DeliveryCountry)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateDeliveryRegion(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "deliveryRegion", "body", string(m.DeliveryRegion)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateDiscountTotal(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DiscountTotal != nil {
+
+		if swag.IsZero(m.DiscountTotal) { // not required
+			return nil
+		}
+
+		if err := m.DiscountTotal.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("discountTotal")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("discountTotal")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateDiscounts(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "discounts", "body", []*OrderDiscount(m.Discounts)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Discounts); i++ {
+
+		if m.Discounts[i] != nil {
+
+			if swag.IsZero(m.Discounts[i]) { // not required
+				return nil
+			}
+
+			if err := m.Discounts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("discounts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("discounts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateGuestEmail(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "guestEmail", "body", string(m.GuestEmail)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Order) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
@@ -162,6 +729,15 @@ func (m *Order) contextValidateID(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Order) contextValidatePricesIncludeTax(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "pricesIncludeTax", "body", m.PricesIncludeTax); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Order) contextValidateProducts(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Products); i++ {
@@ -187,6 +763,212 @@ func (m *Order) contextValidateProducts(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *Order) contextValidateRefundedTotal(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RefundedTotal != nil {
+
+		if swag.IsZero(m.RefundedTotal) { // not required
+			return nil
+		}
+
+		if err := m.RefundedTotal.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("refundedTotal")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("refundedTotal")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateReturns(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "returns", "body", []*OrderReturn(m.Returns)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Returns); i++ {
+
+		if m.Returns[i] != nil {
+
+			if swag.IsZero(m.Returns[i]) { // not required
+				return nil
+			}
+
+			if err := m.Returns[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("returns" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("returns" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateShippingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ShippingAddress != nil {
+
+		if swag.IsZero(m.ShippingAddress) { // not required
+			return nil
+		}
+
+		if err := m.ShippingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateShippingMethodName(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "shippingMethodName", "body", string(m.ShippingMethodName)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateShippingPrice(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ShippingPrice != nil {
+
+		if swag.IsZero(m.ShippingPrice) { // not required
+			return nil
+		}
+
+		if err := m.ShippingPrice.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingPrice")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "status", "body", string(m.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateStatusHistory(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "statusHistory", "body", []*OrderStatusHistoryEntry(m.StatusHistory)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.StatusHistory); i++ {
+
+		if m.StatusHistory[i] != nil {
+
+			if swag.IsZero(m.StatusHistory[i]) { // not required
+				return nil
+			}
+
+			if err := m.StatusHistory[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("statusHistory" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("statusHistory" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateTaxTotal(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TaxTotal != nil {
+
+		if swag.IsZero(m.TaxTotal) { // not required
+			return nil
+		}
+
+		if err := m.TaxTotal.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("taxTotal")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("taxTotal")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateTaxes(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "taxes", "body", []*OrderTaxLine(m.Taxes)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Taxes); i++ {
+
+		if m.Taxes[i] != nil {
+
+			if swag.IsZero(m.Taxes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Taxes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("taxes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("taxes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateTotalPrice(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TotalPrice != nil {
+
+		if err := m.TotalPrice.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("totalPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("totalPrice")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Order) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -37,11 +37,16 @@ type OrderedProduct struct {
 
 	// quantity
 	// Required: true
+	// Minimum: 1
 	Quantity *int64 `json:"quantity"`
 
+	// tax amount
+	// Read Only: true
+	TaxAmount *Money `json:"taxAmount,omitempty"`
+
 	// total price
 	// Required: true
-	TotalPrice *float64 `json:"totalPrice"`
+	TotalPrice *Money `json:"totalPrice"`
 }
 
 // Validate validates this ordered product
@@ -56,6 +61,10 @@ func (m *OrderedProduct) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTaxAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTotalPrice(formats); err != nil {
 		res = append(res, err)
 	}
@@ -81,6 +90,29 @@ func (m *OrderedProduct) validateQuantity(formats strfmt.Registry) error {
 		return err
 	}
 
+	if err := validate.MinimumInt("quantity", "body", *m.Quantity, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OrderedProduct) validateTaxAmount(formats strfmt.Registry) error {
+	if swag.IsZero(m.TaxAmount) { // not required
+		return nil
+	}
+
+	if m.TaxAmount != nil {
+		if err := m.TaxAmount.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("taxAmount")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("taxAmount")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -90,6 +122,17 @@ func (m *OrderedProduct) validateTotalPrice(formats strfmt.Registry) error {
 		return err
 	}
 
+	if m.TotalPrice != nil {
+		if err := m.TotalPrice.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("totalPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("totalPrice")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -109,6 +152,14 @@ func (m *OrderedProduct) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTaxAmount(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTotalPrice(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -142,6 +193,44 @@ func (m *OrderedProduct) contextValidateProductName(ctx context.Context, formats
 	return nil
 }
 
+func (m *OrderedProduct) contextValidateTaxAmount(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TaxAmount != nil {
+
+		if swag.IsZero(m.TaxAmount) { // not required
+			return nil
+		}
+
+		if err := m.TaxAmount.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("taxAmount")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("taxAmount")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OrderedProduct) contextValidateTotalPrice(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TotalPrice != nil {
+
+		if err := m.TotalPrice.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("totalPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("totalPrice")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *OrderedProduct) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -21,27 +22,61 @@ type Payment struct {
 
 	// amount
 	// Required: true
-	Amount *float64 `json:"amount"`
+	Amount *Money `json:"amount"`
 
 	// date created
 	// Read Only: true
 	DateCreated int64 `json:"dateCreated,omitempty"`
 
 	// date updated
+	// Read Only: true
 	DateUpdated int64 `json:"dateUpdated,omitempty"`
 
+	// Deadline of the bank transfer; the payment and the unpaid order are cancelled afterwards
+	// Read Only: true
+	DueAt int64 `json:"dueAt,omitempty"`
+
+	// Reason of the last failed or canceled payment attempt as reported by the payment gateway (e. g., a card decline code)
+	// Read Only: true
+	FailureCode string `json:"failureCode,omitempty"`
+
+	// Message of the last failed payment attempt as reported by the payment gateway
+	// Read Only: true
+	FailureMessage string `json:"failureMessage,omitempty"`
+
+	// Payment gateway of the online payment
+	// Read Only: true
+	Gateway string `json:"gateway,omitempty"`
+
 	// id
 	// Read Only: true
 	ID int64 `json:"id,omitempty"`
 
+	// How the customer pays the offline payment
+	// Read Only: true
+	Instructions string `json:"instructions,omitempty"`
+
+	// How the payment is made; the payments without a method have been made online
+	// Enum: [online bank_transfer cash_on_delivery cash]
+	Method string `json:"method,omitempty"`
+
 	// order Id
 	// Required: true
 	OrderID *int64 `json:"orderId"`
 
-	// status
+	// Reference the customer gives with the bank transfer
+	// Read Only: true
+	Reference string `json:"reference,omitempty"`
+
+	// refunded amount
+	// Read Only: true
+	RefundedAmount *Money `json:"refundedAmount,omitempty"`
+
+	// intended, requires_action, processing, authorized, failed, complete, canceled, partially_refunded or refunded; set from the payment gateway events
 	Status string `json:"status,omitempty"`
 
-	// user Id
+	// Customer of the order
+	// Read Only: true
 	UserID int64 `json:"userId,omitempty"`
 }
 
@@ -53,10 +88,18 @@ func (m *Payment) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOrderID(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRefundedAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -69,6 +112,65 @@ func (m *Payment) validateAmount(formats strfmt.Registry) error {
 		return err
 	}
 
+	if m.Amount != nil {
+		if err := m.Amount.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("amount")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("amount")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var paymentTypeMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["online","bank_transfer","cash_on_delivery","cash"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		paymentTypeMethodPropEnum = append(paymentTypeMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// PaymentMethodOnline captures enum value "online"
+	PaymentMethodOnline string = "online"
+
+	// PaymentMethodBankTransfer captures enum value "bank_transfer"
+	PaymentMethodBankTransfer string = "bank_transfer"
+
+	// PaymentMethodCashOnDelivery captures enum value "cash_on_delivery"
+	PaymentMethodCashOnDelivery string = "cash_on_delivery"
+
+	// PaymentMethodCash captures enum value "cash"
+	PaymentMethodCash string = "cash"
+)
+
+// prop value enum
+func (m *Payment) validateMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, paymentTypeMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Payment) validateMethod(formats strfmt.Registry) error {
+	if swag.IsZero(m.Method) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateMethodEnum("method", "body", m.Method); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -81,24 +183,100 @@ func (m *Payment) validateOrderID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Payment) validateRefundedAmount(formats strfmt.Registry) error {
+	if swag.IsZero(m.RefundedAmount) { // not required
+		return nil
+	}
+
+	if m.RefundedAmount != nil {
+		if err := m.RefundedAmount.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("refundedAmount")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("refundedAmount")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this payment based on the context it is used
 func (m *Payment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAmount(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDateCreated(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDateUpdated(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDueAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFailureCode(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFailureMessage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGateway(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateInstructions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReference(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRefundedAmount(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUserID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Payment) contextValidateAmount(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Amount != nil {
+
+		if err := m.Amount.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("amount")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("amount")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Payment) contextValidateDateCreated(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "dateCreated", "body", int64(m.DateCreated)); err != nil {
@@ -108,6 +286,51 @@ func (m *Payment) contextValidateDateCreated(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *Payment) contextValidateDateUpdated(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "dateUpdated", "body", int64(m.DateUpdated)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateDueAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "dueAt", "body", int64(m.DueAt)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateFailureCode(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "failureCode", "body", string(m.FailureCode)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateFailureMessage(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "failureMessage", "body", string(m.FailureMessage)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateGateway(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "gateway", "body", string(m.Gateway)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Payment) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
@@ -117,6 +340,54 @@ func (m *Payment) contextValidateID(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Payment) contextValidateInstructions(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "instructions", "body", string(m.Instructions)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateReference(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "reference", "body", string(m.Reference)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateRefundedAmount(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RefundedAmount != nil {
+
+		if swag.IsZero(m.RefundedAmount) { // not required
+			return nil
+		}
+
+		if err := m.RefundedAmount.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("refundedAmount")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("refundedAmount")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Payment) contextValidateUserID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "userId", "body", int64(m.UserID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Payment) MarshalBinary() ([]byte, error) {
 	if m == nil {
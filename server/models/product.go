@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -27,6 +28,9 @@ type Product struct {
 	// Min Length: 1
 	Description *string `json:"description"`
 
+	// Height in centimeters
+	Height float64 `json:"height,omitempty"`
+
 	// id
 	// Read Only: true
 	ID int64 `json:"id,omitempty"`
@@ -34,16 +38,35 @@ type Product struct {
 	// images
 	Images []string `json:"images"`
 
+	// Length in centimeters
+	Length float64 `json:"length,omitempty"`
+
 	// number in stock
 	NumberInStock int64 `json:"numberInStock,omitempty"`
 
 	// price
-	Price float64 `json:"price,omitempty"`
+	Price *Money `json:"price,omitempty"`
+
+	// Prices set by hand in the other store currencies; the missing ones are converted from the base price
+	Prices []*Money `json:"prices"`
+
+	// selling price
+	// Read Only: true
+	SellingPrice *Money `json:"sellingPrice,omitempty"`
+
+	// Tax class of the product; empty for the standard class
+	TaxClass string `json:"taxClass,omitempty"`
 
 	// title
 	// Required: true
 	// Min Length: 1
 	Title *string `json:"title"`
+
+	// Weight in kilograms
+	Weight float64 `json:"weight,omitempty"`
+
+	// Width in centimeters
+	Width float64 `json:"width,omitempty"`
 }
 
 // Validate validates this product
@@ -54,6 +77,18 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePrice(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrices(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSellingPrice(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTitle(formats); err != nil {
 		res = append(res, err)
 	}
@@ -77,6 +112,70 @@ func (m *Product) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Product) validatePrice(formats strfmt.Registry) error {
+	if swag.IsZero(m.Price) { // not required
+		return nil
+	}
+
+	if m.Price != nil {
+		if err := m.Price.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("price")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("price")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Product) validatePrices(formats strfmt.Registry) error {
+	if swag.IsZero(m.Prices) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Prices); i++ {
+		if swag.IsZero(m.Prices[i]) { // not required
+			continue
+		}
+
+		if m.Prices[i] != nil {
+			if err := m.Prices[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prices" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prices" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) validateSellingPrice(formats strfmt.Registry) error {
+	if swag.IsZero(m.SellingPrice) { // not required
+		return nil
+	}
+
+	if m.SellingPrice != nil {
+		if err := m.SellingPrice.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sellingPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sellingPrice")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Product) validateTitle(formats strfmt.Registry) error {
 
 	if err := validate.Required("title", "body", m.Title); err != nil {
@@ -98,6 +197,18 @@ func (m *Product) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidatePrice(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePrices(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSellingPrice(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -113,6 +224,73 @@ func (m *Product) contextValidateID(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Product) contextValidatePrice(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Price != nil {
+
+		if swag.IsZero(m.Price) { // not required
+			return nil
+		}
+
+		if err := m.Price.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("price")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("price")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidatePrices(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Prices); i++ {
+
+		if m.Prices[i] != nil {
+
+			if swag.IsZero(m.Prices[i]) { // not required
+				return nil
+			}
+
+			if err := m.Prices[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prices" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prices" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidateSellingPrice(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SellingPrice != nil {
+
+		if swag.IsZero(m.SellingPrice) { // not required
+			return nil
+		}
+
+		if err := m.SellingPrice.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sellingPrice")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sellingPrice")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Product) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -24,6 +24,7 @@ type User struct {
 	DateCreated int64 `json:"dateCreated,omitempty"`
 
 	// date updated
+	// Read Only: true
 	DateUpdated int64 `json:"dateUpdated,omitempty"`
 
 	// email
@@ -69,6 +70,10 @@ func (m *User) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDateUpdated(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -88,6 +93,15 @@ func (m *User) contextValidateDateCreated(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *User) contextValidateDateUpdated(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "dateUpdated", "body", int64(m.DateUpdated)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *User) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
@@ -0,0 +1,248 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+*
+Exact money arithmetic. The amounts are int64 numbers of the minor units of their currencies (e. g., cents),
+so adding and subtracting them is exact.
+
+Rounding rules: every computation leaving a fraction of a minor unit (percentages, taxes, proportional
+shares, prices per weight, conversions of decimal amounts and of currencies) rounds half to even (banker's
+rounding), so the rounding errors of many lines do not pile up in one direction. An amount split into shares is allocated
+by the largest remainder instead, so the shares add up to the amount exactly.
+*/
+
+// Money is an amount in the minor units of an ISO 4217 currency
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// String formats the money with the decimals of its currency, e. g., "12.34 USD"
+func (m Money) String() string {
+	return Format(m.Amount, m.Currency) + " " + m.Currency
+}
+
+// exponents are the numbers of the decimals of the currencies having other than two
+var exponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0, "RWF": 0,
+	"UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// IsCurrencyCode tells whether the code is formed as an ISO 4217 currency code
+func IsCurrencyCode(code string) bool {
+	return currencyCodePattern.MatchString(code)
+}
+
+// Exponent returns the number of the decimals of the currency
+func Exponent(currency string) int {
+	if exponent, ok := exponents[strings.ToUpper(currency)]; ok {
+		return exponent
+	}
+	return 2
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+// Format formats the amount with the decimals of the currency, e. g., "-12.34"
+func Format(amount int64, currency string) string {
+	exponent := Exponent(currency)
+	if exponent == 0 {
+		return strconv.FormatInt(amount, 10)
+	}
+	sign := ""
+	abs := new(big.Int).Abs(big.NewInt(amount))
+	if amount < 0 {
+		sign = "-"
+	}
+	units, fraction := new(big.Int).QuoRem(abs, pow10(exponent), new(big.Int))
+	return fmt.Sprintf("%s%s.%0*s", sign, units.String(), exponent, fraction.String())
+}
+
+// decimal returns the exact value of the decimal the float is written as, so 0.1 is one tenth
+func decimal(value float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+// roundHalfEven rounds the number to an integer, the halves to the even one
+func roundHalfEven(r *big.Rat) int64 {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	twice := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+	cmp := twice.Cmp(r.Denom())
+	if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+		if r.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient.Int64()
+}
+
+// FromMajor converts the decimal amount in the major units of the currency (e. g., dollars) to the minor units
+func FromMajor(amount float64, currency string) int64 {
+	r := decimal(amount)
+	r.Mul(r, new(big.Rat).SetInt(pow10(Exponent(currency))))
+	return roundHalfEven(r)
+}
+
+// Percent returns the percentage of the amount
+func Percent(amount int64, percent float64) int64 {
+	r := new(big.Rat).SetInt64(amount)
+	r.Mul(r, decimal(percent))
+	r.Quo(r, big.NewRat(100, 1))
+	return roundHalfEven(r)
+}
+
+// IncludedPercent returns the part of the gross amount charged as the percentage of the rest, e. g., the tax
+// included in a price
+func IncludedPercent(gross int64, percent float64) int64 {
+	rate := decimal(percent)
+	r := new(big.Rat).SetInt64(gross)
+	r.Mul(r, rate)
+	r.Quo(r, new(big.Rat).Add(rate, big.NewRat(100, 1)))
+	return roundHalfEven(r)
+}
+
+// Multiply returns the amount multiplied by the factor, e. g., a price per kilogram by the weight
+func Multiply(amount int64, factor float64) int64 {
+	r := new(big.Rat).SetInt64(amount)
+	r.Mul(r, decimal(factor))
+	return roundHalfEven(r)
+}
+
+// Share returns the part of the amount proportional to the part of the whole, e. g., the price of some
+// of the ordered items; the whole amount is returned for a zero whole
+func Share(amount int64, part int64, whole int64) int64 {
+	if whole == 0 {
+		return amount
+	}
+	return roundHalfEven(new(big.Rat).Mul(big.NewRat(amount, 1), big.NewRat(part, whole)))
+}
+
+// Allocate splits the amount into the shares proportional to the weights which add up to the amount exactly;
+// the minor units left over are given to the shares with the largest remainders, the first ones of equal
+// remainders first. The amount is split equally if all the weights are zero.
+func Allocate(amount int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+	whole := int64(0)
+	for _, weight := range weights {
+		whole += weight
+	}
+	if whole == 0 {
+		weights = make([]int64, len(shares))
+		for i := range weights {
+			weights[i] = 1
+		}
+		whole = int64(len(weights))
+	}
+	sign := int64(1)
+	if amount < 0 {
+		sign, amount = -1, -amount
+	}
+	remainders := make([]*big.Int, len(weights))
+	allocated := int64(0)
+	for i, weight := range weights {
+		product := new(big.Int).Mul(big.NewInt(amount), big.NewInt(weight))
+		share, remainder := new(big.Int).QuoRem(product, big.NewInt(whole), new(big.Int))
+		shares[i] = share.Int64()
+		remainders[i] = remainder
+		allocated += shares[i]
+	}
+	for left := amount - allocated; left > 0; left-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i].Cmp(remainders[largest]) > 0 {
+				largest = i
+			}
+		}
+		shares[largest]++
+		remainders[largest] = new(big.Int).Sub(remainders[largest], big.NewInt(whole))
+	}
+	for i := range shares {
+		shares[i] *= sign
+	}
+	return shares
+}
+
+// Convert converts the amount of one currency to another one at the rate, the number of the units of the target
+// currency a unit of the source one is worth; the decimals of the currencies may differ
+func Convert(amount int64, from string, to string, rate float64) int64 {
+	r := new(big.Rat).SetInt64(amount)
+	r.Mul(r, decimal(rate))
+	r.Mul(r, new(big.Rat).SetInt(pow10(Exponent(to))))
+	r.Quo(r, new(big.Rat).SetInt(pow10(Exponent(from))))
+	return roundHalfEven(r)
+}
+
+// The ways RoundPrice rounds the prices
+const (
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+	RoundDown    = "down"
+)
+
+// RoundPrice rounds the price to the increment so that it ends with the ending, e. g., to 0.05 with the increment of 5
+// cents or up to 0.99 with the increment of 100 cents and the ending of 99. The nearest price rounds half to even.
+// The increments below 2 minor units leave the price as it is, and a price is never rounded below the ending.
+func RoundPrice(amount int64, increment int64, ending int64, mode string) int64 {
+	if increment < 2 {
+		return amount
+	}
+	ending = ending % increment
+	r := big.NewRat(amount-ending, increment)
+	steps, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	switch {
+	case remainder.Sign() == 0:
+	case mode == RoundUp:
+		if r.Sign() > 0 {
+			steps.Add(steps, big.NewInt(1))
+		}
+	case mode == RoundDown:
+		if r.Sign() < 0 {
+			steps.Sub(steps, big.NewInt(1))
+		}
+	default:
+		steps = big.NewInt(roundHalfEven(r))
+	}
+	return Max(steps.Int64()*increment+ending, ending)
+}
+
+// Min returns the smaller of the amounts
+func Min(a int64, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Max returns the greater of the amounts
+func Max(a int64, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,185 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFromMajor(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     int64
+	}{
+		{12.34, "USD", 1234},
+		{0.29, "USD", 29},
+		{0.125, "USD", 12},
+		{0.135, "USD", 14},
+		{1.005, "USD", 100},
+		{-0.125, "USD", -12},
+		{-0.135, "USD", -14},
+		{1000, "JPY", 1000},
+		{999.5, "JPY", 1000},
+		{1.2345, "KWD", 1234},
+		{0, "EUR", 0},
+	}
+	for _, tt := range tests {
+		if got := FromMajor(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("FromMajor(%v, %s) = %d, want %d", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{1234, "USD", "12.34"},
+		{5, "USD", "0.05"},
+		{-5, "USD", "-0.05"},
+		{1000, "JPY", "1000"},
+		{1234, "KWD", "1.234"},
+		{1234, "kwd", "1.234"},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("Format(%d, %s) = %q, want %q", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+	if got := New(1234, "USD").String(); got != "12.34 USD" {
+		t.Errorf("String() = %q, want %q", got, "12.34 USD")
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		amount  int64
+		percent float64
+		want    int64
+	}{
+		{1999, 10, 200},
+		{25, 10, 2},
+		{35, 10, 4},
+		{1000, 0, 0},
+		{1000, 7.5, 75},
+		{-35, 10, -4},
+	}
+	for _, tt := range tests {
+		if got := Percent(tt.amount, tt.percent); got != tt.want {
+			t.Errorf("Percent(%d, %v) = %d, want %d", tt.amount, tt.percent, got, tt.want)
+		}
+	}
+}
+
+func TestIncludedPercent(t *testing.T) {
+	tests := []struct {
+		gross   int64
+		percent float64
+		want    int64
+	}{
+		{1210, 21, 210},
+		{1000, 20, 167},
+		{1000, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := IncludedPercent(tt.gross, tt.percent); got != tt.want {
+			t.Errorf("IncludedPercent(%d, %v) = %d, want %d", tt.gross, tt.percent, got, tt.want)
+		}
+	}
+}
+
+func TestShare(t *testing.T) {
+	tests := []struct {
+		amount, part, whole int64
+		want                int64
+	}{
+		{1000, 1, 3, 333},
+		{1000, 2, 3, 667},
+		{5, 1, 2, 2},
+		{15, 1, 2, 8},
+		{1000, 1, 0, 1000},
+	}
+	for _, tt := range tests {
+		if got := Share(tt.amount, tt.part, tt.whole); got != tt.want {
+			t.Errorf("Share(%d, %d, %d) = %d, want %d", tt.amount, tt.part, tt.whole, got, tt.want)
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		amount  int64
+		weights []int64
+		want    []int64
+	}{
+		{100, []int64{1, 1, 1}, []int64{34, 33, 33}},
+		{1, []int64{1, 1, 1}, []int64{1, 0, 0}},
+		{7, []int64{3, 1}, []int64{5, 2}},
+		{10, []int64{1, 2, 3, 4}, []int64{1, 2, 3, 4}},
+		{100, []int64{0, 0}, []int64{50, 50}},
+		{-100, []int64{1, 1, 1}, []int64{-34, -33, -33}},
+		{100, []int64{0, 1}, []int64{0, 100}},
+		{5, []int64{}, []int64{}},
+	}
+	for _, tt := range tests {
+		got := Allocate(tt.amount, tt.weights)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Allocate(%d, %v) = %v, want %v", tt.amount, tt.weights, got, tt.want)
+		}
+		sum := int64(0)
+		for _, share := range got {
+			sum += share
+		}
+		if len(got) > 0 && sum != tt.amount {
+			t.Errorf("Allocate(%d, %v) shares add up to %d", tt.amount, tt.weights, sum)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		from, to string
+		rate     float64
+		want     int64
+	}{
+		{1000, "USD", "EUR", 0.9, 900},
+		{1000, "USD", "JPY", 150.5, 1505},
+		{1505, "JPY", "USD", 0.00664, 999},
+		{125, "USD", "KWD", 0.3, 375},
+		{5, "USD", "EUR", 0.5, 2},
+		{15, "USD", "EUR", 0.5, 8},
+	}
+	for _, tt := range tests {
+		if got := Convert(tt.amount, tt.from, tt.to, tt.rate); got != tt.want {
+			t.Errorf("Convert(%d, %s, %s, %v) = %d, want %d", tt.amount, tt.from, tt.to, tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestRoundPrice(t *testing.T) {
+	tests := []struct {
+		amount, increment, ending int64
+		mode                      string
+		want                      int64
+	}{
+		{1234, 5, 0, RoundNearest, 1235},
+		{1232, 5, 0, RoundNearest, 1230},
+		{1225, 50, 0, RoundNearest, 1200},
+		{1275, 50, 0, RoundNearest, 1300},
+		{1201, 100, 99, RoundUp, 1299},
+		{1201, 100, 99, RoundDown, 1199},
+		{1299, 100, 99, RoundUp, 1299},
+		{50, 100, 99, RoundDown, 99},
+		{1234, 1, 0, RoundUp, 1234},
+	}
+	for _, tt := range tests {
+		got := RoundPrice(tt.amount, tt.increment, tt.ending, tt.mode)
+		if got != tt.want {
+			t.Errorf("RoundPrice(%d, %d, %d, %s) = %d, want %d", tt.amount, tt.increment, tt.ending, tt.mode,
+				got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+update orders set status = 'paid' where status in ('paid', 'succeeded', 'no_payment_required');
+update orders set status = 'pending_payment' where status is null or status not in ('pending_payment', 'paid', 'processing', 'shipped', 'delivered', 'cancelled', 'refunded');
@@ -0,0 +1,4 @@
+update products set version = 1 where version is null or version < 1;
+update categories set version = 1 where version is null or version < 1;
+update orders set version = 1 where version is null or version < 1;
+update users set version = 1 where version is null or version < 1;
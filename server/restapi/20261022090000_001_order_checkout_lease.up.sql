@@ -0,0 +1 @@
+alter table orders add column checkout_lease_until bigint;
@@ -0,0 +1 @@
+alter table webhook_events add column locked_until bigint;
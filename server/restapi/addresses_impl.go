@@ -0,0 +1,321 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/address"
+	"estore-backend/server/restapi/operations/addresses"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// addressRule describes the postal address requirements of a country
+type addressRule struct {
+	postalCode       *regexp.Regexp
+	isRegionRequired bool
+}
+
+// addressRules are the per-country address validation rules; the countries absent from the map
+// accept any postal code and region
+var addressRules = map[string]addressRule{
+	"AU": {regexp.MustCompile(`^\d{4}$`), true},
+	"BR": {regexp.MustCompile(`^\d{5}-?\d{3}$`), true},
+	"CA": {regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`), true},
+	"DE": {regexp.MustCompile(`^\d{5}$`), false},
+	"ES": {regexp.MustCompile(`^\d{5}$`), false},
+	"FR": {regexp.MustCompile(`^\d{5}$`), false},
+	"GB": {regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`), false},
+	"IN": {regexp.MustCompile(`^\d{6}$`), true},
+	"IT": {regexp.MustCompile(`^\d{5}$`), false},
+	"JP": {regexp.MustCompile(`^\d{3}-?\d{4}$`), true},
+	"NL": {regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`), false},
+	"PL": {regexp.MustCompile(`^\d{2}-\d{3}$`), false},
+	"US": {regexp.MustCompile(`^\d{5}(-\d{4})?$`), true},
+}
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9 ()\-]{5,20}$`)
+
+// validateAddress normalizes the address and checks it against the rules of its country;
+// the kind ("shipping", "billing") names the address in the error messages
+func validateAddress(kind string, item *dbModels.AddressFields) errors.Error {
+	item.Name = strings.TrimSpace(item.Name)
+	item.Line1 = strings.TrimSpace(item.Line1)
+	item.Line2 = strings.TrimSpace(item.Line2)
+	item.City = strings.TrimSpace(item.City)
+	item.Country = strings.ToUpper(strings.TrimSpace(item.Country))
+	item.Region = strings.ToUpper(strings.TrimSpace(item.Region))
+	item.PostalCode = strings.ToUpper(strings.TrimSpace(item.PostalCode))
+	item.Phone = strings.TrimSpace(item.Phone)
+
+	if item.Name == "" || item.Line1 == "" || item.City == "" {
+		return errors.New(400, "The %s address must have a name, an address line and a city!", kind)
+	}
+	if !countryCodePattern.MatchString(item.Country) {
+		return errors.New(400, "The %s address country must be an ISO 3166-1 alpha-2 code!", kind)
+	}
+	if item.Phone != "" && !phonePattern.MatchString(item.Phone) {
+		return errors.New(400, "The %s address phone number %s is not valid!", kind, item.Phone)
+	}
+	rule, ok := addressRules[item.Country]
+	if !ok {
+		return nil
+	}
+	if rule.isRegionRequired && item.Region == "" {
+		return errors.New(400, "The %s address in %s must have a region!", kind, item.Country)
+	}
+	if !rule.postalCode.MatchString(item.PostalCode) {
+		return errors.New(400, "The %s address postal code '%s' is not valid for %s!", kind, item.PostalCode,
+			item.Country)
+	}
+	return nil
+}
+
+func addAddress(params *addresses.AddAddressParams, principal *models.Principal) (*models.Address, errors.Error) {
+	err := isPrincipalOwnerOrAdmin(principal, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := dbModels.NewAddressFrom(params.Body)
+	dbModel.UserID = params.ID
+	err = validateAddress("new", &dbModel.AddressFields)
+	if err != nil {
+		return nil, err
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewInsert().Model(dbModel).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add address %v!\n", sqlErr, params.Body)
+			return errors.New(500, "ERROR: Could not add address!")
+		}
+		dbModel.ID, sqlErr = res.LastInsertId()
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find last insert ID for address %v!", sqlErr, params.Body)
+			return errors.New(500, "ERROR: Could not add address!")
+		}
+		return resetOtherDefaultAddresses(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func updateAddress(params *address.EditAddressParams, principal *models.Principal) (*models.Address, errors.Error) {
+	err := isPrincipalOwnerOrAdmin(principal, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := getDBAddress(params.HTTPRequest.Context(), db, params.ID, params.AddressID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := dbModels.NewAddressFrom(params.Body)
+	dbModel.ID = params.AddressID
+	dbModel.UserID = params.ID
+	dbModel.DateCreated = existing.DateCreated
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	err = validateAddress("updated", &dbModel.AddressFields)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewUpdate().Model(dbModel).ExcludeColumn("id", "date_created").
+			Where("id = ?", params.AddressID).Where("user_id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not update address %d of user %d!\n", sqlErr, params.AddressID, params.ID)
+			return errors.New(500, "ERROR: Could not update address %d!", params.AddressID)
+		}
+		return resetOtherDefaultAddresses(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// resetOtherDefaultAddresses keeps the address the only default shipping and billing address of the user
+func resetOtherDefaultAddresses(ctx context.Context, idb bun.IDB, dbModel *dbModels.Address) errors.Error {
+	columns := map[string]bool{
+		"is_default_shipping": dbModel.IsDefaultShipping,
+		"is_default_billing":  dbModel.IsDefaultBilling,
+	}
+	for column, isDefault := range columns {
+		if !isDefault {
+			continue
+		}
+		query := idb.NewUpdate().Model((*dbModels.Address)(nil)).Set("? = ?", bun.Ident(column), false).
+			Where("user_id = ?", dbModel.UserID).Where("id != ?", dbModel.ID)
+		Logger.Debug("Built the query %s\n", query)
+
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not reset %s of user %d addresses!\n", sqlErr, column, dbModel.UserID)
+			return errors.New(500, "ERROR: Could not update default addresses of user %d!", dbModel.UserID)
+		}
+	}
+	return nil
+}
+
+func deleteAddress(params *address.DeleteAddressParams, principal *models.Principal) errors.Error {
+	err := isPrincipalOwnerOrAdmin(principal, params.ID)
+	if err != nil {
+		return err
+	}
+	// orders keep the snapshots of their addresses, so they are not affected
+	query := db.NewDelete().TableExpr("addresses").Where("id = ?", params.AddressID).Where("user_id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete address %d of user %d!\n", sqlErr, params.AddressID, params.ID)
+		return errors.New(500, "ERROR: Could not delete address %d!", params.AddressID)
+	}
+	return nil
+}
+
+func getAddress(params *address.GetAddressParams, principal *models.Principal) (*models.Address, errors.Error) {
+	err := isPrincipalOwnerOrAdmin(principal, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBAddress(params.HTTPRequest.Context(), db, params.ID, params.AddressID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func allAddresses(params *addresses.ListAddressesParams, principal *models.Principal) ([]*models.Address, errors.Error) {
+	err := isPrincipalOwnerOrAdmin(principal, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbAddresses := make([]*dbModels.Address, 0)
+	query := db.NewSelect().Model(&dbAddresses).Where("user_id = ?", params.ID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find addresses of user %d!\n", sqlErr, params.ID)
+		return nil, errors.New(500, "ERROR: Could not find addresses of user %d!", params.ID)
+	}
+
+	result := make([]*models.Address, len(dbAddresses))
+	for i, m := range dbAddresses {
+		result[i] = m.ToDTO()
+	}
+	return result, nil
+}
+
+func getDBAddress(ctx context.Context, idb bun.IDB, userID int64, id int64) (*dbModels.Address, errors.Error) {
+	dbModel := new(dbModels.Address)
+	query := idb.NewSelect().Model(dbModel).Where("id = ?", id).Where("user_id = ?", userID)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find address %d of user %d!\n", sqlErr, id, userID)
+		return nil, errors.New(404, "Could not find address %d!", id)
+	}
+	return dbModel, nil
+}
+
+// getDefaultDBAddress finds the default address of the user by the default flag column;
+// nil is returned if the user has no such an address
+func getDefaultDBAddress(ctx context.Context, idb bun.IDB, userID int64, column string) (*dbModels.Address, errors.Error) {
+	dbAddresses := make([]*dbModels.Address, 0)
+	query := idb.NewSelect().Model(&dbAddresses).Where("user_id = ?", userID).
+		Where("? = ?", bun.Ident(column), true).Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find default address of user %d!\n", sqlErr, userID)
+		return nil, errors.New(500, "ERROR: Could not find default address of user %d!", userID)
+	}
+	if len(dbAddresses) == 0 {
+		return nil, nil
+	}
+	return dbAddresses[0], nil
+}
+
+// resolveOrderAddresses fills the order address snapshots from the given addresses, then from the given
+// address book entries, then from the snapshots of the existing order (if it is updated), then from
+// the default addresses of the user. The billing address falls back to the shipping one.
+// The delivery country and region used for taxes come from the shipping address.
+func resolveOrderAddresses(ctx context.Context, idb bun.IDB, order *dbModels.Order, dto *models.Order, existing *dbModels.Order) errors.Error {
+	resolve := func(given *models.Address, addressID int64, snapshot *dbModels.AddressFields, defaultColumn string) (dbModels.AddressFields, errors.Error) {
+		if given != nil {
+			return dbModels.NewAddressFieldsFrom(given), nil
+		}
+		if addressID > 0 {
+			entry, err := getDBAddress(ctx, idb, order.UserID, addressID)
+			if err != nil {
+				return dbModels.AddressFields{}, err
+			}
+			return entry.AddressFields, nil
+		}
+		if snapshot != nil && !snapshot.IsEmpty() {
+			return *snapshot, nil
+		}
+		entry, err := getDefaultDBAddress(ctx, idb, order.UserID, defaultColumn)
+		if err != nil || entry == nil {
+			return dbModels.AddressFields{}, err
+		}
+		return entry.AddressFields, nil
+	}
+
+	var shippingSnapshot, billingSnapshot *dbModels.AddressFields
+	if existing != nil {
+		shippingSnapshot = &existing.ShippingAddress
+		billingSnapshot = &existing.BillingAddress
+	}
+
+	var err errors.Error
+	order.ShippingAddress, err = resolve(dto.ShippingAddress, dto.ShippingAddressID, shippingSnapshot,
+		"is_default_shipping")
+	if err != nil {
+		return err
+	}
+	if order.ShippingAddress.IsEmpty() {
+		return errors.New(400, "Order must have a shipping address!")
+	}
+	err = validateAddress("shipping", &order.ShippingAddress)
+	if err != nil {
+		return err
+	}
+
+	order.BillingAddress, err = resolve(dto.BillingAddress, dto.BillingAddressID, billingSnapshot,
+		"is_default_billing")
+	if err != nil {
+		return err
+	}
+	if order.BillingAddress.IsEmpty() {
+		order.BillingAddress = order.ShippingAddress
+	}
+	err = validateAddress("billing", &order.BillingAddress)
+	if err != nil {
+		return err
+	}
+
+	order.DeliveryCountry = order.ShippingAddress.Country
+	order.DeliveryRegion = order.ShippingAddress.Region
+	return nil
+}
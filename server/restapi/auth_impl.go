@@ -232,6 +232,13 @@ func getUserInfo(token string) (userInfo *models.UserInfo, err error) {
 		Logger.Info("getUserInfo: Error while parsing user info: %v", err)
 		return nil, fmt.Errorf("getUserInfo: Error while parsing user info: %s", err.Error())
 	} else {
+		// OpenID Connect providers report the verification as the email_verified claim
+		claims := struct {
+			EmailVerified bool `json:"email_verified"`
+		}{}
+		if json.Unmarshal(bytes, &claims) == nil && claims.EmailVerified {
+			userInfo.EmailVerified = true
+		}
 		user, err := getUserByEmail(userInfo.Email)
 		if err != nil {
 			Logger.Info("getUserInfo: WARN: %v\nCould not find a registered user by email %s!", err, userInfo.Email)
@@ -0,0 +1,143 @@
+package restapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CarrierLabel is a shipping label created by a carrier
+type CarrierLabel struct {
+	LabelURL       string
+	TrackingNumber string
+	TrackingURL    string
+}
+
+// TrackingUpdate is a tracking status of a shipment reported by a carrier;
+// the status is one of the shipment statuses (e. g., models.ShipmentStatusInTransit)
+type TrackingUpdate struct {
+	Details        string `json:"details"`
+	Status         string `json:"status"`
+	TrackingNumber string `json:"trackingNumber"`
+}
+
+// Carrier is an integration with a shipping carrier
+type Carrier interface {
+	// CreateLabel creates the label of the shipment delivered to the order shipping address
+	CreateLabel(ctx context.Context, order *dbModels.Order, shipment *dbModels.Shipment) (*CarrierLabel, error)
+
+	// GetTrackingStatus polls the carrier for the current tracking status of the shipment
+	GetTrackingStatus(ctx context.Context, trackingNumber string) (*TrackingUpdate, error)
+
+	// ParseTrackingEvent verifies the signature of the tracking webhook payload and parses its updates
+	ParseTrackingEvent(payload []byte, signature string) ([]*TrackingUpdate, error)
+}
+
+const fakeCarrierName = "fake"
+
+var carriers = make(map[string]Carrier)
+
+func normalizeCarrierName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func registerCarrier(name string, carrier Carrier) {
+	carriers[normalizeCarrierName(name)] = carrier
+}
+
+// getCarrier returns the integration of the carrier or nil for carriers without integration
+func getCarrier(name string) Carrier {
+	return carriers[normalizeCarrierName(name)]
+}
+
+// registerCarriers registers the integrations of the carriers listed in the configuration
+func registerCarriers() {
+	for name, conf := range ApiConfiguration.Shipping.Carriers {
+		switch normalizeCarrierName(name) {
+		case fakeCarrierName:
+			registerCarrier(name, newFakeCarrier(conf.WebhookSecret))
+		default:
+			Logger.Error("Carrier %s has no integration; its shipments can only be tracked manually", name)
+			continue
+		}
+		Logger.Info("Registered carrier %s", name)
+	}
+}
+
+// fakeCarrier is a local carrier for tests and development: it issues sequential tracking numbers,
+// keeps the tracking statuses in memory and accepts webhook events signed with a hex HMAC-SHA256 of the payload
+type fakeCarrier struct {
+	mutex         sync.Mutex
+	lastNumber    int64
+	statuses      map[string]*TrackingUpdate
+	webhookSecret string
+}
+
+func newFakeCarrier(webhookSecret string) *fakeCarrier {
+	return &fakeCarrier{
+		statuses:      make(map[string]*TrackingUpdate),
+		webhookSecret: webhookSecret,
+	}
+}
+
+func (c *fakeCarrier) CreateLabel(ctx context.Context, order *dbModels.Order, shipment *dbModels.Shipment) (*CarrierLabel, error) {
+	if order.ShippingAddress.IsEmpty() {
+		return nil, fmt.Errorf("order %d has no shipping address", order.ID)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lastNumber++
+	trackingNumber := fmt.Sprintf("FAKE%010d", c.lastNumber)
+	c.statuses[trackingNumber] = &TrackingUpdate{Status: models.ShipmentStatusPending, TrackingNumber: trackingNumber}
+	return &CarrierLabel{
+		LabelURL:       "https://carrier.invalid/labels/" + trackingNumber + ".pdf",
+		TrackingNumber: trackingNumber,
+		TrackingURL:    "https://carrier.invalid/tracking/" + trackingNumber,
+	}, nil
+}
+
+func (c *fakeCarrier) GetTrackingStatus(ctx context.Context, trackingNumber string) (*TrackingUpdate, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	update, ok := c.statuses[trackingNumber]
+	if !ok {
+		return nil, fmt.Errorf("unknown tracking number %s", trackingNumber)
+	}
+	result := *update
+	return &result, nil
+}
+
+// SetTrackingStatus simulates the progress of the shipment for the following polls
+func (c *fakeCarrier) SetTrackingStatus(trackingNumber string, status string, details string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.statuses[trackingNumber] = &TrackingUpdate{Details: details, Status: status, TrackingNumber: trackingNumber}
+}
+
+func (c *fakeCarrier) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(c.webhookSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *fakeCarrier) ParseTrackingEvent(payload []byte, signature string) ([]*TrackingUpdate, error) {
+	if c.webhookSecret == "" || !hmac.Equal([]byte(strings.ToLower(signature)), []byte(c.Sign(payload))) {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	update := new(TrackingUpdate)
+	if err := json.Unmarshal(payload, update); err != nil {
+		return nil, err
+	}
+	if update.TrackingNumber == "" {
+		return nil, fmt.Errorf("no tracking number")
+	}
+	c.SetTrackingStatus(update.TrackingNumber, update.Status, update.Details)
+	return []*TrackingUpdate{update}, nil
+}
@@ -0,0 +1,391 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/cart"
+	"estore-backend/server/restapi/operations/orders"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+const cartTokenKind = "cart"
+
+// cartTokenTTL is the time an anonymous cart token is valid for; the token is renewed with every cart response
+const cartTokenTTL = 30 * 24 * time.Hour
+
+func getCart(params *cart.GetCartParams, principal *models.Principal) (*models.Cart, errors.Error) {
+	ctx := params.HTTPRequest.Context()
+	dbCart, err := resolveCart(ctx, principal, params.XCartToken, false)
+	if err != nil {
+		return nil, err
+	}
+	cartCurrency := ""
+	if dbCart != nil {
+		cartCurrency = dbCart.Currency
+	}
+	currency, err := resolveCurrency(params.XCurrency, cartCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if dbCart == nil {
+		// nothing has been put to the cart yet
+		return &models.Cart{Currency: currency, Items: []*models.CartItem{}, TotalPrice: dbModels.MoneyDTO(0, currency)}, nil
+	}
+	return revalidateCart(ctx, dbCart, currency)
+}
+
+// updateCart replaces the cart items; the currency given in the body is kept for the later requests of the cart
+func updateCart(params *cart.UpdateCartParams, principal *models.Principal) (*models.Cart, errors.Error) {
+	ctx := params.HTTPRequest.Context()
+	dbCart, err := resolveCart(ctx, principal, params.XCartToken, true)
+	if err != nil {
+		return nil, err
+	}
+	if params.Body.Currency != "" {
+		dbCart.Currency, err = resolveCurrency(&params.Body.Currency, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	currency, err := resolveCurrency(params.XCurrency, dbCart.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	dbCart.Items = mergeCartItems(nil, dbModels.CartItemsFromCartItemDTOs(params.Body.Items))
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		return saveCartItems(ctx, tx, dbCart)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return revalidateCart(ctx, dbCart, currency)
+}
+
+func clearCart(params *cart.ClearCartParams, principal *models.Principal) errors.Error {
+	ctx := params.HTTPRequest.Context()
+	dbCart, err := resolveCart(ctx, principal, params.XCartToken, false)
+	if err != nil || dbCart == nil {
+		return err
+	}
+
+	dbCart.Items = nil
+	return runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		return saveCartItems(ctx, tx, dbCart)
+	})
+}
+
+// checkoutCart converts the cart of the current user into an order and empties the cart
+func checkoutCart(params *cart.CheckoutCartParams, principal *models.Principal) (*models.Order, errors.Error) {
+	ctx := params.HTTPRequest.Context()
+	if principal == nil || principal.User == nil || principal.User.ID < 1 {
+		return nil, errors.New(403, "Unregistered users are forbidden!")
+	}
+	dbCart, err := resolveCart(ctx, principal, params.XCartToken, false)
+	if err != nil {
+		return nil, err
+	}
+	if dbCart == nil {
+		return nil, errors.New(400, "Cart is empty!")
+	}
+	currency, err := resolveCurrency(params.XCurrency, dbCart.Currency)
+	if err != nil {
+		return nil, err
+	}
+	orderedProducts, err := orderedProductsFromCart(ctx, dbCart, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalPrice int64 = 0
+	for _, product := range orderedProducts {
+		totalPrice += dbModels.MoneyAmount(product.TotalPrice)
+	}
+	orderParams := orders.NewAddOrderParams()
+	orderParams.HTTPRequest = params.HTTPRequest
+	orderParams.XCurrency = &currency
+	orderParams.Body = &models.Order{
+		BillingAddress:    params.Body.BillingAddress,
+		BillingAddressID:  params.Body.BillingAddressID,
+		CouponCode:        params.Body.CouponCode,
+		DeliveryInfo:      params.Body.DeliveryInfo,
+		Products:          orderedProducts,
+		ShippingAddress:   params.Body.ShippingAddress,
+		ShippingAddressID: params.Body.ShippingAddressID,
+		ShippingMethodID:  params.Body.ShippingMethodID,
+		TotalPrice:        dbModels.MoneyDTO(totalPrice, currency),
+		UserID:            principal.User.ID,
+	}
+	orderDTO, err := addOrder(&orderParams, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	dbCart.Items = nil
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		return saveCartItems(ctx, tx, dbCart)
+	})
+	if err != nil {
+		Logger.Error("Could not empty cart %d after creating order %d: %s", dbCart.ID, orderDTO.ID, err.Error())
+	}
+	return orderDTO, nil
+}
+
+// resolveCart finds the cart of the registered user or the anonymous cart identified by the cart token.
+// An anonymous cart presented by a registered user is merged into the user's cart (e. g., right after login).
+// If create is set, a missing cart is created; otherwise nil is returned for it.
+func resolveCart(ctx context.Context, principal *models.Principal, token *string, create bool) (*dbModels.Cart, errors.Error) {
+	var anonymousCart *dbModels.Cart
+	if token != nil && *token != "" {
+		cartID, err := parseSignedToken(cartTokenKind, *token)
+		if err != nil {
+			return nil, err
+		}
+		anonymousCart, err = getDBCart(ctx, "id = ?", cartID)
+		if err != nil {
+			return nil, err
+		}
+		if anonymousCart != nil && anonymousCart.UserID != 0 {
+			// the token was issued before the cart has been merged or attached to a user
+			anonymousCart = nil
+		}
+	}
+
+	if principal == nil || principal.User == nil || principal.User.ID < 1 {
+		if anonymousCart == nil && create {
+			return createDBCart(ctx, 0)
+		}
+		return anonymousCart, nil
+	}
+
+	userCart, err := getDBCart(ctx, "user_id = ?", principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if anonymousCart == nil {
+		if userCart == nil && create {
+			return createDBCart(ctx, principal.User.ID)
+		}
+		return userCart, nil
+	}
+	if userCart == nil {
+		// attaching the anonymous cart to the user
+		anonymousCart.UserID = principal.User.ID
+		query := db.NewUpdate().Model(anonymousCart).Column("user_id").Where("id = ?", anonymousCart.ID)
+		Logger.Debug("Built the query %s\n", query)
+		if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not attach cart %d to user %d!\n", sqlErr, anonymousCart.ID, principal.User.ID)
+			return nil, errors.New(500, "ERROR: Could not update cart!")
+		}
+		return anonymousCart, nil
+	}
+
+	userCart.Items = mergeCartItems(userCart.Items, anonymousCart.Items)
+	if userCart.Currency == "" {
+		userCart.Currency = anonymousCart.Currency
+	}
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		if err := saveCartItems(ctx, tx, userCart); err != nil {
+			return err
+		}
+		return deleteDBCart(ctx, tx, anonymousCart.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	Logger.Debug("Merged anonymous cart %d into user %d cart %d", anonymousCart.ID, principal.User.ID, userCart.ID)
+	return userCart, nil
+}
+
+func getDBCart(ctx context.Context, where string, arg int64) (*dbModels.Cart, errors.Error) {
+	dbCarts := make([]*dbModels.Cart, 0)
+	query := db.NewSelect().Model(&dbCarts).Relation("Items").Where(where, arg).Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find cart!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find cart!")
+	}
+	if len(dbCarts) == 0 {
+		return nil, nil
+	}
+	return dbCarts[0], nil
+}
+
+func createDBCart(ctx context.Context, userID int64) (*dbModels.Cart, errors.Error) {
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbCart := &dbModels.Cart{
+		DateCreated: nowUnixEpoch,
+		DateUpdated: nowUnixEpoch,
+		UserID:      userID,
+	}
+	query := db.NewInsert().Model(dbCart).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add cart!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not add cart!")
+	}
+	dbCart.ID, sqlErr = res.LastInsertId()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find last insert ID for cart!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not add cart!")
+	}
+	return dbCart, nil
+}
+
+func deleteDBCart(ctx context.Context, idb bun.IDB, cartID int64) errors.Error {
+	query := idb.NewDelete().TableExpr("cart_items").Where("cart_id = ?", cartID)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete cart %d items!\n", sqlErr, cartID)
+		return errors.New(500, "ERROR: Could not delete cart %d!", cartID)
+	}
+
+	query = idb.NewDelete().TableExpr("carts").Where("id = ?", cartID)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete cart %d!\n", sqlErr, cartID)
+		return errors.New(500, "ERROR: Could not delete cart %d!", cartID)
+	}
+	return nil
+}
+
+// saveCartItems replaces the stored cart items with the ones of the given cart
+func saveCartItems(ctx context.Context, idb bun.IDB, dbCart *dbModels.Cart) errors.Error {
+	delQuery := idb.NewDelete().TableExpr("cart_items").Where("cart_id = ?", dbCart.ID)
+	Logger.Debug("Built the query %s\n", delQuery)
+	if _, sqlErr := delQuery.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete cart %d items!\n", sqlErr, dbCart.ID)
+		return errors.New(500, "ERROR: Could not update cart %d!", dbCart.ID)
+	}
+
+	if len(dbCart.Items) > 0 {
+		for _, item := range dbCart.Items {
+			item.ID = 0
+			item.CartID = dbCart.ID
+		}
+		insQuery := idb.NewInsert().Model(&dbCart.Items).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", insQuery)
+		if _, sqlErr := insQuery.Exec(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add cart %d items!\n", sqlErr, dbCart.ID)
+			return errors.New(500, "ERROR: Could not update cart %d!", dbCart.ID)
+		}
+	}
+
+	dbCart.DateUpdated = time.Now().In(time.UTC).Unix()
+	updQuery := idb.NewUpdate().Model(dbCart).Column("currency", "date_updated").Where("id = ?", dbCart.ID)
+	Logger.Debug("Built the query %s\n", updQuery)
+	if _, sqlErr := updQuery.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update cart %d!\n", sqlErr, dbCart.ID)
+		return errors.New(500, "ERROR: Could not update cart %d!", dbCart.ID)
+	}
+	return nil
+}
+
+// mergeCartItems adds the quantities of the added items to the items of the same products
+func mergeCartItems(items []*dbModels.CartItem, added []*dbModels.CartItem) []*dbModels.CartItem {
+	result := make([]*dbModels.CartItem, 0, len(items)+len(added))
+	byProduct := make(map[int64]*dbModels.CartItem)
+	for _, item := range append(append([]*dbModels.CartItem{}, items...), added...) {
+		if existing, ok := byProduct[item.ProductID]; ok {
+			existing.Quantity += item.Quantity
+			continue
+		}
+		byProduct[item.ProductID] = item
+		result = append(result, item)
+	}
+	return result
+}
+
+// revalidateCart checks the cart items against the actual product prices in the currency and stock,
+// drops unavailable products, limits quantities by the stock and stores the changes
+func revalidateCart(ctx context.Context, dbCart *dbModels.Cart, currency string) (*models.Cart, errors.Error) {
+	converter, err := newCurrencyConverter(ctx, db, currency)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.Cart{
+		Currency:    currency,
+		DateUpdated: dbCart.DateUpdated,
+		ID:          dbCart.ID,
+		Items:       make([]*models.CartItem, 0, len(dbCart.Items)),
+		Messages:    make([]string, 0),
+		TotalPrice:  dbModels.MoneyDTO(0, currency),
+		UserID:      dbCart.UserID,
+	}
+	if dbCart.UserID == 0 {
+		result.Token = signToken(cartTokenKind, dbCart.ID, cartTokenTTL)
+	}
+	if len(dbCart.Items) == 0 {
+		return result, nil
+	}
+
+	productIDs := make([]int64, len(dbCart.Items))
+	for i, item := range dbCart.Items {
+		productIDs[i] = item.ProductID
+	}
+	actualProducts := make([]*dbModels.Product, 0)
+	query := db.NewSelect().Model(&actualProducts).Where("id in (?)", bun.In(productIDs))
+	Logger.Debug("Built the query %s\n", query)
+	if sqlErr := query.Scan(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find products for cart %d!\n", sqlErr, dbCart.ID)
+		return nil, errors.New(500, "ERROR: Could not find products for cart %d!", dbCart.ID)
+	}
+	products := make(map[int64]*dbModels.Product, len(actualProducts))
+	for _, product := range actualProducts {
+		products[product.ID] = product
+	}
+
+	isChanged := false
+	validItems := make([]*dbModels.CartItem, 0, len(dbCart.Items))
+	for _, item := range dbCart.Items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			result.Messages = append(result.Messages, fmt.Sprintf("Product %d is no longer available", item.ProductID))
+			isChanged = true
+			continue
+		}
+		if product.NumberInStock > 0 && item.Quantity > product.NumberInStock {
+			result.Messages = append(result.Messages, fmt.Sprintf("Only %d of %s are in stock; quantity reduced from %d",
+				product.NumberInStock, *product.Title, item.Quantity))
+			item.Quantity = product.NumberInStock
+			isChanged = true
+		}
+		validItems = append(validItems, item)
+
+		inStock := product.NumberInStock > 0
+		if !inStock {
+			result.Messages = append(result.Messages, fmt.Sprintf("%s is out of stock", *product.Title))
+		}
+		unitPrice, err := converter.productPrice(product)
+		if err != nil {
+			return nil, err
+		}
+		itemDTO := item.ToDTO()
+		itemDTO.ProductName = *product.Title
+		itemDTO.Price = dbModels.MoneyDTO(unitPrice, currency)
+		itemDTO.TotalPrice = dbModels.MoneyDTO(CalculateProductTotalPrice(unitPrice, &item.Quantity), currency)
+		itemDTO.InStock = &inStock
+		result.Items = append(result.Items, itemDTO)
+		*result.TotalPrice.Amount += *itemDTO.TotalPrice.Amount
+	}
+
+	if isChanged {
+		dbCart.Items = validItems
+		err := runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+			return saveCartItems(ctx, tx, dbCart)
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.DateUpdated = dbCart.DateUpdated
+	}
+	return result, nil
+}
@@ -2,6 +2,7 @@ package restapi
 
 import (
 	"context"
+	"database/sql"
 	dbModels "estore-backend/server/database/models"
 	"estore-backend/server/models"
 	"estore-backend/server/restapi/operations/categories"
@@ -11,36 +12,49 @@ import (
 )
 
 func addCategory(item *models.Category) error {
-	log.Printf("adding item %s\n%s\n%s", item, &item, *item)
+	log.Printf("adding item %v\n%v\n%v", item, &item, *item)
 	if item == nil {
 		return errors.New(500, "DB item cannot be nil!")
 	}
 
 	dbModel := dbModels.NewCategoryFrom(item)
+	dbModel.Version = 1
 	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
 	log.Printf("Built the query %s\n", query)
 
 	_, err := query.Exec(context.Background())
 	if err != nil {
-		return errors.New(500, "ERROR %v: Could not add category %s!\n", err, item)
+		return errors.New(500, "ERROR %v: Could not add category %v!\n", err, item)
 	}
 	return nil
 }
 
-func updateCategory(id int64, item *models.Category) error {
+// updateCategory replaces the category if it has not been modified since the version the If-Match header refers to;
+// the new version is returned
+func updateCategory(ctx context.Context, id int64, item *models.Category, ifMatch string) (int64, errors.Error) {
 	if item == nil {
-		return errors.New(500, "Empty category!")
+		return 0, errors.New(500, "Empty category!")
 	}
 	dbModel := dbModels.NewCategoryFrom(item)
-	query := db.NewUpdate().Where("id = ?", id).Model(dbModel).ExcludeColumn("id")
-	log.Printf("Built the query %s\n", query)
-
-	_, err := query.Exec(context.Background())
+	err := runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		version, err := checkIfMatch(ctx, tx, "categories", id, ifMatch)
+		if err != nil {
+			return err
+		}
+		dbModel.Version = version + 1
+		query := tx.NewUpdate().Where("id = ?", id).Where("COALESCE(version, 0) = ?", version).Model(dbModel).ExcludeColumn("id")
+		log.Printf("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			return errors.New(500, "ERROR %v: Could not update category %d!\n", sqlErr, id)
+		}
+		return checkVersionedUpdate(res, "categories", id)
+	})
 	if err != nil {
-		return errors.New(500, "ERROR %v: Could not update category %d!\n", err, id)
+		return 0, err
 	}
-
-	return nil
+	return dbModel.Version, nil
 }
 
 func deleteCategory(id int64) error {
@@ -55,7 +69,31 @@ func deleteCategory(id int64) error {
 	return nil
 }
 
-func getCategory(id int64) (result *models.Category, err error) {
+// patchCategory applies the merge patch to the category; the new version is returned
+func patchCategory(ctx context.Context, id int64, patch interface{}, ifMatch *string) (*models.Category, int64, errors.Error) {
+	current, version, err := getCategory(id)
+	if err != nil {
+		return nil, 0, err.(errors.Error)
+	}
+	patchErr := checkPatchPrecondition("categories", id, ifMatch, version)
+	if patchErr != nil {
+		return nil, 0, patchErr
+	}
+	item := new(models.Category)
+	patchErr = applyMergePatch("category", current, patch, item)
+	if patchErr != nil {
+		return nil, 0, patchErr
+	}
+	item.ID = id
+
+	version, patchErr = updateCategory(ctx, id, item, entityETag(version))
+	if patchErr != nil {
+		return nil, 0, patchErr
+	}
+	return item, version, nil
+}
+
+func getCategory(id int64) (result *models.Category, version int64, err error) {
 	dbModel := new(dbModels.Category)
 
 	query := db.NewSelect().Model(dbModel).Where("id = ?", id)
@@ -63,11 +101,14 @@ func getCategory(id int64) (result *models.Category, err error) {
 
 	err = query.Scan(context.Background())
 	if err != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find category %d!\n", err, id)
+		if err == sql.ErrNoRows {
+			return nil, 0, errors.New(404, "Could not find category %d!", id)
+		}
+		return nil, 0, errors.New(500, "ERROR %v: Could not find category %d!\n", err, id)
 	}
 
 	result = dbModel.ToDTO()
-	return result, nil
+	return result, dbModel.Version, nil
 }
 
 func allCategories(params *categories.ListCategoriesParams) (result []*models.Category, err error) {
@@ -87,7 +128,7 @@ func allCategories(params *categories.ListCategoriesParams) (result []*models.Ca
 
 	err = query.Scan(context.Background())
 	if err != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find categories matching %s!\n", err, params)
+		return nil, errors.New(500, "ERROR %v: Could not find categories matching %v!\n", err, params)
 	}
 
 	result = make([]*models.Category, len(dbModel))
@@ -1,19 +1,20 @@
 package restapi
 
 import (
-	"encoding/json"
+	"context"
 	dbModels "estore-backend/server/database/models"
 	"estore-backend/server/models"
 	"estore-backend/server/restapi/operations/checkout"
 	"estore-backend/server/restapi/operations/webhooks"
+	"fmt"
 	"github.com/go-openapi/errors"
-	"github.com/stripe/stripe-go/v76"
-	"github.com/stripe/stripe-go/v76/checkout/session"
-	"github.com/stripe/stripe-go/v76/webhook"
+	"github.com/uptrace/bun"
 	"io"
+	"net/http"
+	"time"
 )
 
-func createCheckoutSession(params *checkout.AddCheckoutSessionParams, principal *models.Principal) (*string, errors.Error) {
+func createCheckoutSession(params *checkout.AddCheckoutSessionParams, principal *models.Principal) (*models.CheckoutSessionSecret, errors.Error) {
 	// reject unregistered users, restrict further queries by user's ID for non-admin users
 	isAdmin, err := isPrincipalAdmin(principal)
 	if err != nil {
@@ -24,267 +25,433 @@ func createCheckoutSession(params *checkout.AddCheckoutSessionParams, principal
 	if err != nil {
 		return nil, err
 	}
+	return createOrderCheckoutSession(params.HTTPRequest.Context(), order, principal.User.ID)
+}
 
-	var lineItems []*stripe.CheckoutSessionLineItemParams = make([]*stripe.CheckoutSessionLineItemParams, len(order.Products))
-	for i, p := range order.Products {
-		lineItems[i] = &stripe.CheckoutSessionLineItemParams{
-			// TODO add a product price or fetch products from the DB
+// the failure code and message of the payments whose checkout sessions have expired
+const (
+	checkoutSessionExpiredCode    = "expired"
+	checkoutSessionExpiredMessage = "The checkout session has expired."
+)
 
-			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
-				Currency: stripe.String("usd"),
-				ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
-					Name: stripe.String(p.ProductName),
-				},
-				UnitAmountDecimal: stripe.Float64(*p.TotalPrice / float64(*p.Quantity) * 100),
-			},
+// the checkouts of an order are serialized by a lease stored in its row, so the orders never get two checkout
+// sessions at once, whichever instances of the API start them; a lease outlives the gateway calls made under it
+// and is waited for a few seconds at most
+const (
+	orderCheckoutLeaseSeconds = 120
+	orderCheckoutLeaseRetry   = 100 * time.Millisecond
+)
 
-			Quantity: stripe.Int64(*p.Quantity),
+// orderCheckoutLeaseWait is how long a checkout waits for the lease held by another one
+var orderCheckoutLeaseWait = 10 * time.Second
+
+// lockOrderCheckout takes the checkout lease of the order; the returned function gives it back
+func lockOrderCheckout(ctx context.Context, orderID int64) (func(), errors.Error) {
+	deadline := time.Now().Add(orderCheckoutLeaseWait)
+	for {
+		now := time.Now().In(time.UTC).Unix()
+		until := now + orderCheckoutLeaseSeconds
+		query := db.NewUpdate().TableExpr("orders").
+			Set("checkout_lease_until = ?", until).
+			Where("id = ?", orderID).
+			Where("COALESCE(checkout_lease_until, 0) < ?", now)
+		Logger.Debug("Built the query %s\n", query)
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not lock order %d checkout!\n", sqlErr, orderID)
+			return nil, errors.New(500, "ERROR: Could not lock order %d checkout!", orderID)
+		}
+		if affected, _ := res.RowsAffected(); affected == 1 {
+			return func() { unlockOrderCheckout(orderID, until) }, nil
 		}
+		if time.Now().After(deadline) {
+			return nil, errors.New(409, "Order %d is being checked out; retry later!", orderID)
+		}
+		time.Sleep(orderCheckoutLeaseRetry)
 	}
+}
 
-	sessionParams := &stripe.CheckoutSessionParams{
-		UIMode:               stripe.String("embedded"),
-		ReturnURL:            stripe.String(ApiConfiguration.AppFrontEndHost + "/cart?session_id={CHECKOUT_SESSION_ID}"),
-		RedirectOnCompletion: stripe.String("if_required"),
-		LineItems:            lineItems,
-		Mode:                 stripe.String(string(stripe.CheckoutSessionModePayment)),
-		AutomaticTax:         &stripe.CheckoutSessionAutomaticTaxParams{Enabled: stripe.Bool(true)},
+// unlockOrderCheckout gives back the checkout lease of the order unless it has expired and been taken by another one
+func unlockOrderCheckout(orderID int64, until int64) {
+	query := db.NewUpdate().TableExpr("orders").
+		Set("checkout_lease_until = NULL").
+		Where("id = ?", orderID).
+		Where("checkout_lease_until = ?", until)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(context.Background()); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not unlock order %d checkout!\n", sqlErr, orderID)
+	}
+}
+
+// createOrderCheckoutSession starts the checkout of the order with the active payment gateway, reserves
+// the ordered stock and records the intended payment; the open checkout session of the order is returned
+// instead if it can still be paid
+func createOrderCheckoutSession(ctx context.Context, order *dbModels.Order, userID int64) (*models.CheckoutSessionSecret, errors.Error) {
+	gateway := activePaymentGateway()
+	if gateway == nil {
+		return nil, errors.New(503, "Checkout is not available!")
+	}
+	unlock, err := lockOrderCheckout(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// the order may have been changed, paid or cancelled before the lease was taken, so its current state is charged
+	order, err = getOrderFromDB(order.ID, true, -1)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOrderPayable(order); err != nil {
+		return nil, err
+	}
+	secret, err := sweepOrderCheckoutSessions(ctx, order, gateway)
+	if err != nil || secret != nil {
+		return secret, err
 	}
-	stripe.Key = ApiConfiguration.Payments.Stripe.Secret
 
-	s, sessionErr := session.New(sessionParams)
+	request := &CheckoutSessionRequest{
+		OrderID:        order.ID,
+		Currency:       order.Currency,
+		LineItems:      make([]*PaymentLineItem, 0, len(order.Products)),
+		DiscountAmount: order.DiscountTotal,
+		Amount:         order.TotalPrice,
+		ReturnURL:      ApiConfiguration.AppFrontEndHost + "/cart?session_id={CHECKOUT_SESSION_ID}",
+	}
+	for _, p := range order.Products {
+		if p.Quantity == nil || *p.Quantity < 1 {
+			return nil, errors.New(409, "Order %d has a product of no quantity and cannot be paid!", order.ID)
+		}
+		// TODO add a product price or fetch products from the DB
+		item := &PaymentLineItem{Name: p.ProductName, UnitAmount: p.TotalPrice / *p.Quantity, Quantity: *p.Quantity}
+		if p.TotalPrice%*p.Quantity != 0 {
+			// the line is charged as a whole rather than losing the minor units of a rounded unit price
+			item = &PaymentLineItem{Name: fmt.Sprintf("%s × %d", p.ProductName, *p.Quantity), UnitAmount: p.TotalPrice,
+				Quantity: 1}
+		}
+		request.LineItems = append(request.LineItems, item)
+	}
+	if order.ShippingPrice > 0 {
+		request.LineItems = append(request.LineItems, &PaymentLineItem{
+			Name:       "Shipping: " + order.ShippingMethodName,
+			UnitAmount: order.ShippingPrice,
+			Quantity:   1,
+		})
+	}
+	// taxes are calculated locally (see applyTaxes) and sent as explicit amounts;
+	// tax inclusive prices already contain them
+	if !order.PricesIncludeTax {
+		for _, t := range orderTaxSummary(order) {
+			request.LineItems = append(request.LineItems, &PaymentLineItem{
+				Name:       fmt.Sprintf("%s (%g%%)", t.Name, t.Rate),
+				UnitAmount: t.Amount,
+				Quantity:   1,
+			})
+		}
+	}
 
-	if sessionErr != nil {
-		Logger.Error("ERROR: session.New: %v", err)
-		return nil, errors.New(500, err.Error())
+	s, gatewayErr := gateway.CreateCheckoutSession(ctx, request)
+	if gatewayErr != nil {
+		Logger.Error("ERROR: Could not create %s checkout session for order %d: %v", gateway.Name(), order.ID, gatewayErr)
+		return nil, errors.New(502, "Could not create checkout session: %s", gatewayErr.Error())
 	}
 
 	var payment *dbModels.Payment = &dbModels.Payment{
 		Amount:            order.TotalPrice,
+		Currency:          order.Currency,
 		OrderID:           order.ID,
-		Status:            "intended",
-		UserID:            principal.User.ID,
+		Status:            paymentStatusIntended,
+		UserID:            userID,
+		Method:            models.PaymentMethodOnline,
+		Gateway:           gateway.Name(),
 		CheckoutSessionID: s.ID,
+		PaymentIntentId:   s.PaymentIntentID,
 	}
-	res, err := createDBPayment(payment)
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		// the session charges the order as it has been read under the lease
+		if _, err := checkIfMatch(ctx, tx, "orders", order.ID, entityETag(order.Version)); err != nil {
+			return err
+		}
+		// the customer who has chosen to transfer the money pays online instead
+		err := cancelOrderOfflinePayments(ctx, tx, order.ID, offlinePaymentSupersededCode,
+			"The order is paid online instead.", orderActorCustomer, userID)
+		if err != nil {
+			return err
+		}
+		if err := reserveOrderStock(ctx, tx, order, payment); err != nil {
+			return err
+		}
+		_, err = createDBPayment(ctx, tx, payment)
+		return err
+	})
 	if err != nil {
-		Logger.Error("Could not process successful payment for intent %v\n%s\nError: %d %s", res, res, err.Code(), err.Error())
+		Logger.Error("Could not record the payment of %s checkout session %s: %d %s", gateway.Name(), s.ID,
+			err.Code(), err.Error())
+		// the session cannot be paid without its payment
+		if _, gatewayErr = gateway.ExpireCheckoutSession(context.Background(), s.ID); gatewayErr != nil {
+			Logger.Error("ERROR: Could not expire %s checkout session %s: %v", gateway.Name(), s.ID, gatewayErr)
+		}
 		return nil, err
 	}
 
-	return &s.ClientSecret, nil
-}
-
-func retrieveCheckoutSession(params *checkout.GetCheckoutSessionParams, principal *models.Principal) (*models.CheckoutSession, errors.Error) {
-	s, err := session.Get(*params.SessionID, nil)
-	if err != nil {
-		Logger.Error("ERROR: session.Get: %v", err)
-		return nil, errors.New(500, err.Error())
-	}
-
-	return &models.CheckoutSession{
-		Status:        string(s.Status),
-		CustomerEmail: s.CustomerDetails.Email,
+	return &models.CheckoutSessionSecret{
+		ClientSecret: s.ClientSecret,
+		SessionID:    s.ID,
+		Gateway:      gateway.Name(),
+		URL:          s.URL,
 	}, nil
 }
 
-func processStripePaymentEvent(params *webhooks.ProcessStripePaymentParams) errors.Error {
-	stripe.Key = ApiConfiguration.Payments.Stripe.Secret
-	headerString := params.HTTPRequest.Header.Get("Stripe-Signature")
-	Logger.Debug("processStripePaymentEvent: stripe signature %s\nStripe.PaymentWebhookSecret %s\nheaderString %s\n",
-		params.StripeSignature, ApiConfiguration.Payments.Stripe.PaymentWebhookSecret, headerString)
-
-	payload, err := io.ReadAll(params.HTTPRequest.Body)
-	if err != nil {
-		Logger.Error("Error reading request body: %v\n", err)
-		return errors.New(500, "Error reading request body")
-	}
-
-	// If you are testing your webhook locally with the Stripe CLI you
-	// can find the endpoint's secret by running `stripe listen`
-	// Otherwise, find your endpoint's secret in your webhook settings
-	// in the Developer Dashboard
-	endpointSecret := ApiConfiguration.Payments.Stripe.PaymentWebhookSecret
-	event, err := webhook.ConstructEvent(payload, params.HTTPRequest.Header.Get("Stripe-Signature"),
-		endpointSecret)
-
-	Logger.Debug(string(payload))
-
-	if err != nil {
-		Logger.Error("processStripePaymentEvent: Could not parse event! error: %s (%v)", err.Error(), err)
-		return errors.New(500, "Could not parse event")
+// sweepOrderCheckoutSessions returns the secret of the open checkout session of the order made through the given
+// gateway if it can still be paid; the other checkout sessions of the order which have not been paid are expired
+// and their payments canceled, all of them if no gateway is given
+func sweepOrderCheckoutSessions(ctx context.Context, order *dbModels.Order, gateway PaymentGateway) (*models.CheckoutSessionSecret, errors.Error) {
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := db.NewSelect().Model(&dbPayments).
+		Where("order_id = ?", order.ID).
+		Where("status IN (?)", bun.In(paymentStatusesOpen)).
+		Where("checkout_session_id != ''").
+		Order("id DESC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d payments!\n", sqlErr, order.ID)
+		return nil, errors.New(500, "ERROR: Could not find order %d payments!", order.ID)
 	}
-
-	switch event.Type {
-	/**
-	canceled
-	processing
-	requires_action
-	requires_capture
-	requires_confirmation
-	requires_payment_method
-	succeeded
-	*/
-	case "payment_intent.created":
-		intent, err := parsePaymentIntent(&event)
-		if err != nil {
-			return errors.New(500, "Could not parse event intent")
+	var result *models.CheckoutSessionSecret
+	for _, payment := range dbPayments {
+		paymentGateway := getPaymentGateway(payment.Gateway)
+		if paymentGateway == nil {
+			return nil, errors.New(503, "Payment gateway %s of payment %d is not available!", payment.Gateway,
+				payment.ID)
 		}
-		go processCreatedPayment(intent)
-		return nil
-	case "payment_intent.succeeded":
-		intent, err := parsePaymentIntent(&event)
-		if err != nil {
-			return errors.New(500, "Could not parse event intent")
+		s, gatewayErr := paymentGateway.GetCheckoutSession(ctx, payment.CheckoutSessionID)
+		if gatewayErr != nil {
+			Logger.Error("ERROR: Could not get %s checkout session %s: %v", paymentGateway.Name(),
+				payment.CheckoutSessionID, gatewayErr)
+			return nil, errors.New(502, "Could not retrieve checkout session: %s", gatewayErr.Error())
 		}
-		go processSuccessfulPayment(intent)
-		return nil
-	case "payment_intent.canceled":
-		intent, err := parsePaymentIntent(&event)
-		if err != nil {
-			return errors.New(500, "Could not parse event intent")
+		status := checkoutSessionPaymentStatus(s)
+		if s.Status == "complete" && status != paymentStatusIntended && status != paymentStatusFailed {
+			return nil, errors.New(409, "Order %d is being paid through checkout session %s!", order.ID, s.ID)
 		}
-		go processCanceledPayment(intent)
-		return nil
-	case "checkout.session.completed":
-		sess, err := parseCheckoutSession(&event)
-		if err != nil {
-			return errors.New(500, "Could not parse checkout session")
+		if result == nil && s.Status == "open" && gateway != nil && paymentGateway.Name() == gateway.Name() &&
+			isCheckoutSessionReusable(order, payment) {
+			Logger.Debug("Reusing checkout session %s of order %d", s.ID, order.ID)
+			result = &models.CheckoutSessionSecret{
+				ClientSecret: s.ClientSecret,
+				SessionID:    s.ID,
+				Gateway:      paymentGateway.Name(),
+				URL:          s.URL,
+			}
+			continue
 		}
-		go processCompletedCheckoutSession(sess)
-	case "payment_intent.processing":
-	case "payment_intent.requires_action":
-	case "payment_intent.requires_capture":
-	case "payment_intent.requires_confirmation":
-	case "payment_intent.requires_payment_method":
-		intent, err := parsePaymentIntent(&event)
-		if err != nil {
-			return errors.New(500, "Could not parse event intent")
+		if err := abandonCheckoutSession(ctx, paymentGateway, payment, s); err != nil {
+			return nil, err
 		}
-		go processOtherPaymentEvent(intent)
-		return nil
-	default:
+	}
+	return result, nil
+}
+
+// checkOrderPayable rejects the payments of the orders which are not waiting for one
+func checkOrderPayable(order *dbModels.Order) errors.Error {
+	if order.Status != models.OrderStatusPendingPayment {
+		return errors.New(409, "Order %d is %s and cannot be paid!", order.ID, order.Status)
 	}
 	return nil
 }
 
-func parseCheckoutSession(event *stripe.Event) (*stripe.CheckoutSession, errors.Error) {
-	var sess stripe.CheckoutSession
-	err := json.Unmarshal(event.Data.Raw, &sess)
+// releaseOrderPayments expires the checkout sessions and cancels the offline payments of the cancelled order,
+// so the stock reserved for them is released without waiting for the sessions to expire
+func releaseOrderPayments(ctx context.Context, order *dbModels.Order, actorRole string, actorID int64) {
+	unlock, err := lockOrderCheckout(ctx, order.ID)
 	if err != nil {
-		return nil, errors.New(500, "Could not parse event session")
+		Logger.Error("Could not release the payments of cancelled order %d: %s", order.ID, err.Error())
+		return
+	}
+	defer unlock()
+	if _, err := sweepOrderCheckoutSessions(ctx, order, nil); err != nil {
+		Logger.Error("Could not expire the checkout sessions of cancelled order %d: %s", order.ID, err.Error())
+	}
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		return cancelOrderOfflinePayments(ctx, tx, order.ID, offlinePaymentOrderCancelledCode,
+			"The order has been cancelled.", actorRole, actorID)
+	})
+	if err != nil {
+		Logger.Error("Could not cancel the offline payments of cancelled order %d: %s", order.ID, err.Error())
 	}
-	return &sess, nil
 }
 
-func processCompletedCheckoutSession(sess *stripe.CheckoutSession) {
-	sessPaymentStatus := string(sess.PaymentStatus)
-	sessPaymentIntentId := sess.PaymentIntent.ID
-
-	if len(sessPaymentStatus) <= 0 || len(sessPaymentIntentId) <= 0 {
-		if sess.PaymentIntent != nil && len(sess.PaymentIntent.Status) > 0 && len(sess.PaymentIntent.ID) > 0 {
-			Logger.Debug("processCompletedCheckoutSession: setting sessPaymentStatus, sessPaymentIntentId from sess.PaymentIntent: %s, %s",
-				sess.PaymentIntent.Status, sess.PaymentIntent.ID)
-			sessPaymentStatus = string(sess.PaymentIntent.Status)
-			sessPaymentIntentId = sess.PaymentIntent.ID
-		} else {
-			Logger.Debug("processCompletedCheckoutSession: setting sessPaymentStatus, sessPaymentIntentId from session payment intent: %s",
-				sess.PaymentIntent)
-			var expand []*string = []*string{stripe.String("payment_intent_data")}
-			fullSess, err := session.Get(sess.ID, &stripe.CheckoutSessionParams{
-				Expand: expand,
-			})
-			if err != nil {
-				Logger.Error("processCompletedCheckoutSession: Could not retrieve session with payment data! error: %s\n%v\n", err.Error(), err)
-			} else {
-				bt, err := json.Marshal(fullSess)
-				ls := ""
-				if err != nil {
-					Logger.Debug("Could not marshal full session, error: %s", err)
-				} else {
-					ls = string(bt)
-				}
-				Logger.Debug("processCompletedCheckoutSession: Full session: %s\n%v\n%s", fullSess, fullSess, ls)
-				sessPaymentStatus = string(fullSess.PaymentIntent.Status)
-				sessPaymentIntentId = fullSess.PaymentIntent.ID
-			}
-		}
+// isCheckoutSessionReusable tells whether the payment of the open checkout session still charges the order total
+// and the session is not about to be expired by the reconciliation
+func isCheckoutSessionReusable(order *dbModels.Order, payment *dbModels.Payment) bool {
+	if payment.Amount != order.TotalPrice || payment.Currency != order.Currency {
+		return false
 	}
-
-	Logger.Debug("processCompletedCheckoutSession: session status %s, payment intent status: %s, payment intent ID: %s",
-		sess.Status, sessPaymentStatus, sessPaymentIntentId)
-
-	processCheckoutSession(sess, string(sess.Status), sessPaymentStatus, sessPaymentIntentId)
+	return payment.DateCreated+checkoutSessionTimeout() > time.Now().In(time.UTC).Unix()
 }
 
-func processCheckoutSession(sess *stripe.CheckoutSession, paymentStatus string, orderStatus string, paymentIntentId string) {
-	processPaidOrder(sess.ID, paymentStatus, orderStatus, paymentIntentId)
+// abandonCheckoutSession expires the checkout session which is not to be paid any more and cancels its payment
+func abandonCheckoutSession(ctx context.Context, gateway PaymentGateway, payment *dbModels.Payment, s *GatewayCheckoutSession) errors.Error {
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	event := &PaymentEvent{
+		ID:                fmt.Sprintf("checkout_%d_%d", payment.ID, nowUnixEpoch),
+		Type:              paymentEventCheckoutExpired,
+		Created:           nowUnixEpoch,
+		CheckoutSessionID: s.ID,
+		PaymentIntentID:   s.PaymentIntentID,
+		SessionStatus:     s.Status,
+		PaymentStatus:     s.PaymentStatus,
+	}
+	if s.Status == "open" {
+		if _, gatewayErr := gateway.ExpireCheckoutSession(ctx, s.ID); gatewayErr != nil {
+			Logger.Error("ERROR: Could not expire %s checkout session %s: %v", gateway.Name(), s.ID, gatewayErr)
+			return errors.New(502, "Could not expire checkout session %s: %s", s.ID, gatewayErr.Error())
+		}
+		event.FailureCode = checkoutSessionExpiredCode
+		event.FailureMessage = checkoutSessionExpiredMessage
+	}
+	Logger.Info("Canceling payment %d of abandoned checkout session %s", payment.ID, s.ID)
+	return applyPaymentEvent(payment, event, paymentStatusCanceled)
 }
 
-func processPayment(intent *stripe.PaymentIntent, paymentStatus string, orderStatus string) {
-	Logger.Debug("processPayment: intent: %s\n")
+// checkoutSessionGateway finds the gateway the checkout session has been created with
+func checkoutSessionGateway(sessionID string) (PaymentGateway, errors.Error) {
+	gatewayName := ApiConfiguration.Payments.Gateway
+	payment, err := getDBPaymentByCheckoutSessionId(sessionID)
+	if err == nil {
+		gatewayName = payment.Gateway
+	}
+	gateway := getPaymentGateway(gatewayName)
+	if gateway == nil {
+		return nil, errors.New(503, "Payment gateway %s is not available!", gatewayName)
+	}
+	return gateway, nil
 }
 
-func processPaidOrder(checkoutSessionId string, paymentStatus string, orderStatus string, paymentIntentId string) {
-	Logger.Debug("processPayment: checkout session ID %s, payment status %s, order status %s, payment intent ID %s",
-		checkoutSessionId, paymentStatus, orderStatus, paymentIntentId)
-	var payment *dbModels.Payment
-	payment, err := getDBPaymentByCheckoutSessionId(checkoutSessionId)
+func retrieveCheckoutSession(params *checkout.GetCheckoutSessionParams, principal *models.Principal) (*models.CheckoutSession, errors.Error) {
+	if params.SessionID == nil || *params.SessionID == "" {
+		return nil, errors.New(400, "Checkout session ID is required!")
+	}
+	gateway, err := checkoutSessionGateway(*params.SessionID)
 	if err != nil {
-		Logger.Error("Could not get payment by checkout session ID %s; error: ", checkoutSessionId, err.Error())
-		return
+		return nil, err
+	}
+	s, gatewayErr := gateway.GetCheckoutSession(params.HTTPRequest.Context(), *params.SessionID)
+	if gatewayErr != nil {
+		Logger.Error("ERROR: Could not get %s checkout session %s: %v", gateway.Name(), *params.SessionID, gatewayErr)
+		return nil, errors.New(502, gatewayErr.Error())
 	}
-	payment.Status = paymentStatus
-	payment.PaymentIntentId = paymentIntentId
 
-	dbPayment, err := updateDBPayment(payment)
+	return &models.CheckoutSession{
+		Status:        s.Status,
+		CustomerEmail: s.CustomerEmail,
+	}, nil
+}
+
+func processStripePaymentEvent(params *webhooks.ProcessStripePaymentParams) errors.Error {
+	return processGatewayWebhook(stripeGatewayName, params.HTTPRequest)
+}
+
+func processGatewayPaymentEvent(params *webhooks.ProcessPaymentEventParams) errors.Error {
+	return processGatewayWebhook(params.Gateway, params.HTTPRequest)
+}
+
+func processGatewayWebhook(gatewayName string, request *http.Request) errors.Error {
+	payload, err := io.ReadAll(request.Body)
 	if err != nil {
-		Logger.Error("Could not process paid order for checkout session ID: ID %v\n%s\npayment  %v\n%s\n",
-			checkoutSessionId, checkoutSessionId, dbPayment, dbPayment)
-		return
-	} else {
-		Logger.Debug("Processed paid order successfully: checkout session ID %v\n%s\npayment  %v\n%s\n",
-			checkoutSessionId, checkoutSessionId, dbPayment, dbPayment)
+		Logger.Error("Error reading request body: %v\n", err)
+		return errors.New(500, "Error reading request body")
 	}
+	Logger.Debug(string(payload))
+	return processPaymentEvent(gatewayName, payload, request.Header)
+}
 
-	order, err := getOrderFromDB(payment.OrderID, true, -1)
-	if err != nil {
-		Logger.Error("Could not find order for payment: error %v\n%s\npayment  %v\n%s\n", err, err, payment, payment)
-		return
-	} else {
-		Logger.Debug("Found order for payment: order %v\n%s\npayment  %v\n%s\n", order, order, payment, payment)
+// processPaymentEvent verifies the webhook event of the gateway and stores it for processing in background;
+// the repeated deliveries of a stored event are acknowledged without being stored again
+func processPaymentEvent(gatewayName string, payload []byte, header http.Header) errors.Error {
+	gateway := getPaymentGateway(gatewayName)
+	if gateway == nil {
+		return errors.New(404, "Payment gateway %s is not available!", gatewayName)
 	}
-	order.Status = orderStatus
-	orderRes, err := updateDBOrder(order)
+	event, err := gateway.ParseWebhookEvent(payload, header)
 	if err != nil {
-		Logger.Error("Could not update order for payment: error %v\n%s\norder  %v\n%s\npayment  %v\n%s\n",
-			err, err, order, order, payment, payment)
-	} else {
-		Logger.Debug("Updated order for payment: order %v\n%s\npayment  %v\n%s\n", orderRes, orderRes, payment, payment)
+		Logger.Error("processPaymentEvent: Could not parse %s event! error: %s (%v)", gatewayName, err.Error(), err)
+		return errors.New(400, "Could not parse event")
 	}
+	return storeWebhookEvent(context.Background(), gateway.Name(), event, payload)
 }
 
-func processCreatedPayment(intent *stripe.PaymentIntent) {
-	processPayment(intent, "created", "intended")
+// handlePaymentEvent processes the stored event; the failed events are retried, so the handlers
+// report the errors worth retrying and tolerate being run again for the same event
+func handlePaymentEvent(gateway PaymentGateway, event *PaymentEvent) errors.Error {
+	switch event.Type {
+	case paymentEventCheckoutCompleted:
+		return processCompletedCheckoutSession(gateway, event)
+	case paymentEventPaymentCreated, paymentEventPaymentSucceeded, paymentEventPaymentFailed,
+		paymentEventPaymentCanceled, paymentEventPaymentUpdated:
+		return processPaymentLifecycleEvent(event)
+	case paymentEventPaymentRefunded:
+		return processRefundedPayment(event)
+	case paymentEventRefundUpdated:
+		return processRefundUpdate(event)
+	case paymentEventCheckoutExpired, paymentEventCheckoutFailed:
+		return processAbandonedCheckoutSession(event)
+	default:
+		Logger.Debug("handlePaymentEvent: ignoring %s event %s of type %s", gateway.Name(), event.ID, event.Type)
+		return nil
+	}
 }
 
-func processSuccessfulPayment(intent *stripe.PaymentIntent) {
-	processPayment(intent, "success", "paid")
-}
+// processCompletedCheckoutSession applies the payment status of the completed checkout session to its payment
+func processCompletedCheckoutSession(gateway PaymentGateway, event *PaymentEvent) errors.Error {
+	if len(event.PaymentStatus) <= 0 || len(event.PaymentIntentID) <= 0 {
+		Logger.Debug("processCompletedCheckoutSession: retrieving the payment of session %s", event.CheckoutSessionID)
+		fullSess, err := gateway.GetCheckoutSession(context.Background(), event.CheckoutSessionID)
+		if err != nil {
+			Logger.Error("processCompletedCheckoutSession: Could not retrieve session with payment data! error: %s\n%v\n", err.Error(), err)
+			return errors.New(502, "Could not retrieve checkout session %s: %s", event.CheckoutSessionID, err.Error())
+		}
+		Logger.Debug("processCompletedCheckoutSession: Full session: %v\n", fullSess)
+		event.PaymentStatus = fullSess.PaymentStatus
+		event.PaymentIntentID = fullSess.PaymentIntentID
+	}
 
-func processCanceledPayment(intent *stripe.PaymentIntent) {
-	processPayment(intent, "canceled", "not_paid")
-}
+	Logger.Debug("processCompletedCheckoutSession: session status %s, payment intent status: %s, payment intent ID: %s",
+		event.SessionStatus, event.PaymentStatus, event.PaymentIntentID)
 
-func processOtherPaymentEvent(intent *stripe.PaymentIntent) {
-	Logger.Debug("processOtherPaymentEvent: client secret %s", intent.ClientSecret)
+	payment, err := getDBPaymentByCheckoutSessionId(event.CheckoutSessionID)
+	if err != nil {
+		Logger.Error("Could not get payment by checkout session ID %s; error: %s", event.CheckoutSessionID, err.Error())
+		return err
+	}
+	status := paymentStatusOfEvent(event)
+	if status == "" {
+		Logger.Info("processCompletedCheckoutSession: unknown payment status %s of checkout session %s",
+			event.PaymentStatus, event.CheckoutSessionID)
+		return nil
+	}
+	return applyPaymentEvent(payment, event, status)
 }
 
-func parsePaymentIntent(event *stripe.Event) (*stripe.PaymentIntent, errors.Error) {
-	var intent stripe.PaymentIntent
-	err := json.Unmarshal(event.Data.Raw, &intent)
+// processAbandonedCheckoutSession cancels the payment of the checkout session which has expired or whose delayed
+// payment has failed and releases the stock reserved for it; the order stays payable, so the customer may start
+// another checkout session
+func processAbandonedCheckoutSession(event *PaymentEvent) errors.Error {
+	payment, err := getDBPaymentByCheckoutSessionId(event.CheckoutSessionID)
 	if err != nil {
-		return nil, errors.New(500, "Could not parse event intent")
+		if err.Code() == 404 {
+			// the sessions expired as their payments could not be recorded have none
+			Logger.Info("processAbandonedCheckoutSession: ignoring %s event %s of unknown checkout session %s",
+				event.Type, event.ID, event.CheckoutSessionID)
+			return nil
+		}
+		return err
+	}
+	if event.Type == paymentEventCheckoutExpired && event.FailureCode == "" {
+		event.FailureCode = checkoutSessionExpiredCode
+		event.FailureMessage = checkoutSessionExpiredMessage
 	}
-	return &intent, nil
+	return applyPaymentEvent(payment, event, paymentStatusCanceled)
 }
@@ -0,0 +1,308 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestCreateOrderCheckoutSessionOfChangedOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		// change of the order made after the caller has read it, before the lease is taken
+		change     string
+		wantCode   int32
+		wantAmount int64
+	}{
+		{"unchanged", "", 0, 2000},
+		{"cancelled", "status = 'cancelled'", 409, 0},
+		{"paid", "status = 'paid'", 409, 0},
+		{"repriced", "total_price_minor = 3000", 0, 3000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			registerTestGateway(t)
+			ctx := context.Background()
+			product := addTestProduct(t, 1000, 5)
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 2, product)
+			stale, err := getOrderFromDB(order.ID, true, -1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.change != "" {
+				query := db.NewUpdate().TableExpr("orders").Set(tt.change).Set("version = version + 1").
+					Where("id = ?", order.ID)
+				if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+					t.Fatal(sqlErr)
+				}
+			}
+
+			_, err = createOrderCheckoutSession(ctx, stale, order.UserID)
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Fatalf("createOrderCheckoutSession() = %v, want %d", err, tt.wantCode)
+				}
+				if n := countTestRows(t, "payments", "order_id = ?", order.ID); n != 0 {
+					t.Errorf("payments = %d, want none", n)
+				}
+				if n := countTestRows(t, "products", "id = ? AND number_in_stock = 5", product.ID); n != 1 {
+					t.Error("stock reserved for the order which cannot be paid")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := countTestRows(t, "payments", "order_id = ? AND amount_minor = ?", order.ID, tt.wantAmount); n != 1 {
+				t.Errorf("payments of %d = %d, want 1", tt.wantAmount, n)
+			}
+		})
+	}
+}
+
+func TestCreateOrderCheckoutSessionAfterRepricing(t *testing.T) {
+	newTestStore(t)
+	registerTestGateway(t)
+	ctx := context.Background()
+	product := addTestProduct(t, 1000, 5)
+	order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+	first, err := createOrderCheckoutSession(ctx, order, order.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := createOrderCheckoutSession(ctx, order, order.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.SessionID != first.SessionID {
+		t.Errorf("checkout session of the unchanged order = %s, want %s reused", again.SessionID, first.SessionID)
+	}
+
+	if _, sqlErr := db.NewUpdate().TableExpr("orders").Set("total_price_minor = 1500").
+		Set("version = version + 1").Where("id = ?", order.ID).Exec(ctx); sqlErr != nil {
+		t.Fatal(sqlErr)
+	}
+	repriced, err := createOrderCheckoutSession(ctx, order, order.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repriced.SessionID == first.SessionID {
+		t.Error("checkout session of the repriced order reused")
+	}
+	if n := countTestRows(t, "payments", "order_id = ? AND status = ?", order.ID, paymentStatusCanceled); n != 1 {
+		t.Errorf("canceled payments = %d, want 1", n)
+	}
+	if n := countTestRows(t, "products", "id = ? AND number_in_stock = 4", product.ID); n != 1 {
+		t.Error("stock is not reserved once for the open checkout session")
+	}
+}
+
+func TestReserveOrderStock(t *testing.T) {
+	tests := []struct {
+		name      string
+		quantity  *int64
+		wantCode  int32
+		wantStock int64
+	}{
+		{"in stock", swag.Int64(2), 0, 3},
+		{"whole stock", swag.Int64(5), 0, 0},
+		{"out of stock", swag.Int64(6), 409, 5},
+		{"zero", swag.Int64(0), 409, 5},
+		{"negative", swag.Int64(-3), 409, 5},
+		{"missing", nil, 409, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			ctx := context.Background()
+			product := addTestProduct(t, 1000, 5)
+			order := &dbModels.Order{ID: 1, Products: []*dbModels.OrderedProduct{
+				{ProductID: swag.Int64(product.ID), Quantity: tt.quantity, ProductName: *product.Title}}}
+			payment := &dbModels.Payment{}
+
+			err := reserveOrderStock(ctx, db, order, payment)
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Errorf("reserveOrderStock() = %v, want %d", err, tt.wantCode)
+				}
+			} else if err != nil || len(payment.ReservedStock) != 1 {
+				t.Errorf("reserveOrderStock() = %v with %d reservations, want one", err, len(payment.ReservedStock))
+			}
+			if n := countTestRows(t, "products", "id = ? AND number_in_stock = ?", product.ID, tt.wantStock); n != 1 {
+				t.Errorf("stock is not %d", tt.wantStock)
+			}
+		})
+	}
+}
+
+// shortenCheckoutLeaseWait keeps the checkouts of the test from waiting long for the leases held by others
+func shortenCheckoutLeaseWait(t *testing.T) {
+	wait := orderCheckoutLeaseWait
+	orderCheckoutLeaseWait = 300 * time.Millisecond
+	t.Cleanup(func() { orderCheckoutLeaseWait = wait })
+}
+
+func TestLockOrderCheckout(t *testing.T) {
+	shortenCheckoutLeaseWait(t)
+	now := time.Now().Unix()
+	tests := []struct {
+		name string
+		// lease of the order taken before, none if zero
+		leaseUntil int64
+		wantCode   int32
+	}{
+		{"free", 0, 0},
+		{"expired lease", now - 1, 0},
+		{"held by another checkout", now + 60, 409},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			ctx := context.Background()
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 1, addTestProduct(t, 1000, 5))
+			if tt.leaseUntil != 0 {
+				if _, err := db.NewUpdate().TableExpr("orders").Set("checkout_lease_until = ?", tt.leaseUntil).
+					Where("id = ?", order.ID).Exec(ctx); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			unlock, err := lockOrderCheckout(ctx, order.ID)
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Fatalf("lockOrderCheckout() = %v, want %d", err, tt.wantCode)
+				}
+				if n := countTestRows(t, "orders", "id = ? AND checkout_lease_until = ?", order.ID, tt.leaseUntil); n != 1 {
+					t.Error("lease of the other checkout has been changed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND checkout_lease_until > ?", order.ID, now); n != 1 {
+				t.Error("order has no lease")
+			}
+			unlock()
+			if n := countTestRows(t, "orders", "id = ? AND checkout_lease_until IS NULL", order.ID); n != 1 {
+				t.Error("lease has not been given back")
+			}
+		})
+	}
+}
+
+func TestLockOrderCheckoutContention(t *testing.T) {
+	shortenCheckoutLeaseWait(t)
+	newTestStore(t)
+	registerTestGateway(t)
+	ctx := context.Background()
+	product := addTestProduct(t, 1000, 5)
+	order := addTestOrder(t, models.OrderStatusPendingPayment, 2, product)
+
+	unlock, err := lockOrderCheckout(ctx, order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = lockOrderCheckout(ctx, order.ID); err == nil || err.Code() != 409 {
+		t.Errorf("lockOrderCheckout() of the leased order = %v, want 409", err)
+	}
+	if _, err = createOrderCheckoutSession(ctx, order, order.UserID); err == nil || err.Code() != 409 {
+		t.Errorf("createOrderCheckoutSession() of the leased order = %v, want 409", err)
+	}
+	if n := countTestRows(t, "payments", "order_id = ?", order.ID); n != 0 {
+		t.Errorf("payments = %d, want none", n)
+	}
+	if n := countTestRows(t, "products", "id = ? AND number_in_stock = 5", product.ID); n != 1 {
+		t.Error("stock reserved without the lease")
+	}
+
+	// the lease has expired and been taken over by another checkout, which the first one must not unlock
+	taken := time.Now().Unix() + 60
+	if _, sqlErr := db.NewUpdate().TableExpr("orders").Set("checkout_lease_until = ?", taken).
+		Where("id = ?", order.ID).Exec(ctx); sqlErr != nil {
+		t.Fatal(sqlErr)
+	}
+	unlock()
+	if n := countTestRows(t, "orders", "id = ? AND checkout_lease_until = ?", order.ID, taken); n != 1 {
+		t.Error("lease of the other checkout has been given back")
+	}
+
+	if _, sqlErr := db.NewUpdate().TableExpr("orders").Set("checkout_lease_until = NULL").
+		Where("id = ?", order.ID).Exec(ctx); sqlErr != nil {
+		t.Fatal(sqlErr)
+	}
+	if _, err = createOrderCheckoutSession(ctx, order, order.UserID); err != nil {
+		t.Fatalf("createOrderCheckoutSession() after the lease is given back = %v", err)
+	}
+	if n := countTestRows(t, "orders", "id = ? AND checkout_lease_until IS NULL", order.ID); n != 1 {
+		t.Error("lease of the checkout has not been given back")
+	}
+}
+
+func TestAbandonedCheckoutReleasesStock(t *testing.T) {
+	tests := []struct {
+		name string
+		// the events of the checkout session in the order of their delivery
+		events          []string
+		wantStatus      string
+		wantFailureCode string
+		wantStock       int64
+	}{
+		{"expired", []string{paymentEventCheckoutExpired}, paymentStatusCanceled, checkoutSessionExpiredCode, 5},
+		{"expired twice", []string{paymentEventCheckoutExpired, paymentEventCheckoutExpired}, paymentStatusCanceled,
+			checkoutSessionExpiredCode, 5},
+		{"failed", []string{paymentEventCheckoutFailed}, paymentStatusCanceled, "", 5},
+		{"paid before the expiry", []string{paymentEventPaymentSucceeded, paymentEventCheckoutExpired},
+			paymentStatusComplete, "", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			gateway := registerTestGateway(t)
+			ctx := context.Background()
+			product := addTestProduct(t, 1000, 5)
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 2, product)
+			secret, err := createOrderCheckoutSession(ctx, order, order.UserID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := countTestRows(t, "products", "id = ? AND number_in_stock = 3", product.ID); n != 1 {
+				t.Fatal("stock has not been reserved for the checkout")
+			}
+			payment, err := getDBPaymentByCheckoutSessionId(secret.SessionID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			created := time.Now().Unix()
+			for i, eventType := range tt.events {
+				event := &PaymentEvent{ID: fmt.Sprintf("evt_%d", i), Type: eventType, Created: created + int64(i),
+					CheckoutSessionID: secret.SessionID, PaymentIntentID: payment.PaymentIntentId}
+				if err = handlePaymentEvent(gateway, event); err != nil {
+					t.Fatalf("%s event: %s", eventType, err)
+				}
+			}
+
+			updated, err := getDBPayment(ctx, db, payment.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.Status != tt.wantStatus || updated.FailureCode != tt.wantFailureCode {
+				t.Errorf("payment = %s failed with %q, want %s failed with %q", updated.Status, updated.FailureCode,
+					tt.wantStatus, tt.wantFailureCode)
+			}
+			if len(updated.ReservedStock) != 0 {
+				t.Errorf("payment still reserves %d products", len(updated.ReservedStock))
+			}
+			if n := countTestRows(t, "products", "id = ? AND number_in_stock = ?", product.ID, tt.wantStock); n != 1 {
+				t.Errorf("stock is not %d", tt.wantStock)
+			}
+		})
+	}
+}
@@ -8,11 +8,33 @@ import (
 	"embed"
 	"encoding/json"
 	"estore-backend/server/logger"
+	"estore-backend/server/restapi/operations/address"
+	"estore-backend/server/restapi/operations/addresses"
+	"estore-backend/server/restapi/operations/cart"
 	"estore-backend/server/restapi/operations/categories"
 	"estore-backend/server/restapi/operations/category"
 	"estore-backend/server/restapi/operations/checkout"
+	"estore-backend/server/restapi/operations/currencies"
+	"estore-backend/server/restapi/operations/guest"
+	"estore-backend/server/restapi/operations/invoice"
+	"estore-backend/server/restapi/operations/invoices"
+	"estore-backend/server/restapi/operations/message"
+	"estore-backend/server/restapi/operations/messages"
 	"estore-backend/server/restapi/operations/order"
 	"estore-backend/server/restapi/operations/orders"
+	"estore-backend/server/restapi/operations/payment"
+	"estore-backend/server/restapi/operations/payments"
+	"estore-backend/server/restapi/operations/promotion"
+	"estore-backend/server/restapi/operations/promotions"
+	"estore-backend/server/restapi/operations/reconciliations"
+	"estore-backend/server/restapi/operations/refunds"
+	"estore-backend/server/restapi/operations/reports"
+	"estore-backend/server/restapi/operations/returns"
+	"estore-backend/server/restapi/operations/shipment"
+	"estore-backend/server/restapi/operations/shipments"
+	"estore-backend/server/restapi/operations/shipping"
+	"estore-backend/server/restapi/operations/tax"
+	"estore-backend/server/restapi/operations/taxes"
 	"estore-backend/server/restapi/operations/user"
 	"estore-backend/server/restapi/operations/users"
 	"estore-backend/server/restapi/operations/webhooks"
@@ -23,6 +45,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"reflect"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
@@ -81,12 +104,89 @@ var ApiConfiguration struct {
 
 	AccessControlAllowOrigin string
 
+	// Secret signing the tokens issued by the API (e. g., anonymous cart tokens)
+	TokenSecret string
+
+	// ISO 4217 code of the currency of the catalog prices and the orders; USD by default
+	Currency string `json:"Currency"`
+
+	// ISO 4217 codes of the other currencies the customers may choose; their prices are set by hand for the products
+	// or converted from the base ones by the exchange rates
+	Currencies []string `json:"Currencies"`
+
+	// Whether the catalog prices include taxes (the tax is extracted from them) or the taxes are added on top
+	Taxes struct {
+		PricesIncludeTax bool `json:"pricesIncludeTax"`
+	} `json:"Taxes"`
+
+	Shipping struct {
+		// Carrier integrations by carrier name; only the "fake" carrier for tests and development is built in
+		Carriers map[string]struct {
+			// Secret verifying the signatures of the carrier tracking webhook events
+			WebhookSecret string `json:"webhookSecret"`
+		} `json:"carriers"`
+
+		// Interval of polling the carriers for the tracking statuses in seconds; zero disables polling
+		TrackingPollInterval int64 `json:"trackingPollInterval"`
+	} `json:"Shipping"`
+
+	// Seller details printed on the invoices and the credit notes
+	Invoices struct {
+		Company struct {
+			Name         string   `json:"name"`
+			AddressLines []string `json:"addressLines"`
+			VATNumber    string   `json:"vatNumber"`
+			Email        string   `json:"email"`
+		} `json:"company"`
+	} `json:"Invoices"`
+
 	Payments struct {
+		// Gateway the checkout sessions are created with: "stripe" (the default) or "fake" for tests and development
+		Gateway string `json:"Gateway"`
+
+		// Fake gateway with a local hosted payment page; enabled by its webhook secret or as the gateway
+		Fake struct {
+			// Secret signing the events of the fake gateway
+			WebhookSecret string `json:"webhookSecret"`
+		} `json:"Fake"`
+
 		Stripe struct {
 			Secret               string `json:"secret"`
 			PaymentWebhookSecret string `json:"paymentWebhookSecret"`
 			PaymentWebhookId     string `json:"paymentWebhookId"`
 		} `json:"Stripe"`
+
+		// Background processing of the received webhook events
+		Webhooks struct {
+			// Attempts before the event is dead and waits for a replay; 8 by default
+			MaxAttempts int64 `json:"maxAttempts"`
+			// Delay of the first retry in seconds, doubled with every attempt up to an hour; 30 by default
+			RetryDelay int64 `json:"retryDelay"`
+			// Interval of checking for the events due for a retry in seconds; 10 by default
+			PollInterval int64 `json:"pollInterval"`
+		} `json:"Webhooks"`
+
+		// Reconciliation of the open payments with their gateways
+		Reconciliation struct {
+			// Interval of the scheduled reconciliations in seconds; an hour by default, negative disables them
+			Interval int64 `json:"interval"`
+			// Age of the payment in seconds after which its open checkout session is expired; a day by default
+			SessionTimeout int64 `json:"sessionTimeout"`
+		} `json:"Reconciliation"`
+
+		// Bank account the customers transfer the offline payments to; bank transfers are accepted if the IBAN is set
+		BankTransfer struct {
+			AccountHolder string `json:"accountHolder"`
+			BankName      string `json:"bankName"`
+			IBAN          string `json:"iban"`
+			BIC           string `json:"bic"`
+			// Days the transfer has to be received within, otherwise the order is cancelled; 7 by default
+			DeadlineDays int64 `json:"deadlineDays"`
+		} `json:"BankTransfer"`
+
+		CashOnDelivery struct {
+			Enabled bool `json:"enabled"`
+		} `json:"CashOnDelivery"`
 	} `json:"Payments"`
 }
 
@@ -123,7 +223,7 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	Logger.SetLevel(logLevel)
 	api.Logger = Logger
 
-	log.Printf("Log level: %s", logLevel)
+	log.Printf("Log level: %d", logLevel)
 	api.UseSwaggerUI()
 	// To continue using redoc as your UI, uncomment the following line
 	// api.UseRedoc()
@@ -132,6 +232,8 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	api.BinProducer = runtime.ByteStreamProducer()
+
 	// Reading the main application configuration
 	configFileName := apiConf.ConfigFile
 	if configFileName == "" {
@@ -158,6 +260,17 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	db = database.InitDB(ApiConfiguration.DBDriver, ApiConfiguration.DBConnectionString)
 	SetUpDB()
 
+	// Registering the shipping carrier integrations and polling their tracking statuses
+	registerCarriers()
+	registerPaymentGateways()
+	if !isGuestCheckoutEnabled() {
+		Logger.Warn("TokenSecret is not configured; guest checkout is disabled")
+	}
+	startTrackingPolling()
+	startWebhookWorker()
+	startPaymentReconciliation()
+	startOfflinePaymentDeadlines()
+
 	api.OauthSecurityAuth = func(token string, scopes []string) (*models.Principal, error) {
 		Logger.Debug("OauthSecurityAuth: Scopes %s\n", scopes)
 		userInfo, err := authenticate(token, scopes)
@@ -204,7 +317,7 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	// Products
 	api.ProductsAddProductHandler = products.AddProductHandlerFunc(func(params products.AddProductParams, principal *models.Principal) middleware.Responder {
 		Logger.Debug("Calling addProduct with %s\n%s\n%s %s %s %s\n", params, params.Body, params.Body.ID, params.Body.Title, params.Body.Description, params.Body.Images)
-		if err := addProduct(params.Body); err != nil {
+		if err := addProduct(params.HTTPRequest.Context(), params.Body); err != nil {
 			return products.NewAddProductDefault(500).WithPayload(&models.Error{Httpcode: 500, Message: swag.String(err.Error())})
 		}
 		return products.NewAddProductCreated().WithPayload(params.Body)
@@ -218,20 +331,41 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	})
 
 	api.ProductEditProductHandler = product.EditProductHandlerFunc(func(params product.EditProductParams, principal *models.Principal) middleware.Responder {
-		if err := updateProduct(params.ID, params.Body); err != nil {
-			return product.NewEditProductDefault(500).WithPayload(&models.Error{Httpcode: 500, Message: swag.String(err.Error())})
+		version, err := updateProduct(params.HTTPRequest.Context(), params.ID, params.Body, params.IfMatch)
+		if err != nil {
+			return product.NewEditProductDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return product.NewEditProductOK().WithETag(entityETag(version)).WithPayload(params.Body)
+	})
+
+	api.ProductPatchProductHandler = product.PatchProductHandlerFunc(func(params product.PatchProductParams, principal *models.Principal) middleware.Responder {
+		result, version, err := patchProduct(params.HTTPRequest.Context(), params.ID, params.Body, params.IfMatch)
+		if err != nil {
+			return product.NewPatchProductDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return product.NewEditProductOK().WithPayload(params.Body)
+		return product.NewPatchProductOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.ProductGetProductHandler = product.GetProductHandlerFunc(func(params product.GetProductParams) middleware.Responder {
-		var result *models.Product
-		result, err := getProduct(params.ID)
+		currency, err := resolveCurrency(params.XCurrency, "")
 		if err != nil {
-			return product.NewGetProductDefault(500).
-				WithPayload(&models.Error{Httpcode: 500, Message: swag.String(err.Error())})
+			return product.NewGetProductDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		var result *models.Product
+		result, version, getErr := getProduct(params.ID, currency)
+		if getErr != nil {
+			err := getErr.(errors.Error)
+			return product.NewGetProductDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return product.NewGetProductOK().WithPayload(result)
+		// the version does not follow the exchange rates the prices in the other currencies are converted at
+		if currency == baseCurrency() && isNotModified(params.IfNoneMatch, version) {
+			return product.NewGetProductNotModified().WithETag(entityETag(version))
+		}
+		return product.NewGetProductOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.ProductsGetProductsHandler = products.GetProductsHandlerFunc(func(params products.GetProductsParams) middleware.Responder {
@@ -249,12 +383,17 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 		if params.CategoryIds != nil {
 			cleanParams.CategoryIds = params.CategoryIds
 		}
+		cleanParams.XCurrency = params.XCurrency
 		Logger.Debug("Calling allProducts with limit %s, offset %s, search %sCategoryIds %s",
 			params.Limit, params.Offset, params.Search, params.CategoryIds)
 		result, err := allProducts(&cleanParams)
 		if err != nil {
-			return products.NewGetProductsDefault(500).
-				WithPayload(&models.Error{Httpcode: 500, Message: swag.String(err.Error())})
+			code := 500
+			if apiErr, ok := err.(errors.Error); ok {
+				code = int(apiErr.Code())
+			}
+			return products.NewGetProductsDefault(code).
+				WithPayload(&models.Error{Httpcode: int64(code), Message: swag.String(err.Error())})
 		}
 		return products.NewGetProductsOK().WithPayload(result)
 	})
@@ -277,20 +416,35 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	})
 
 	api.CategoryEditCategoryHandler = category.EditCategoryHandlerFunc(func(params category.EditCategoryParams, principal *models.Principal) middleware.Responder {
-		if err := updateCategory(params.ID, params.Body); err != nil {
-			return category.NewEditCategoryDefault(500).WithPayload(&models.Error{Httpcode: 500, Message: swag.String(err.Error())})
+		version, err := updateCategory(params.HTTPRequest.Context(), params.ID, params.Body, params.IfMatch)
+		if err != nil {
+			return category.NewEditCategoryDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return category.NewEditCategoryOK().WithPayload(params.Body)
+		return category.NewEditCategoryOK().WithETag(entityETag(version)).WithPayload(params.Body)
+	})
+
+	api.CategoryPatchCategoryHandler = category.PatchCategoryHandlerFunc(func(params category.PatchCategoryParams, principal *models.Principal) middleware.Responder {
+		result, version, err := patchCategory(params.HTTPRequest.Context(), params.ID, params.Body, params.IfMatch)
+		if err != nil {
+			return category.NewPatchCategoryDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return category.NewPatchCategoryOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.CategoryGetCategoryHandler = category.GetCategoryHandlerFunc(func(params category.GetCategoryParams) middleware.Responder {
 		var result *models.Category
-		result, err := getCategory(params.ID)
-		if err != nil {
-			return category.NewGetCategoryDefault(500).
-				WithPayload(&models.Error{Httpcode: 500, Message: swag.String(err.Error())})
+		result, version, getErr := getCategory(params.ID)
+		if getErr != nil {
+			err := getErr.(errors.Error)
+			return category.NewGetCategoryDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return category.NewGetCategoryOK().WithPayload(result)
+		if isNotModified(params.IfNoneMatch, version) {
+			return category.NewGetCategoryNotModified().WithETag(entityETag(version))
+		}
+		return category.NewGetCategoryOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.CategoriesListCategoriesHandler = categories.ListCategoriesHandlerFunc(func(params categories.ListCategoriesParams) middleware.Responder {
@@ -335,21 +489,34 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	})
 
 	api.UserEditUserHandler = user.EditUserHandlerFunc(func(params user.EditUserParams, principal *models.Principal) middleware.Responder {
-		if err := updateUser(&params, principal); err != nil {
+		version, err := updateUser(&params, principal)
+		if err != nil {
 			return user.NewEditUserDefault(int(err.Code())).
 				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return user.NewEditUserOK().WithPayload(params.Body)
+		return user.NewEditUserOK().WithETag(entityETag(version)).WithPayload(params.Body)
+	})
+
+	api.UserPatchUserHandler = user.PatchUserHandlerFunc(func(params user.PatchUserParams, principal *models.Principal) middleware.Responder {
+		result, version, err := patchUser(&params, principal)
+		if err != nil {
+			return user.NewPatchUserDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return user.NewPatchUserOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.UserGetUserHandler = user.GetUserHandlerFunc(func(params user.GetUserParams, principal *models.Principal) middleware.Responder {
 		var result *models.User
-		result, err := getUser(&params, principal)
+		result, version, err := getUser(&params, principal)
 		if err != nil {
 			return user.NewGetUserDefault(int(err.Code())).
 				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return user.NewGetUserOK().WithPayload(result)
+		if isNotModified(params.IfNoneMatch, version) {
+			return user.NewGetUserNotModified().WithETag(entityETag(version))
+		}
+		return user.NewGetUserOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.UserGetOwnUserHandler = user.GetOwnUserHandlerFunc(func(params user.GetOwnUserParams, principal *models.Principal) middleware.Responder {
@@ -373,6 +540,54 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 		return users.NewListUsersOK().WithPayload(result)
 	})
 
+	// Address book
+
+	api.AddressesListAddressesHandler = addresses.ListAddressesHandlerFunc(func(params addresses.ListAddressesParams, principal *models.Principal) middleware.Responder {
+		result, err := allAddresses(&params, principal)
+		if err != nil {
+			return addresses.NewListAddressesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return addresses.NewListAddressesOK().WithPayload(result)
+	})
+
+	api.AddressesAddAddressHandler = addresses.AddAddressHandlerFunc(func(params addresses.AddAddressParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling addAddress with %v\n%s\n", params, params.Body)
+		result, err := addAddress(&params, principal)
+		if err != nil {
+			return addresses.NewAddAddressDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return addresses.NewAddAddressCreated().WithPayload(result)
+	})
+
+	api.AddressGetAddressHandler = address.GetAddressHandlerFunc(func(params address.GetAddressParams, principal *models.Principal) middleware.Responder {
+		result, err := getAddress(&params, principal)
+		if err != nil {
+			return address.NewGetAddressDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return address.NewGetAddressOK().WithPayload(result)
+	})
+
+	api.AddressEditAddressHandler = address.EditAddressHandlerFunc(func(params address.EditAddressParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling updateAddress with %v\n%s\n", params, params.Body)
+		result, err := updateAddress(&params, principal)
+		if err != nil {
+			return address.NewEditAddressDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return address.NewEditAddressOK().WithPayload(result)
+	})
+
+	api.AddressDeleteAddressHandler = address.DeleteAddressHandlerFunc(func(params address.DeleteAddressParams, principal *models.Principal) middleware.Responder {
+		if err := deleteAddress(&params, principal); err != nil {
+			return address.NewDeleteAddressDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return address.NewDeleteAddressNoContent()
+	})
+
 	// Orders
 
 	api.OrdersAddOrderHandler = orders.AddOrderHandlerFunc(func(params orders.AddOrderParams, principal *models.Principal) middleware.Responder {
@@ -395,21 +610,44 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 	})
 
 	api.OrderEditOrderHandler = order.EditOrderHandlerFunc(func(params order.EditOrderParams, principal *models.Principal) middleware.Responder {
-		if err := updateOrder(&params, principal); err != nil {
+		version, err := updateOrder(&params, principal)
+		if err != nil {
 			return order.NewEditOrderDefault(int(err.Code())).
 				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return order.NewEditOrderOK().WithPayload(params.Body)
+		return order.NewEditOrderOK().WithETag(entityETag(version)).WithPayload(params.Body)
+	})
+
+	api.OrderPatchOrderHandler = order.PatchOrderHandlerFunc(func(params order.PatchOrderParams, principal *models.Principal) middleware.Responder {
+		result, version, err := patchOrder(&params, principal)
+		if err != nil {
+			return order.NewPatchOrderDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return order.NewPatchOrderOK().WithETag(entityETag(version)).WithPayload(result)
 	})
 
 	api.OrderGetOrderHandler = order.GetOrderHandlerFunc(func(params order.GetOrderParams, principal *models.Principal) middleware.Responder {
 		var result *models.Order
-		result, err := getOrder(&params, principal)
+		result, version, err := getOrder(&params, principal)
 		if err != nil {
 			return order.NewGetOrderDefault(int(err.Code())).
 				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return order.NewGetOrderOK().WithPayload(result)
+		if isNotModified(params.IfNoneMatch, version) {
+			return order.NewGetOrderNotModified().WithETag(entityETag(version))
+		}
+		return order.NewGetOrderOK().WithETag(entityETag(version)).WithPayload(result)
+	})
+
+	api.OrderChangeOrderStatusHandler = order.ChangeOrderStatusHandlerFunc(func(params order.ChangeOrderStatusParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling changeOrderStatus for order %d with %v\n", params.ID, params.Body)
+		result, err := changeOrderStatus(&params, principal)
+		if err != nil {
+			return order.NewChangeOrderStatusDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return order.NewChangeOrderStatusOK().WithPayload(result)
 	})
 
 	api.OrdersListOrdersHandler = orders.ListOrdersHandlerFunc(func(params orders.ListOrdersParams, principal *models.Principal) middleware.Responder {
@@ -423,7 +661,643 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 		return orders.NewListOrdersOK().WithPayload(result)
 	})
 
+	api.OrdersExportOrdersHandler = orders.ExportOrdersHandlerFunc(func(params orders.ExportOrdersParams, principal *models.Principal) middleware.Responder {
+		export, err := exportOrders(&params, principal)
+		if err != nil {
+			return orders.NewExportOrdersDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		// the rows are written as they are read instead of producing a payload
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+			rw.Header().Set("Content-Type", export.ContentType())
+			rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.FileName))
+			rw.WriteHeader(http.StatusOK)
+			if streamErr := export.Stream(params.HTTPRequest.Context(), rw); streamErr != nil {
+				Logger.Error("ERROR %v: Could not stream the orders export %s!\n", streamErr, export.FileName)
+			}
+		})
+	})
+
+	// Promotions
+
+	api.PromotionsListPromotionsHandler = promotions.ListPromotionsHandlerFunc(func(params promotions.ListPromotionsParams, principal *models.Principal) middleware.Responder {
+		result, err := allPromotions(&params, principal)
+		if err != nil {
+			return promotions.NewListPromotionsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return promotions.NewListPromotionsOK().WithPayload(result)
+	})
+
+	api.PromotionsAddPromotionHandler = promotions.AddPromotionHandlerFunc(func(params promotions.AddPromotionParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling addPromotion with %v\n%s\n", params, params.Body)
+		result, err := addPromotion(&params, principal)
+		if err != nil {
+			return promotions.NewAddPromotionDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return promotions.NewAddPromotionCreated().WithPayload(result)
+	})
+
+	api.PromotionGetPromotionHandler = promotion.GetPromotionHandlerFunc(func(params promotion.GetPromotionParams, principal *models.Principal) middleware.Responder {
+		result, err := getPromotion(&params, principal)
+		if err != nil {
+			return promotion.NewGetPromotionDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return promotion.NewGetPromotionOK().WithPayload(result)
+	})
+
+	api.PromotionEditPromotionHandler = promotion.EditPromotionHandlerFunc(func(params promotion.EditPromotionParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling updatePromotion with %v\n%s\n", params, params.Body)
+		result, err := updatePromotion(&params, principal)
+		if err != nil {
+			return promotion.NewEditPromotionDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return promotion.NewEditPromotionOK().WithPayload(result)
+	})
+
+	api.PromotionDeletePromotionHandler = promotion.DeletePromotionHandlerFunc(func(params promotion.DeletePromotionParams, principal *models.Principal) middleware.Responder {
+		if err := deletePromotion(&params, principal); err != nil {
+			return promotion.NewDeletePromotionDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return promotion.NewDeletePromotionNoContent()
+	})
+
+	// Taxes
+
+	api.TaxesListTaxZonesHandler = taxes.ListTaxZonesHandlerFunc(func(params taxes.ListTaxZonesParams, principal *models.Principal) middleware.Responder {
+		result, err := allTaxZones(&params, principal)
+		if err != nil {
+			return taxes.NewListTaxZonesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return taxes.NewListTaxZonesOK().WithPayload(result)
+	})
+
+	api.TaxesAddTaxZoneHandler = taxes.AddTaxZoneHandlerFunc(func(params taxes.AddTaxZoneParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling addTaxZone with %v\n%s\n", params, params.Body)
+		result, err := addTaxZone(&params, principal)
+		if err != nil {
+			return taxes.NewAddTaxZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return taxes.NewAddTaxZoneCreated().WithPayload(result)
+	})
+
+	api.TaxGetTaxZoneHandler = tax.GetTaxZoneHandlerFunc(func(params tax.GetTaxZoneParams, principal *models.Principal) middleware.Responder {
+		result, err := getTaxZone(&params, principal)
+		if err != nil {
+			return tax.NewGetTaxZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return tax.NewGetTaxZoneOK().WithPayload(result)
+	})
+
+	api.TaxEditTaxZoneHandler = tax.EditTaxZoneHandlerFunc(func(params tax.EditTaxZoneParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling updateTaxZone with %v\n%s\n", params, params.Body)
+		result, err := updateTaxZone(&params, principal)
+		if err != nil {
+			return tax.NewEditTaxZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return tax.NewEditTaxZoneOK().WithPayload(result)
+	})
+
+	api.TaxDeleteTaxZoneHandler = tax.DeleteTaxZoneHandlerFunc(func(params tax.DeleteTaxZoneParams, principal *models.Principal) middleware.Responder {
+		if err := deleteTaxZone(&params, principal); err != nil {
+			return tax.NewDeleteTaxZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return tax.NewDeleteTaxZoneNoContent()
+	})
+
+	// Currencies
+
+	api.CurrenciesListCurrenciesHandler = currencies.ListCurrenciesHandlerFunc(func(params currencies.ListCurrenciesParams) middleware.Responder {
+		return currencies.NewListCurrenciesOK().WithPayload(listCurrencies(&params))
+	})
+
+	api.CurrenciesListExchangeRatesHandler = currencies.ListExchangeRatesHandlerFunc(func(params currencies.ListExchangeRatesParams, principal *models.Principal) middleware.Responder {
+		result, err := allExchangeRates(&params, principal)
+		if err != nil {
+			return currencies.NewListExchangeRatesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return currencies.NewListExchangeRatesOK().WithPayload(result)
+	})
+
+	api.CurrenciesGetExchangeRateHandler = currencies.GetExchangeRateHandlerFunc(func(params currencies.GetExchangeRateParams, principal *models.Principal) middleware.Responder {
+		result, err := getExchangeRate(&params, principal)
+		if err != nil {
+			return currencies.NewGetExchangeRateDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return currencies.NewGetExchangeRateOK().WithPayload(result)
+	})
+
+	api.CurrenciesSetExchangeRateHandler = currencies.SetExchangeRateHandlerFunc(func(params currencies.SetExchangeRateParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling setExchangeRate with %v\n%s\n", params, params.Body)
+		result, err := setExchangeRate(&params, principal)
+		if err != nil {
+			return currencies.NewSetExchangeRateDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return currencies.NewSetExchangeRateOK().WithPayload(result)
+	})
+
+	api.CurrenciesDeleteExchangeRateHandler = currencies.DeleteExchangeRateHandlerFunc(func(params currencies.DeleteExchangeRateParams, principal *models.Principal) middleware.Responder {
+		if err := deleteExchangeRate(&params, principal); err != nil {
+			return currencies.NewDeleteExchangeRateDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return currencies.NewDeleteExchangeRateNoContent()
+	})
+
+	// Shipping
+
+	api.ShippingListShippingZonesHandler = shipping.ListShippingZonesHandlerFunc(func(params shipping.ListShippingZonesParams, principal *models.Principal) middleware.Responder {
+		result, err := allShippingZones(&params, principal)
+		if err != nil {
+			return shipping.NewListShippingZonesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewListShippingZonesOK().WithPayload(result)
+	})
+
+	api.ShippingAddShippingZoneHandler = shipping.AddShippingZoneHandlerFunc(func(params shipping.AddShippingZoneParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling addShippingZone with %v\n%s\n", params, params.Body)
+		result, err := addShippingZone(&params, principal)
+		if err != nil {
+			return shipping.NewAddShippingZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewAddShippingZoneCreated().WithPayload(result)
+	})
+
+	api.ShippingGetShippingZoneHandler = shipping.GetShippingZoneHandlerFunc(func(params shipping.GetShippingZoneParams, principal *models.Principal) middleware.Responder {
+		result, err := getShippingZone(&params, principal)
+		if err != nil {
+			return shipping.NewGetShippingZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewGetShippingZoneOK().WithPayload(result)
+	})
+
+	api.ShippingEditShippingZoneHandler = shipping.EditShippingZoneHandlerFunc(func(params shipping.EditShippingZoneParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling updateShippingZone with %v\n%s\n", params, params.Body)
+		result, err := updateShippingZone(&params, principal)
+		if err != nil {
+			return shipping.NewEditShippingZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewEditShippingZoneOK().WithPayload(result)
+	})
+
+	api.ShippingDeleteShippingZoneHandler = shipping.DeleteShippingZoneHandlerFunc(func(params shipping.DeleteShippingZoneParams, principal *models.Principal) middleware.Responder {
+		if err := deleteShippingZone(&params, principal); err != nil {
+			return shipping.NewDeleteShippingZoneDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewDeleteShippingZoneNoContent()
+	})
+
+	api.ShippingListShippingMethodsHandler = shipping.ListShippingMethodsHandlerFunc(func(params shipping.ListShippingMethodsParams, principal *models.Principal) middleware.Responder {
+		result, err := allShippingMethods(&params, principal)
+		if err != nil {
+			return shipping.NewListShippingMethodsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewListShippingMethodsOK().WithPayload(result)
+	})
+
+	api.ShippingAddShippingMethodHandler = shipping.AddShippingMethodHandlerFunc(func(params shipping.AddShippingMethodParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling addShippingMethod with %v\n%s\n", params, params.Body)
+		result, err := addShippingMethod(&params, principal)
+		if err != nil {
+			return shipping.NewAddShippingMethodDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewAddShippingMethodCreated().WithPayload(result)
+	})
+
+	api.ShippingGetShippingMethodHandler = shipping.GetShippingMethodHandlerFunc(func(params shipping.GetShippingMethodParams, principal *models.Principal) middleware.Responder {
+		result, err := getShippingMethod(&params, principal)
+		if err != nil {
+			return shipping.NewGetShippingMethodDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewGetShippingMethodOK().WithPayload(result)
+	})
+
+	api.ShippingEditShippingMethodHandler = shipping.EditShippingMethodHandlerFunc(func(params shipping.EditShippingMethodParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling updateShippingMethod with %v\n%s\n", params, params.Body)
+		result, err := updateShippingMethod(&params, principal)
+		if err != nil {
+			return shipping.NewEditShippingMethodDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewEditShippingMethodOK().WithPayload(result)
+	})
+
+	api.ShippingDeleteShippingMethodHandler = shipping.DeleteShippingMethodHandlerFunc(func(params shipping.DeleteShippingMethodParams, principal *models.Principal) middleware.Responder {
+		if err := deleteShippingMethod(&params, principal); err != nil {
+			return shipping.NewDeleteShippingMethodDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewDeleteShippingMethodNoContent()
+	})
+
+	api.ShippingGetShippingRatesHandler = shipping.GetShippingRatesHandlerFunc(func(params shipping.GetShippingRatesParams, principal *models.Principal) middleware.Responder {
+		result, err := getShippingRates(&params, principal)
+		if err != nil {
+			return shipping.NewGetShippingRatesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipping.NewGetShippingRatesOK().WithPayload(result)
+	})
+
+	// Shipments
+
+	api.ShipmentsListShipmentsHandler = shipments.ListShipmentsHandlerFunc(func(params shipments.ListShipmentsParams, principal *models.Principal) middleware.Responder {
+		result, err := allShipments(&params, principal)
+		if err != nil {
+			return shipments.NewListShipmentsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipments.NewListShipmentsOK().WithPayload(result)
+	})
+
+	api.ShipmentsAddShipmentHandler = shipments.AddShipmentHandlerFunc(func(params shipments.AddShipmentParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling addShipment with %v\n%s\n", params, params.Body)
+		result, err := addShipment(&params, principal)
+		if err != nil {
+			return shipments.NewAddShipmentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipments.NewAddShipmentCreated().WithPayload(result)
+	})
+
+	api.ShipmentGetShipmentHandler = shipment.GetShipmentHandlerFunc(func(params shipment.GetShipmentParams, principal *models.Principal) middleware.Responder {
+		result, err := getShipment(&params, principal)
+		if err != nil {
+			return shipment.NewGetShipmentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipment.NewGetShipmentOK().WithPayload(result)
+	})
+
+	api.ShipmentChangeShipmentStatusHandler = shipment.ChangeShipmentStatusHandlerFunc(func(params shipment.ChangeShipmentStatusParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling changeShipmentStatus with %v\n%s\n", params, params.Body)
+		result, err := changeShipmentStatus(&params, principal)
+		if err != nil {
+			return shipment.NewChangeShipmentStatusDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipment.NewChangeShipmentStatusOK().WithPayload(result)
+	})
+
+	api.ShipmentRefreshShipmentTrackingHandler = shipment.RefreshShipmentTrackingHandlerFunc(func(params shipment.RefreshShipmentTrackingParams, principal *models.Principal) middleware.Responder {
+		result, err := refreshShipmentTracking(&params, principal)
+		if err != nil {
+			return shipment.NewRefreshShipmentTrackingDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return shipment.NewRefreshShipmentTrackingOK().WithPayload(result)
+	})
+
+	// Returns
+
+	api.ReturnsListAllReturnsHandler = returns.ListAllReturnsHandlerFunc(func(params returns.ListAllReturnsParams, principal *models.Principal) middleware.Responder {
+		result, err := allReturns(&params, principal)
+		if err != nil {
+			return returns.NewListAllReturnsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewListAllReturnsOK().WithPayload(result)
+	})
+
+	api.ReturnsListReturnsHandler = returns.ListReturnsHandlerFunc(func(params returns.ListReturnsParams, principal *models.Principal) middleware.Responder {
+		result, err := allOrderReturns(&params, principal)
+		if err != nil {
+			return returns.NewListReturnsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewListReturnsOK().WithPayload(result)
+	})
+
+	api.ReturnsRequestReturnHandler = returns.RequestReturnHandlerFunc(func(params returns.RequestReturnParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling requestReturn with %v\n%s\n", params, params.Body)
+		result, err := requestReturn(&params, principal)
+		if err != nil {
+			return returns.NewRequestReturnDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewRequestReturnCreated().WithPayload(result)
+	})
+
+	api.ReturnsGetReturnHandler = returns.GetReturnHandlerFunc(func(params returns.GetReturnParams, principal *models.Principal) middleware.Responder {
+		result, err := getReturn(&params, principal)
+		if err != nil {
+			return returns.NewGetReturnDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewGetReturnOK().WithPayload(result)
+	})
+
+	api.ReturnsDecideReturnHandler = returns.DecideReturnHandlerFunc(func(params returns.DecideReturnParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling decideReturn with %v\n%s\n", params, params.Body)
+		result, err := decideReturn(&params, principal)
+		if err != nil {
+			return returns.NewDecideReturnDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewDecideReturnOK().WithPayload(result)
+	})
+
+	api.ReturnsInspectReturnHandler = returns.InspectReturnHandlerFunc(func(params returns.InspectReturnParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling inspectReturn with %v\n%s\n", params, params.Body)
+		result, err := inspectReturn(&params, principal)
+		if err != nil {
+			return returns.NewInspectReturnDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewInspectReturnOK().WithPayload(result)
+	})
+
+	api.ReturnsRetryReturnRefundHandler = returns.RetryReturnRefundHandlerFunc(func(params returns.RetryReturnRefundParams, principal *models.Principal) middleware.Responder {
+		result, err := retryReturnRefund(&params, principal)
+		if err != nil {
+			return returns.NewRetryReturnRefundDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return returns.NewRetryReturnRefundOK().WithPayload(result)
+	})
+
+	// Order messages
+
+	api.MessagesListOrderMessagesHandler = messages.ListOrderMessagesHandlerFunc(func(params messages.ListOrderMessagesParams, principal *models.Principal) middleware.Responder {
+		result, err := allOrderMessages(&params, principal)
+		if err != nil {
+			return messages.NewListOrderMessagesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return messages.NewListOrderMessagesOK().WithPayload(result)
+	})
+
+	api.MessagesPostOrderMessageHandler = messages.PostOrderMessageHandlerFunc(func(params messages.PostOrderMessageParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling postOrderMessage for order %d\n", params.ID)
+		result, err := postOrderMessage(&params, principal)
+		if err != nil {
+			return messages.NewPostOrderMessageDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return messages.NewPostOrderMessageCreated().WithPayload(result)
+	})
+
+	api.MessagesMarkOrderMessagesReadHandler = messages.MarkOrderMessagesReadHandlerFunc(func(params messages.MarkOrderMessagesReadParams, principal *models.Principal) middleware.Responder {
+		result, err := markOrderMessagesRead(&params, principal)
+		if err != nil {
+			return messages.NewMarkOrderMessagesReadDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return messages.NewMarkOrderMessagesReadOK().WithPayload(result)
+	})
+
+	api.MessageGetOrderMessageAttachmentHandler = message.GetOrderMessageAttachmentHandlerFunc(func(params message.GetOrderMessageAttachmentParams, principal *models.Principal) middleware.Responder {
+		attachment, content, err := getOrderMessageAttachment(&params, principal)
+		if err != nil {
+			return message.NewGetOrderMessageAttachmentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return message.NewGetOrderMessageAttachmentOK().
+			WithContentDisposition(fmt.Sprintf("attachment; filename=%q", attachment.FileName)).
+			WithContentType(attachment.ContentType).
+			WithPayload(content)
+	})
+
+	// Invoices
+
+	api.InvoiceGetInvoiceHandler = invoice.GetInvoiceHandlerFunc(func(params invoice.GetInvoiceParams, principal *models.Principal) middleware.Responder {
+		result, fileName, err := getInvoice(&params, principal)
+		if err != nil {
+			return invoice.NewGetInvoiceDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return invoice.NewGetInvoiceOK().
+			WithContentDisposition(fmt.Sprintf("attachment; filename=%q", fileName)).
+			WithPayload(result)
+	})
+
+	api.InvoicesListInvoicesHandler = invoices.ListInvoicesHandlerFunc(func(params invoices.ListInvoicesParams, principal *models.Principal) middleware.Responder {
+		result, err := allInvoices(&params, principal)
+		if err != nil {
+			return invoices.NewListInvoicesDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return invoices.NewListInvoicesOK().WithPayload(result)
+	})
+
+	// Reports
+
+	api.ReportsGetTaxReportHandler = reports.GetTaxReportHandlerFunc(func(params reports.GetTaxReportParams, principal *models.Principal) middleware.Responder {
+		result, err := getTaxReport(&params, principal)
+		if err != nil {
+			return reports.NewGetTaxReportDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return reports.NewGetTaxReportOK().WithPayload(result)
+	})
+
+	// Cart
+
+	api.CartGetCartHandler = cart.GetCartHandlerFunc(func(params cart.GetCartParams, principal *models.Principal) middleware.Responder {
+		result, err := getCart(&params, principal)
+		if err != nil {
+			return cart.NewGetCartDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return cart.NewGetCartOK().WithPayload(result)
+	})
+
+	api.CartUpdateCartHandler = cart.UpdateCartHandlerFunc(func(params cart.UpdateCartParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling updateCart with %v\n%s\n", params, params.Body)
+		result, err := updateCart(&params, principal)
+		if err != nil {
+			return cart.NewUpdateCartDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return cart.NewUpdateCartOK().WithPayload(result)
+	})
+
+	api.CartClearCartHandler = cart.ClearCartHandlerFunc(func(params cart.ClearCartParams, principal *models.Principal) middleware.Responder {
+		if err := clearCart(&params, principal); err != nil {
+			return cart.NewClearCartDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return cart.NewClearCartNoContent()
+	})
+
+	api.CartCheckoutCartHandler = cart.CheckoutCartHandlerFunc(func(params cart.CheckoutCartParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling checkoutCart with %v\n%s\n", params, params.Body)
+		orderDTO, err := checkoutCart(&params, principal)
+		if err != nil {
+			return cart.NewCheckoutCartDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return cart.NewCheckoutCartCreated().WithPayload(orderDTO)
+	})
+
+	// Guest orders
+
+	api.GuestAddGuestOrderHandler = guest.AddGuestOrderHandlerFunc(func(params guest.AddGuestOrderParams) middleware.Responder {
+		result, err := addGuestOrder(&params)
+		if err != nil {
+			return guest.NewAddGuestOrderDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return guest.NewAddGuestOrderCreated().WithPayload(result)
+	})
+
+	api.GuestGetGuestOrderHandler = guest.GetGuestOrderHandlerFunc(func(params guest.GetGuestOrderParams) middleware.Responder {
+		result, err := getGuestOrder(&params)
+		if err != nil {
+			return guest.NewGetGuestOrderDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return guest.NewGetGuestOrderOK().WithPayload(result)
+	})
+
+	api.GuestAddGuestCheckoutSessionHandler = guest.AddGuestCheckoutSessionHandlerFunc(func(params guest.AddGuestCheckoutSessionParams) middleware.Responder {
+		clientSecret, err := createGuestCheckoutSession(&params)
+		if err != nil {
+			return guest.NewAddGuestCheckoutSessionDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return guest.NewAddGuestCheckoutSessionCreated().WithPayload(clientSecret)
+	})
+
+	api.GuestAddGuestOfflinePaymentHandler = guest.AddGuestOfflinePaymentHandlerFunc(func(params guest.AddGuestOfflinePaymentParams) middleware.Responder {
+		result, err := createGuestOfflinePayment(&params)
+		if err != nil {
+			return guest.NewAddGuestOfflinePaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return guest.NewAddGuestOfflinePaymentCreated().WithPayload(result)
+	})
+
+	api.GuestClaimGuestOrderHandler = guest.ClaimGuestOrderHandlerFunc(func(params guest.ClaimGuestOrderParams, principal *models.Principal) middleware.Responder {
+		result, err := claimGuestOrder(&params, principal)
+		if err != nil {
+			return guest.NewClaimGuestOrderDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return guest.NewClaimGuestOrderOK().WithPayload(result)
+	})
+
 	// Payments
+	api.PaymentsListPaymentsHandler = payments.ListPaymentsHandlerFunc(func(params payments.ListPaymentsParams, principal *models.Principal) middleware.Responder {
+		result, err := allPayments(&params, principal)
+		if err != nil {
+			return payments.NewListPaymentsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payments.NewListPaymentsOK().WithPayload(result)
+	})
+
+	api.PaymentsAddPaymentHandler = payments.AddPaymentHandlerFunc(func(params payments.AddPaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := addPayment(&params, principal)
+		if err != nil {
+			return payments.NewAddPaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payments.NewAddPaymentCreated().WithPayload(result)
+	})
+
+	api.PaymentGetPaymentHandler = payment.GetPaymentHandlerFunc(func(params payment.GetPaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := getPayment(&params, principal)
+		if err != nil {
+			return payment.NewGetPaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewGetPaymentOK().WithPayload(result)
+	})
+
+	api.PaymentEditPaymentHandler = payment.EditPaymentHandlerFunc(func(params payment.EditPaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := updatePayment(&params, principal)
+		if err != nil {
+			return payment.NewEditPaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewEditPaymentOK().WithPayload(result)
+	})
+
+	api.PaymentDeletePaymetHandler = payment.DeletePaymetHandlerFunc(func(params payment.DeletePaymetParams, principal *models.Principal) middleware.Responder {
+		err := deletePayment(&params, principal)
+		if err != nil {
+			return payment.NewDeletePaymetDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewDeletePaymetNoContent()
+	})
+
+	api.PaymentReceivePaymentHandler = payment.ReceivePaymentHandlerFunc(func(params payment.ReceivePaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := receivePayment(&params, principal)
+		if err != nil {
+			return payment.NewReceivePaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewReceivePaymentOK().WithPayload(result)
+	})
+
+	api.PaymentCollectPaymentHandler = payment.CollectPaymentHandlerFunc(func(params payment.CollectPaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := collectPayment(&params, principal)
+		if err != nil {
+			return payment.NewCollectPaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewCollectPaymentOK().WithPayload(result)
+	})
+
+	api.PaymentListPaymentHistoryHandler = payment.ListPaymentHistoryHandlerFunc(func(params payment.ListPaymentHistoryParams, principal *models.Principal) middleware.Responder {
+		result, err := allPaymentHistory(&params, principal)
+		if err != nil {
+			return payment.NewListPaymentHistoryDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewListPaymentHistoryOK().WithPayload(result)
+	})
+
+	api.PaymentPatchPaymentHandler = payment.PatchPaymentHandlerFunc(func(params payment.PatchPaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := patchPayment(&params, principal)
+		if err != nil {
+			return payment.NewPatchPaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payment.NewPatchPaymentOK().WithPayload(result)
+	})
+
+	// Refunds
+	api.RefundsListRefundsHandler = refunds.ListRefundsHandlerFunc(func(params refunds.ListRefundsParams, principal *models.Principal) middleware.Responder {
+		result, err := allRefunds(&params, principal)
+		if err != nil {
+			return refunds.NewListRefundsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return refunds.NewListRefundsOK().WithPayload(result)
+	})
+
+	api.RefundsCreateRefundHandler = refunds.CreateRefundHandlerFunc(func(params refunds.CreateRefundParams, principal *models.Principal) middleware.Responder {
+		Logger.Debug("Calling createRefund with %v\n%s\n", params, params.Body)
+		result, err := createRefund(&params, principal)
+		if err != nil {
+			return refunds.NewCreateRefundDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return refunds.NewCreateRefundCreated().WithPayload(result)
+	})
 
 	//Checkout
 	api.CheckoutAddCheckoutSessionHandler = checkout.AddCheckoutSessionHandlerFunc(func(params checkout.AddCheckoutSessionParams, principal *models.Principal) middleware.Responder {
@@ -433,7 +1307,16 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 			return checkout.NewAddCheckoutSessionDefault(int(err.Code())).
 				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
 		}
-		return checkout.NewAddCheckoutSessionCreated().WithPayload(&models.CheckoutSessionSecret{ClientSecret: *clientSecret})
+		return checkout.NewAddCheckoutSessionCreated().WithPayload(clientSecret)
+	})
+
+	api.CheckoutAddOfflinePaymentHandler = checkout.AddOfflinePaymentHandlerFunc(func(params checkout.AddOfflinePaymentParams, principal *models.Principal) middleware.Responder {
+		result, err := createOfflinePayment(&params, principal)
+		if err != nil {
+			return checkout.NewAddOfflinePaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return checkout.NewAddOfflinePaymentCreated().WithPayload(result)
 	})
 
 	api.CheckoutGetCheckoutSessionHandler = checkout.GetCheckoutSessionHandlerFunc(func(params checkout.GetCheckoutSessionParams, principal *models.Principal) middleware.Responder {
@@ -459,6 +1342,103 @@ func configureAPI(api *operations.EStoreMainAPI) http.Handler {
 		return webhooks.NewProcessStripePaymentOK()
 	})
 
+	// Payment gateway webhook
+	api.WebhooksProcessPaymentEventHandler = webhooks.ProcessPaymentEventHandlerFunc(func(params webhooks.ProcessPaymentEventParams) middleware.Responder {
+		Logger.Debug("Calling WebhooksProcessPaymentEventHandler for gateway %s", params.Gateway)
+		err := processGatewayPaymentEvent(&params)
+		if err != nil {
+			return webhooks.NewProcessPaymentEventDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return webhooks.NewProcessPaymentEventOK()
+	})
+
+	// Fake payment gateway hosted payment page
+	api.PaymentsGetFakePaymentPageHandler = payments.GetFakePaymentPageHandlerFunc(func(params payments.GetFakePaymentPageParams) middleware.Responder {
+		result, err := getFakePaymentPage(&params)
+		if err != nil {
+			return payments.NewGetFakePaymentPageDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payments.NewGetFakePaymentPageOK().WithPayload(result)
+	})
+
+	api.PaymentsCompleteFakePaymentHandler = payments.CompleteFakePaymentHandlerFunc(func(params payments.CompleteFakePaymentParams) middleware.Responder {
+		location, err := completeFakePayment(&params)
+		if err != nil {
+			return payments.NewCompleteFakePaymentDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return payments.NewCompleteFakePaymentSeeOther().WithLocation(location)
+	})
+
+	// Received payment webhook events
+	api.WebhooksListWebhookEventsHandler = webhooks.ListWebhookEventsHandlerFunc(func(params webhooks.ListWebhookEventsParams, principal *models.Principal) middleware.Responder {
+		result, err := allWebhookEvents(&params, principal)
+		if err != nil {
+			return webhooks.NewListWebhookEventsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return webhooks.NewListWebhookEventsOK().WithPayload(result)
+	})
+
+	api.WebhooksGetWebhookEventHandler = webhooks.GetWebhookEventHandlerFunc(func(params webhooks.GetWebhookEventParams, principal *models.Principal) middleware.Responder {
+		result, err := getWebhookEvent(&params, principal)
+		if err != nil {
+			return webhooks.NewGetWebhookEventDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return webhooks.NewGetWebhookEventOK().WithPayload(result)
+	})
+
+	api.WebhooksReplayWebhookEventHandler = webhooks.ReplayWebhookEventHandlerFunc(func(params webhooks.ReplayWebhookEventParams, principal *models.Principal) middleware.Responder {
+		result, err := replayWebhookEvent(&params, principal)
+		if err != nil {
+			return webhooks.NewReplayWebhookEventDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return webhooks.NewReplayWebhookEventOK().WithPayload(result)
+	})
+
+	// Payment reconciliations
+	api.ReconciliationsListPaymentReconciliationsHandler = reconciliations.ListPaymentReconciliationsHandlerFunc(func(params reconciliations.ListPaymentReconciliationsParams, principal *models.Principal) middleware.Responder {
+		result, err := allPaymentReconciliations(&params, principal)
+		if err != nil {
+			return reconciliations.NewListPaymentReconciliationsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return reconciliations.NewListPaymentReconciliationsOK().WithPayload(result)
+	})
+
+	api.ReconciliationsGetPaymentReconciliationHandler = reconciliations.GetPaymentReconciliationHandlerFunc(func(params reconciliations.GetPaymentReconciliationParams, principal *models.Principal) middleware.Responder {
+		result, err := getPaymentReconciliation(&params, principal)
+		if err != nil {
+			return reconciliations.NewGetPaymentReconciliationDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return reconciliations.NewGetPaymentReconciliationOK().WithPayload(result)
+	})
+
+	api.ReconciliationsReconcilePaymentsHandler = reconciliations.ReconcilePaymentsHandlerFunc(func(params reconciliations.ReconcilePaymentsParams, principal *models.Principal) middleware.Responder {
+		result, err := runPaymentReconciliation(&params, principal)
+		if err != nil {
+			return reconciliations.NewReconcilePaymentsDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return reconciliations.NewReconcilePaymentsCreated().WithPayload(result)
+	})
+
+	// Carrier tracking webhook
+	api.WebhooksProcessTrackingEventHandler = webhooks.ProcessTrackingEventHandlerFunc(func(params webhooks.ProcessTrackingEventParams) middleware.Responder {
+		Logger.Debug("Calling WebhooksProcessTrackingEventHandler for carrier %s", params.Carrier)
+		err := processTrackingEvent(&params)
+		if err != nil {
+			return webhooks.NewProcessTrackingEventDefault(int(err.Code())).
+				WithPayload(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+		}
+		return webhooks.NewProcessTrackingEventOK()
+	})
+
 	api.PreServerShutdown = func() {}
 
 	api.ServerShutdown = func() {}
@@ -481,7 +1461,7 @@ func configureServer(s *http.Server, scheme, addr string) {
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation.
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	return requireFullRepresentation(handler)
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
@@ -513,7 +1493,13 @@ func SetUpDB() {
 	db.RegisterModel((*dbModels.ProductToCategory)(nil))
 	var err error
 	modelTables := []interface{}{&dbModels.ProductToCategory{}, &dbModels.Product{}, &dbModels.Category{},
-		&dbModels.User{}, &dbModels.OrderedProduct{}, &dbModels.Order{}, &dbModels.Payment{}}
+		&dbModels.User{}, &dbModels.OrderedProduct{}, &dbModels.Order{}, &dbModels.Payment{}, &dbModels.Refund{},
+		&dbModels.OrderStatusHistory{}, &dbModels.Cart{}, &dbModels.CartItem{}, &dbModels.Promotion{},
+		&dbModels.PromotionRedemption{}, &dbModels.OrderDiscount{}, &dbModels.TaxZone{}, &dbModels.TaxRate{},
+		&dbModels.OrderTaxLine{}, &dbModels.Address{}, &dbModels.ShippingZone{}, &dbModels.ShippingMethod{},
+		&dbModels.Shipment{}, &dbModels.OrderReturn{}, &dbModels.Invoice{},
+		&dbModels.OrderMessage{}, &dbModels.OrderMessageAttachment{}, &dbModels.WebhookEvent{},
+		&dbModels.PaymentHistory{}, &dbModels.PaymentReconciliation{}, &dbModels.ExchangeRate{}}
 	for _, m := range modelTables {
 		query := db.NewCreateTable().Model(m).IfNotExists()
 		Logger.Debug("Built the query %s\n", query)
@@ -521,6 +1507,7 @@ func SetUpDB() {
 		if err != nil {
 			panic(fmt.Sprintf("Error: %s, result: %s", err.Error(), result))
 		}
+		addMissingColumns(m)
 	}
 
 	// Migrating the database according to the embedded migration files if needed
@@ -529,6 +1516,7 @@ func SetUpDB() {
 	if err := Migrations.Discover(sqlMigrations); err != nil {
 		panic(err)
 	}
+	Migrations.Add(moneyMinorUnitsMigration())
 	migrator := migrate.NewMigrator(db, Migrations)
 	err = migrator.Init(context.Background())
 	if err != nil {
@@ -604,3 +1592,49 @@ func SetUpDB() {
 		Logger.Debug("migrated to %s\n", group)
 	}
 }
+
+// tableColumns returns the set of the column names of the table
+func tableColumns(ctx context.Context, idb bun.IDB, table string) (map[string]bool, error) {
+	rows, err := idb.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s LIMIT 0", table))
+	if err != nil {
+		return nil, err
+	}
+	columns, err := rows.Columns()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		result[column] = true
+	}
+	return result, nil
+}
+
+// addMissingColumns adds the columns of the model absent from its already existing table;
+// the tables created by older versions do not get the fields added to the models later otherwise
+func addMissingColumns(model interface{}) {
+	table := db.Table(reflect.TypeOf(model))
+	existing, err := tableColumns(context.Background(), db, string(table.SQLName))
+	if err != nil {
+		panic(fmt.Sprintf("Error: %s\nCould not read table %s columns", err.Error(), table.Name))
+	}
+
+	for _, field := range table.Fields {
+		if existing[field.Name] {
+			continue
+		}
+		definition := field.CreateTableSQLType
+		if field.SQLDefault != "" {
+			definition += " DEFAULT " + field.SQLDefault
+		}
+		query := db.NewAddColumn().Model(model).ColumnExpr("? ?", field.SQLName, bun.Safe(definition))
+		Logger.Info("Adding column %s to table %s", field.Name, table.Name)
+		Logger.Debug("Built the query %s\n", query)
+		_, err = query.Exec(context.Background())
+		if err != nil {
+			panic(fmt.Sprintf("Error: %s\nCould not add column %s to table %s", err.Error(), field.Name,
+				table.Name))
+		}
+	}
+}
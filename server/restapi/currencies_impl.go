@@ -0,0 +1,281 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/currencies"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"time"
+)
+
+const defaultCurrency = "USD"
+
+// baseCurrency returns the ISO 4217 code of the currency the catalog is priced in
+func baseCurrency() string {
+	currency := strings.ToUpper(ApiConfiguration.Currency)
+	if !money.IsCurrencyCode(currency) {
+		return defaultCurrency
+	}
+	return currency
+}
+
+// storeCurrencies returns the currencies the customers may choose, the base one first;
+// the invalid codes of the configuration are skipped
+func storeCurrencies() []string {
+	result := []string{baseCurrency()}
+	for _, code := range ApiConfiguration.Currencies {
+		currency := strings.ToUpper(strings.TrimSpace(code))
+		if !money.IsCurrencyCode(currency) || isStoreCurrency(result, currency) {
+			continue
+		}
+		result = append(result, currency)
+	}
+	return result
+}
+
+func isStoreCurrency(currencies []string, currency string) bool {
+	for _, c := range currencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveCurrency returns the store currency of the requested code; without a code, the fallback currency
+// is returned, or the base one if there is no fallback either
+func resolveCurrency(code *string, fallback string) (string, errors.Error) {
+	if code == nil || strings.TrimSpace(*code) == "" {
+		if fallback == "" {
+			return baseCurrency(), nil
+		}
+		return fallback, nil
+	}
+	currency := strings.ToUpper(strings.TrimSpace(*code))
+	if !isStoreCurrency(storeCurrencies(), currency) {
+		return "", errors.New(400, "Currency %s is not accepted; choose one of %s!", currency,
+			strings.Join(storeCurrencies(), ", "))
+	}
+	return currency, nil
+}
+
+// checkMoneyCurrency fails the money given in another currency than the base one; nil money passes
+func checkMoneyCurrency(name string, dto *models.Money) errors.Error {
+	if dto == nil {
+		return nil
+	}
+	if dbModels.MoneyCurrency(dto) != baseCurrency() {
+		return errors.New(400, "The %s must be in %s, not %s!", name, baseCurrency(), dbModels.MoneyCurrency(dto))
+	}
+	if dbModels.MoneyAmount(dto) < 0 {
+		return errors.New(400, "The %s cannot be negative!", name)
+	}
+	return nil
+}
+
+// checkProductPrices checks the prices set by hand are given once per currency other than the base one
+func checkProductPrices(item *models.Product) errors.Error {
+	seen := make(map[string]bool, len(item.Prices))
+	for _, price := range item.Prices {
+		currency := dbModels.MoneyCurrency(price)
+		if currency == baseCurrency() {
+			return errors.New(400, "The product price in the base currency %s is set by its price!", currency)
+		}
+		if seen[currency] {
+			return errors.New(400, "The product price in %s is given more than once!", currency)
+		}
+		if dbModels.MoneyAmount(price) < 0 {
+			return errors.New(400, "The product price in %s cannot be negative!", currency)
+		}
+		seen[currency] = true
+	}
+	return nil
+}
+
+func listCurrencies(params *currencies.ListCurrenciesParams) *models.CurrencyList {
+	return &models.CurrencyList{Base: baseCurrency(), Currencies: storeCurrencies()}
+}
+
+func allExchangeRates(params *currencies.ListExchangeRatesParams, principal *models.Principal) ([]*models.ExchangeRate, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]*dbModels.ExchangeRate, 0)
+	query := db.NewSelect().Model(&rates).Order("currency ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find exchange rates!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find exchange rates!")
+	}
+	result := make([]*models.ExchangeRate, len(rates))
+	for i, rate := range rates {
+		result[i] = rate.ToDTO()
+	}
+	return result, nil
+}
+
+func getExchangeRate(params *currencies.GetExchangeRateParams, principal *models.Principal) (*models.ExchangeRate, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := getDBExchangeRate(params.HTTPRequest.Context(), db, params.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if rate == nil {
+		return nil, errors.New(404, "Could not find exchange rate of %s!", params.Currency)
+	}
+	return rate.ToDTO(), nil
+}
+
+// setExchangeRate adds or replaces the exchange rate of the currency
+func setExchangeRate(params *currencies.SetExchangeRateParams, principal *models.Principal) (*models.ExchangeRate, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	item := params.Body
+	if item == nil {
+		return nil, errors.New(400, "Exchange rate cannot be nil!")
+	}
+	if *item.Currency != params.Currency {
+		return nil, errors.New(400, "Exchange rate currency %s does not match %s!", *item.Currency, params.Currency)
+	}
+	if params.Currency == baseCurrency() {
+		return nil, errors.New(400, "The base currency %s needs no exchange rate!", params.Currency)
+	}
+	if item.RoundingMode == "" {
+		item.RoundingMode = models.ExchangeRateRoundingModeNearest
+	}
+	if item.PriceEnding > 0 && item.PriceEnding >= item.RoundingIncrement {
+		return nil, errors.New(400, "Price ending %d must be less than the rounding increment %d!",
+			item.PriceEnding, item.RoundingIncrement)
+	}
+
+	dbModel := dbModels.NewExchangeRateFrom(item)
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		existing, err := getDBExchangeRate(ctx, tx, params.Currency)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			query := tx.NewInsert().Model(dbModel).ExcludeColumn("id")
+			Logger.Debug("Built the query %s\n", query)
+			if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+				Logger.Error("ERROR %v: Could not add exchange rate of %s!\n", sqlErr, params.Currency)
+				return errors.New(500, "ERROR: Could not add exchange rate of %s!", params.Currency)
+			}
+			return nil
+		}
+		dbModel.ID = existing.ID
+		query := tx.NewUpdate().Model(dbModel).ExcludeColumn("id").Where("id = ?", existing.ID)
+		Logger.Debug("Built the query %s\n", query)
+		if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not update exchange rate of %s!\n", sqlErr, params.Currency)
+			return errors.New(500, "ERROR: Could not update exchange rate of %s!", params.Currency)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func deleteExchangeRate(params *currencies.DeleteExchangeRateParams, principal *models.Principal) errors.Error {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return err
+	}
+	// the orders keep their prices, so they are not affected
+	query := db.NewDelete().TableExpr("exchange_rates").Where("currency = ?", params.Currency)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(params.HTTPRequest.Context()); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete exchange rate of %s!\n", sqlErr, params.Currency)
+		return errors.New(500, "ERROR: Could not delete exchange rate of %s!", params.Currency)
+	}
+	return nil
+}
+
+// getDBExchangeRate finds the exchange rate of the currency; nil is returned if there is none
+func getDBExchangeRate(ctx context.Context, idb bun.IDB, currency string) (*dbModels.ExchangeRate, errors.Error) {
+	rates := make([]*dbModels.ExchangeRate, 0)
+	query := idb.NewSelect().Model(&rates).Where("currency = ?", currency).Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find exchange rate of %s!\n", sqlErr, currency)
+		return nil, errors.New(500, "ERROR: Could not find exchange rate of %s!", currency)
+	}
+	if len(rates) == 0 {
+		return nil, nil
+	}
+	return rates[0], nil
+}
+
+// currencyConverter prices the catalog and converts the base currency amounts in a store currency
+type currencyConverter struct {
+	currency string
+	// nil for the base currency and for the currencies having no exchange rate
+	rate *dbModels.ExchangeRate
+}
+
+func newCurrencyConverter(ctx context.Context, idb bun.IDB, currency string) (*currencyConverter, errors.Error) {
+	result := &currencyConverter{currency: currency}
+	if currency == baseCurrency() {
+		return result, nil
+	}
+	rate, err := getDBExchangeRate(ctx, idb, currency)
+	if err != nil {
+		return nil, err
+	}
+	result.rate = rate
+	return result, nil
+}
+
+// convert converts the base currency amount, e. g., a threshold or an amount off, rounding half to even
+func (c *currencyConverter) convert(amount int64) (int64, errors.Error) {
+	if amount == 0 || c.currency == baseCurrency() {
+		return amount, nil
+	}
+	if c.rate == nil {
+		return 0, errors.New(409, "There is no exchange rate of %s to convert %s!", c.currency,
+			money.New(amount, baseCurrency()))
+	}
+	return money.Convert(amount, baseCurrency(), c.currency, *c.rate.Rate), nil
+}
+
+// convertPrice converts the base currency price and rounds it by the rules of the exchange rate;
+// a zero price, e. g., of free shipping, stays free rather than rounded up to the price ending
+func (c *currencyConverter) convertPrice(amount int64) (int64, errors.Error) {
+	converted, err := c.convert(amount)
+	if err != nil || c.rate == nil || converted == 0 {
+		return converted, err
+	}
+	return money.RoundPrice(converted, c.rate.RoundingIncrement, c.rate.PriceEnding, c.rate.RoundingMode), nil
+}
+
+// productPrice returns the unit price of the product: the price set by hand in the currency or the converted one
+func (c *currencyConverter) productPrice(product *dbModels.Product) (int64, errors.Error) {
+	if c.currency == product.Currency || product.Currency == "" && c.currency == baseCurrency() {
+		return product.Price, nil
+	}
+	if price, ok := product.PriceIn(c.currency); ok {
+		return price, nil
+	}
+	price, err := c.convertPrice(product.Price)
+	if err != nil {
+		return 0, errors.New(409, "Product %s has no price in %s!", *product.Title, c.currency)
+	}
+	return price, nil
+}
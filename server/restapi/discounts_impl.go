@@ -0,0 +1,282 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// applyPromotions calculates the discounts of the active automatic promotions and of the order coupon,
+// replaces the order discount lines and promotion redemptions and returns the total discount.
+// The order products must already have their total prices calculated and the order shipping price applied.
+// An invalid coupon fails the whole calculation, while automatic promotions just get skipped.
+// The amounts are in the minor units of the order currency; the promotion amounts are converted to it.
+func applyPromotions(ctx context.Context, idb bun.IDB, order *dbModels.Order, converter *currencyConverter, subtotal int64) (int64, errors.Error) {
+	for _, table := range []string{"order_discounts", "promotion_redemptions"} {
+		delQuery := idb.NewDelete().TableExpr(table).Where("order_id = ?", order.ID)
+		Logger.Debug("Built the query %s\n", delQuery)
+		_, sqlErr := delQuery.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete order %d %s!\n", sqlErr, order.ID, table)
+			return 0, errors.New(500, "Could not delete order %d discounts!", order.ID)
+		}
+	}
+	order.CouponCode = normalizeCouponCode(order.CouponCode)
+	order.Discounts = make([]*dbModels.OrderDiscount, 0)
+	order.DiscountTotal = 0
+
+	candidates, err := findApplicablePromotions(ctx, idb, order.CouponCode)
+	if err != nil {
+		return 0, err
+	}
+	productCategories, err := findOrderedProductCategories(ctx, idb, order)
+	if err != nil {
+		return 0, err
+	}
+
+	redemptions := make([]*dbModels.PromotionRedemption, 0)
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	remaining := subtotal
+	for _, p := range candidates {
+		isCoupon := p.Code != ""
+		minOrderValue, err := converter.convert(p.MinOrderValue)
+		if err == nil && subtotal < minOrderValue {
+			err = errors.New(400, "Coupon %s requires the order total of at least %s!", p.Code,
+				money.New(minOrderValue, order.Currency))
+		}
+		if err != nil {
+			if isCoupon {
+				return 0, err
+			}
+			Logger.Debug("Skipping promotion %d: %s", p.ID, err.Error())
+			continue
+		}
+		amountOff, err := converter.convert(p.AmountOff)
+		if err != nil {
+			if isCoupon {
+				return 0, err
+			}
+			Logger.Debug("Skipping promotion %d: %s", p.ID, err.Error())
+			continue
+		}
+		err = checkPromotionUsageLimits(ctx, idb, p, order)
+		if err != nil {
+			if isCoupon {
+				return 0, err
+			}
+			Logger.Debug("Skipping promotion %d: %s", p.ID, err.Error())
+			continue
+		}
+
+		discounts := calculatePromotionDiscounts(p, amountOff, order.Products, productCategories)
+		if len(discounts) == 0 && isCoupon {
+			return 0, errors.New(400, "Coupon %s is not applicable to the ordered products!", p.Code)
+		}
+		for _, discount := range discounts {
+			if discount.Kind == models.PromotionKindFreeShipping {
+				// the shipping price is not a part of the subtotal the product discounts are capped by
+				discount.Amount = order.ShippingPrice
+			} else {
+				discount.Amount = money.Min(discount.Amount, remaining)
+				remaining -= discount.Amount
+			}
+			discount.OrderID = order.ID
+			order.Discounts = append(order.Discounts, discount)
+			order.DiscountTotal += discount.Amount
+		}
+		if len(discounts) > 0 {
+			redemptions = append(redemptions, &dbModels.PromotionRedemption{
+				DateCreated: nowUnixEpoch,
+				OrderID:     order.ID,
+				PromotionID: p.ID,
+				UserID:      order.UserID,
+			})
+		}
+	}
+
+	if len(order.Discounts) > 0 {
+		query := idb.NewInsert().Model(&order.Discounts).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %d discounts!\n", sqlErr, order.ID)
+			return 0, errors.New(500, "ERROR: Could not add order %d discounts!", order.ID)
+		}
+
+		query = idb.NewInsert().Model(&redemptions).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr = query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %d promotion redemptions!\n", sqlErr, order.ID)
+			return 0, errors.New(500, "ERROR: Could not add order %d promotion redemptions!", order.ID)
+		}
+	}
+	Logger.Debug("Order %d discount total: %d", order.ID, order.DiscountTotal)
+	return order.DiscountTotal, nil
+}
+
+// findApplicablePromotions finds the active automatic promotions and the promotion of the coupon code
+// which are valid now
+func findApplicablePromotions(ctx context.Context, idb bun.IDB, couponCode string) ([]*dbModels.Promotion, errors.Error) {
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	result := make([]*dbModels.Promotion, 0)
+	query := idb.NewSelect().Model(&result).
+		Where("active = ?", true).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("code = ''").WhereOr("code IS NULL")
+			if couponCode != "" {
+				q = q.WhereOr("code = ?", couponCode)
+			}
+			return q
+		}).
+		Where("valid_from = 0 OR valid_from IS NULL OR valid_from <= ?", nowUnixEpoch).
+		Where("valid_to = 0 OR valid_to IS NULL OR valid_to >= ?", nowUnixEpoch).
+		Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find promotions!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find promotions!")
+	}
+	if couponCode == "" {
+		return result, nil
+	}
+	for _, p := range result {
+		if p.Code == couponCode {
+			return result, nil
+		}
+	}
+	return nil, errors.New(400, "Coupon %s is not valid!", couponCode)
+}
+
+func checkPromotionUsageLimits(ctx context.Context, idb bun.IDB, p *dbModels.Promotion, order *dbModels.Order) errors.Error {
+	if p.UsageLimit > 0 {
+		count, err := countPromotionRedemptions(ctx, idb, p.ID, 0)
+		if err != nil {
+			return err
+		}
+		if count >= p.UsageLimit {
+			return errors.New(409, "Promotion %s usage limit is reached!", *p.Title)
+		}
+	}
+	if p.UsageLimitPerCustomer > 0 && (order.UserID > 0 || order.GuestEmail != "") {
+		var count int64
+		var err errors.Error
+		if order.UserID > 0 {
+			count, err = countPromotionRedemptions(ctx, idb, p.ID, order.UserID)
+		} else {
+			count, err = countGuestPromotionRedemptions(ctx, idb, p.ID, order.GuestEmail)
+		}
+		if err != nil {
+			return err
+		}
+		if count >= p.UsageLimitPerCustomer {
+			return errors.New(409, "Promotion %s usage limit per customer is reached!", *p.Title)
+		}
+	}
+	return nil
+}
+
+// findOrderedProductCategories maps the ordered product IDs to their category IDs
+func findOrderedProductCategories(ctx context.Context, idb bun.IDB, order *dbModels.Order) (map[int64][]int64, errors.Error) {
+	productIDs := make([]int64, len(order.Products))
+	for i, product := range order.Products {
+		productIDs[i] = *product.ProductID
+	}
+	links := make([]*dbModels.ProductToCategory, 0)
+	query := idb.NewSelect().Model(&links).Where("product_id in (?)", bun.In(productIDs))
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find categories of order %d products!\n", sqlErr, order.ID)
+		return nil, errors.New(500, "ERROR: Could not find categories of order %d products!", order.ID)
+	}
+	result := make(map[int64][]int64)
+	for _, link := range links {
+		result[link.ProductID] = append(result[link.ProductID], link.CategoryID)
+	}
+	return result, nil
+}
+
+// isProductTargeted checks whether the promotion targets the product;
+// promotions without product and category lists target all products
+func isProductTargeted(p *dbModels.Promotion, productID int64, categoryIDs []int64) bool {
+	if len(p.ProductIds) == 0 && len(p.CategoryIds) == 0 {
+		return true
+	}
+	for _, id := range p.ProductIds {
+		if id == productID {
+			return true
+		}
+	}
+	for _, id := range p.CategoryIds {
+		for _, categoryID := range categoryIDs {
+			if id == categoryID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// calculatePromotionDiscounts builds the discount lines of the promotion for the ordered products; the amount off
+// of the fixed promotions is in the order currency. The amounts are not capped by the order total yet,
+// and the percentages are rounded half to even per line.
+func calculatePromotionDiscounts(p *dbModels.Promotion, amountOff int64, products []*dbModels.OrderedProduct, productCategories map[int64][]int64) []*dbModels.OrderDiscount {
+	isTargeted := len(p.ProductIds) > 0 || len(p.CategoryIds) > 0
+	newDiscount := func(productID int64, amount int64) *dbModels.OrderDiscount {
+		return &dbModels.OrderDiscount{
+			Amount:      amount,
+			Code:        p.Code,
+			Kind:        *p.Kind,
+			ProductID:   productID,
+			PromotionID: p.ID,
+			Title:       *p.Title,
+		}
+	}
+
+	eligible := make([]*dbModels.OrderedProduct, 0, len(products))
+	var eligibleSubtotal int64 = 0
+	for _, product := range products {
+		if product.TotalPrice == 0 || !isProductTargeted(p, *product.ProductID, productCategories[*product.ProductID]) {
+			continue
+		}
+		eligible = append(eligible, product)
+		eligibleSubtotal += product.TotalPrice
+	}
+	if len(eligible) == 0 {
+		return nil
+	}
+
+	result := make([]*dbModels.OrderDiscount, 0)
+	switch *p.Kind {
+	case models.PromotionKindPercentage:
+		if !isTargeted {
+			return append(result, newDiscount(0, money.Percent(eligibleSubtotal, p.Value)))
+		}
+		for _, product := range eligible {
+			result = append(result, newDiscount(*product.ProductID, money.Percent(product.TotalPrice, p.Value)))
+		}
+	case models.PromotionKindFixed:
+		result = append(result, newDiscount(0, money.Min(amountOff, eligibleSubtotal)))
+	case models.PromotionKindBuyXGetY:
+		// every full group of "buy" + "get" units of the same product gets "get" units for free
+		for _, product := range eligible {
+			freeUnits := *product.Quantity / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+			if freeUnits > 0 {
+				result = append(result, newDiscount(*product.ProductID,
+					money.Share(product.TotalPrice, freeUnits, *product.Quantity)))
+			}
+		}
+	case models.PromotionKindFreeShipping:
+		// the amount is set to the order shipping price by applyPromotions
+		result = append(result, newDiscount(0, 0))
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package restapi
+
+import (
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestCalculatePromotionDiscounts(t *testing.T) {
+	// product 1 is in category 7
+	productCategories := map[int64][]int64{1: {7}, 2: {8}}
+	newProducts := func(quantities []int64, totals []int64) []*dbModels.OrderedProduct {
+		result := testOrderedProducts(totals...)
+		for i, quantity := range quantities {
+			result[i].Quantity = swag.Int64(quantity)
+		}
+		return result
+	}
+	type discount struct {
+		productID int64
+		amount    int64
+	}
+	tests := []struct {
+		name      string
+		promotion dbModels.Promotion
+		amountOff int64
+		products  []*dbModels.OrderedProduct
+		want      []discount
+	}{
+		{"percentage of the order", dbModels.Promotion{Kind: swag.String(models.PromotionKindPercentage), Value: 10},
+			0, testOrderedProducts(1005, 2000), []discount{{0, 300}}},
+		{"percentage of a product", dbModels.Promotion{Kind: swag.String(models.PromotionKindPercentage), Value: 15,
+			ProductIds: []int64{2}},
+			0, testOrderedProducts(1005, 2003), []discount{{2, 300}}},
+		{"percentage of a category", dbModels.Promotion{Kind: swag.String(models.PromotionKindPercentage),
+			Value: 12.5, CategoryIds: []int64{7}},
+			0, testOrderedProducts(1004, 2000), []discount{{1, 126}}},
+		{"fixed", dbModels.Promotion{Kind: swag.String(models.PromotionKindFixed)},
+			500, testOrderedProducts(1000, 2000), []discount{{0, 500}}},
+		{"fixed over the subtotal", dbModels.Promotion{Kind: swag.String(models.PromotionKindFixed)},
+			5000, testOrderedProducts(1000, 2000), []discount{{0, 3000}}},
+		{"fixed over the targeted subtotal", dbModels.Promotion{Kind: swag.String(models.PromotionKindFixed),
+			ProductIds: []int64{1}},
+			1500, testOrderedProducts(1000, 2000), []discount{{0, 1000}}},
+		{"buy 2 get 1", dbModels.Promotion{Kind: swag.String(models.PromotionKindBuyXGetY), BuyQuantity: 2,
+			GetQuantity: 1},
+			0, newProducts([]int64{7, 2}, []int64{700, 1999}), []discount{{1, 200}}},
+		{"free shipping", dbModels.Promotion{Kind: swag.String(models.PromotionKindFreeShipping)},
+			0, testOrderedProducts(1000), []discount{{0, 0}}},
+		{"no targeted products", dbModels.Promotion{Kind: swag.String(models.PromotionKindPercentage), Value: 10,
+			ProductIds: []int64{9}},
+			0, testOrderedProducts(1000, 2000), nil},
+		{"free product", dbModels.Promotion{Kind: swag.String(models.PromotionKindPercentage), Value: 10,
+			ProductIds: []int64{1}},
+			0, testOrderedProducts(0, 2000), nil},
+	}
+	for _, tt := range tests {
+		tt.promotion.ID = 3
+		tt.promotion.Code = "SALE"
+		tt.promotion.Title = swag.String("Sale")
+		var got []discount
+		for _, d := range calculatePromotionDiscounts(&tt.promotion, tt.amountOff, tt.products, productCategories) {
+			got = append(got, discount{d.ProductID, d.Amount})
+			if d.PromotionID != 3 || d.Code != "SALE" || d.Title != "Sale" || d.Kind != *tt.promotion.Kind {
+				t.Errorf("%s: discount %+v does not describe the promotion", tt.name, d)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: discounts = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
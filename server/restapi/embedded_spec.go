@@ -59,6 +59,185 @@ func init() {
         }
       }
     },
+    "/cart": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Get the cart of the current user or the anonymous cart identified by the cart token",
+        "operationId": "getCart",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/cart"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Replace the cart items",
+        "operationId": "updateCart",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cart"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/cart"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Remove all the cart items",
+        "operationId": "clearCart",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Cleared"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/cart/checkout": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Convert the cart into an order",
+        "operationId": "checkoutCart",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cart_checkout"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/categories": {
       "get": {
         "security": [],
@@ -151,11 +330,34 @@ func init() {
         ],
         "summary": "Get category by ID",
         "operationId": "getCategory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "$ref": "#/definitions/category"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
             }
           },
           "default": {
@@ -177,12 +379,21 @@ func init() {
         "tags": [
           "category"
         ],
-        "summary": "Edit category by ID",
+        "summary": "Replace category by ID",
         "operationId": "editCategory",
         "parameters": [
           {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/category"
             }
@@ -193,6 +404,18 @@ func init() {
             "description": "OK",
             "schema": {
               "$ref": "#/definitions/category"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "default": {
@@ -228,6 +451,68 @@ func init() {
           }
         }
       },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "category"
+        ],
+        "summary": "Patch category by ID",
+        "operationId": "patchCategory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/category"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
       "parameters": [
         {
           "type": "integer",
@@ -238,6 +523,48 @@ func init() {
         }
       ]
     },
+    "/checkout/offline": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "The bank transfer gets a payment reference, the instructions and a deadline; the order stays pending until the transfer is received. The orders paid cash on delivery are fulfilled right away and paid when delivered. The open checkout sessions of the order are expired.",
+        "tags": [
+          "checkout"
+        ],
+        "summary": "Pay the order by bank transfer or cash on delivery",
+        "operationId": "addOfflinePayment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/offline_checkout"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/checkout/session": {
       "get": {
         "security": [
@@ -315,19 +642,19 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/currencies": {
       "get": {
         "security": [],
         "tags": [
-          "auth"
+          "currencies"
         ],
-        "summary": "Login through oauth2 server",
-        "operationId": "login",
+        "summary": "List the currencies the customers may choose",
+        "operationId": "listCurrencies",
         "responses": {
           "200": {
-            "description": "Login",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/principal"
+              "$ref": "#/definitions/currency_list"
             }
           },
           "default": {
@@ -339,62 +666,27 @@ func init() {
         }
       }
     },
-    "/orders": {
+    "/currencies/rates": {
       "get": {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
         "tags": [
-          "orders"
-        ],
-        "summary": "List orders",
-        "operationId": "listOrders",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "id",
-              "date_created",
-              "date_updated"
-            ],
-            "type": "string",
-            "name": "orderBy",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "asc",
-              "desc"
-            ],
-            "type": "string",
-            "name": "order",
-            "in": "query"
-          }
+          "currencies"
         ],
+        "summary": "List the exchange rates of the base currency",
+        "operationId": "listExchangeRates",
         "responses": {
           "200": {
-            "description": "Get order list",
+            "description": "Get exchange rate list",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/order"
+                "$ref": "#/definitions/exchange_rate"
               }
             }
           },
@@ -405,66 +697,27 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "orders"
-        ],
-        "summary": "Add order",
-        "operationId": "addOrder",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/order"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "$ref": "#/definitions/order"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
       }
     },
-    "/orders/{id}": {
+    "/currencies/rates/{currency}": {
       "get": {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
         "tags": [
-          "order"
+          "currencies"
         ],
-        "summary": "Get order by ID",
-        "operationId": "getOrder",
+        "summary": "Get the exchange rate of the base currency to the currency",
+        "operationId": "getExchangeRate",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/order"
+              "$ref": "#/definitions/exchange_rate"
             }
           },
           "default": {
@@ -479,22 +732,22 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
         "tags": [
-          "order"
+          "currencies"
         ],
-        "summary": "Edit order by ID",
-        "operationId": "editOrder",
+        "summary": "Set the exchange rate of the base currency to the currency with its rounding rules",
+        "operationId": "setExchangeRate",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/order"
+              "$ref": "#/definitions/exchange_rate"
             }
           }
         ],
@@ -502,7 +755,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/order"
+              "$ref": "#/definitions/exchange_rate"
             }
           },
           "default": {
@@ -517,16 +770,15 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
         "tags": [
-          "order"
+          "currencies"
         ],
-        "summary": "Delete order by ID",
-        "operationId": "deleteOrder",
+        "summary": "Delete the exchange rate of the base currency to the currency",
+        "operationId": "deleteExchangeRate",
         "responses": {
           "204": {
             "description": "Deleted"
@@ -541,82 +793,40 @@ func init() {
       },
       "parameters": [
         {
-          "type": "integer",
-          "format": "int64",
-          "name": "id",
+          "pattern": "^[A-Z]{3}$",
+          "type": "string",
+          "name": "currency",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/payments": {
-      "get": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
+    "/guest/orders": {
+      "post": {
+        "security": [],
         "tags": [
-          "payments"
+          "guest"
         ],
-        "summary": "List payments",
-        "operationId": "listPayments",
+        "summary": "Place an order without an account; the products are taken from the anonymous cart if not given",
+        "operationId": "addGuestOrder",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
           },
           {
-            "type": "integer",
-            "format": "int64",
-            "name": "offset",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Get payment list",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/payment"
-              }
-            }
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
           },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "payments"
-        ],
-        "summary": "Add payment",
-        "operationId": "addPayment",
-        "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/payment"
+              "$ref": "#/definitions/guest_order"
             }
           }
         ],
@@ -624,11 +834,11 @@ func init() {
           "201": {
             "description": "Created",
             "schema": {
-              "$ref": "#/definitions/payment"
+              "$ref": "#/definitions/guest_order_access"
             }
           },
           "default": {
-            "description": "error",
+            "description": "Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -636,26 +846,19 @@ func init() {
         }
       }
     },
-    "/payments/{id}": {
+    "/guest/orders/{id}": {
       "get": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
+        "security": [],
         "tags": [
-          "payment"
+          "guest"
         ],
-        "summary": "Get payment by ID",
-        "operationId": "getPayment",
+        "summary": "Get the guest order authorized by its access token",
+        "operationId": "getGuestOrder",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/payment"
+              "$ref": "#/definitions/order"
             }
           },
           "default": {
@@ -666,32 +869,43 @@ func init() {
           }
         }
       },
-      "put": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
+        }
+      ]
+    },
+    "/guest/orders/{id}/checkout/offline": {
+      "post": {
+        "security": [],
         "tags": [
-          "payment"
+          "guest"
         ],
-        "summary": "Edit payment by ID",
-        "operationId": "editPayment",
+        "summary": "Pay the guest order authorized by its access token by bank transfer or cash on delivery",
+        "operationId": "addGuestOfflinePayment",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/payment"
+              "$ref": "#/definitions/offline_checkout"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "201": {
+            "description": "Created",
             "schema": {
               "$ref": "#/definitions/payment"
             }
@@ -704,23 +918,36 @@ func init() {
           }
         }
       },
-      "delete": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
+        }
+      ]
+    },
+    "/guest/orders/{id}/checkout/session": {
+      "post": {
+        "security": [],
         "tags": [
-          "payment"
+          "guest"
         ],
-        "summary": "Delete payment by ID",
-        "operationId": "deletePaymet",
+        "summary": "Add checkout session of the guest order authorized by its access token",
+        "operationId": "addGuestCheckoutSession",
         "responses": {
-          "204": {
-            "description": "Deleted"
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/checkout_session_secret"
+            }
           },
           "default": {
             "description": "Error",
@@ -737,179 +964,36 @@ func init() {
           "name": "id",
           "in": "path",
           "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
         }
       ]
     },
-    "/products": {
-      "get": {
-        "security": [],
-        "tags": [
-          "products"
-        ],
-        "summary": "List products",
-        "operationId": "getProducts",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "search",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "collectionFormat": "csv",
-            "name": "categoryIds",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Get product list",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/product"
-              }
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
+    "/guest/orders/{id}/claim": {
       "post": {
         "security": [
           {
             "OauthSecurity": [
-              "admin"
-            ]
-          }
-        ],
-        "tags": [
-          "products"
-        ],
-        "summary": "Add product",
-        "operationId": "addProduct",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/product"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "$ref": "#/definitions/product"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/products/{id}": {
-      "get": {
-        "security": [],
-        "tags": [
-          "product"
-        ],
-        "summary": "Get product by ID",
-        "operationId": "getProduct",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/product"
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "put": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin"
+              "admin",
+              "private"
             ]
           }
         ],
         "tags": [
-          "product"
-        ],
-        "summary": "Edit product by ID",
-        "operationId": "editProduct",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/product"
-            }
-          }
+          "guest"
         ],
+        "summary": "Move the guest order to the account of the current user having the verified order email",
+        "operationId": "claimGuestOrder",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/product"
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/order"
             }
-          }
-        }
-      },
-      "delete": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin"
-            ]
-          }
-        ],
-        "tags": [
-          "product"
-        ],
-        "summary": "Delete product by ID",
-        "operationId": "deleteProduct",
-        "responses": {
-          "204": {
-            "description": "Deleted"
           },
           "default": {
             "description": "Error",
@@ -926,29 +1010,28 @@ func init() {
           "name": "id",
           "in": "path",
           "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
         }
       ]
     },
-    "/user": {
+    "/login": {
       "get": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
+        "security": [],
         "tags": [
-          "user"
+          "auth"
         ],
-        "summary": "Get own user information",
-        "operationId": "getOwnUser",
+        "summary": "Login through oauth2 server",
+        "operationId": "login",
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Login",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/principal"
             }
           },
           "default": {
@@ -960,20 +1043,21 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/orders": {
       "get": {
         "security": [
           {
             "OauthSecurity": [
-              "admin"
+              "admin",
+              "private"
             ]
           }
         ],
         "tags": [
-          "users"
+          "orders"
         ],
-        "summary": "List users",
-        "operationId": "listUsers",
+        "summary": "List orders",
+        "operationId": "listOrders",
         "parameters": [
           {
             "type": "integer",
@@ -988,19 +1072,112 @@ func init() {
             "name": "offset",
             "in": "query"
           },
+          {
+            "enum": [
+              "id",
+              "date_created",
+              "date_updated"
+            ],
+            "type": "string",
+            "name": "orderBy",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "pending_payment",
+                "paid",
+                "processing",
+                "shipped",
+                "delivered",
+                "cancelled",
+                "partially_refunded",
+                "refunded"
+              ],
+              "type": "string"
+            },
+            "description": "Order statuses to match",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or after this Unix time",
+            "name": "dateFrom",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or before this Unix time",
+            "name": "dateTo",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Part of the customer email or name",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders containing the product",
+            "name": "productId",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "minTotal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "maxTotal",
+            "in": "query"
+          },
+          {
+            "pattern": "^[A-Z]{3}$",
+            "type": "string",
+            "description": "Orders priced in this ISO 4217 currency",
+            "name": "currency",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Orders having a payment in this status",
+            "name": "paymentStatus",
+            "in": "query"
+          },
           {
             "type": "string",
+            "description": "Text to search in the delivery info",
             "name": "search",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Get user list",
+            "description": "Get order list",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/user"
+                "$ref": "#/definitions/order"
               }
             }
           },
@@ -1016,21 +1193,28 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin"
-            ]
+              "admin",
+              "private"
+            ]
           }
         ],
         "tags": [
-          "users"
+          "orders"
         ],
-        "summary": "Add user",
-        "operationId": "addUser",
+        "summary": "Add order",
+        "operationId": "addOrder",
         "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
           {
             "name": "body",
             "in": "body",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/order"
             }
           }
         ],
@@ -1038,7 +1222,7 @@ func init() {
           "201": {
             "description": "Created",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/order"
             }
           },
           "default": {
@@ -1050,7 +1234,137 @@ func init() {
         }
       }
     },
-    "/users/{id}": {
+    "/orders/export": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "text/csv",
+          "application/json"
+        ],
+        "tags": [
+          "orders"
+        ],
+        "summary": "Export the orders matching the filter as CSV or JSON",
+        "operationId": "exportOrders",
+        "parameters": [
+          {
+            "enum": [
+              "csv",
+              "json"
+            ],
+            "type": "string",
+            "default": "csv",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "pending_payment",
+                "paid",
+                "processing",
+                "shipped",
+                "delivered",
+                "cancelled",
+                "partially_refunded",
+                "refunded"
+              ],
+              "type": "string"
+            },
+            "description": "Order statuses to match",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or after this Unix time",
+            "name": "dateFrom",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or before this Unix time",
+            "name": "dateTo",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Part of the customer email or name",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders containing the product",
+            "name": "productId",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "minTotal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "maxTotal",
+            "in": "query"
+          },
+          {
+            "pattern": "^[A-Z]{3}$",
+            "type": "string",
+            "description": "Orders priced in this ISO 4217 currency",
+            "name": "currency",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Orders having a payment in this status",
+            "name": "paymentStatus",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Text to search in the delivery info",
+            "name": "search",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Exported orders",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/{id}": {
       "get": {
         "security": [
           {
@@ -1061,15 +1375,38 @@ func init() {
           }
         ],
         "tags": [
-          "user"
+          "order"
+        ],
+        "summary": "Get order by ID",
+        "operationId": "getOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
         ],
-        "summary": "Get user by ID",
-        "operationId": "getUser",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/order"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
             }
           },
           "default": {
@@ -1090,16 +1427,25 @@ func init() {
           }
         ],
         "tags": [
-          "user"
+          "order"
         ],
-        "summary": "Edit user by ID",
-        "operationId": "editUser",
+        "summary": "Replace order by ID",
+        "operationId": "editOrder",
         "parameters": [
           {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/order"
             }
           }
         ],
@@ -1107,7 +1453,19 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/order"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "default": {
@@ -1128,10 +1486,10 @@ func init() {
           }
         ],
         "tags": [
-          "user"
+          "order"
         ],
-        "summary": "Delete user by ID",
-        "operationId": "deleteUser",
+        "summary": "Delete order by ID",
+        "operationId": "deleteOrder",
         "responses": {
           "204": {
             "description": "Deleted"
@@ -1144,6 +1502,69 @@ func init() {
           }
         }
       },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Patch order by ID",
+        "operationId": "patchOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
       "parameters": [
         {
           "type": "integer",
@@ -1154,384 +1575,557 @@ func init() {
         }
       ]
     },
-    "/webhooks/stripe/payments": {
-      "post": {
-        "security": [],
+    "/orders/{id}/invoice": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "produces": [
+          "application/pdf",
+          "application/json"
+        ],
         "tags": [
-          "webhooks",
-          "payments"
+          "invoice"
         ],
-        "summary": "Process Stripe payment event",
-        "operationId": "processStripePayment",
+        "summary": "Download the invoice or a credit note of the order as PDF",
+        "operationId": "getInvoice",
         "parameters": [
           {
             "type": "string",
-            "name": "Stripe-Signature",
-            "in": "header",
-            "required": true
+            "description": "Number of the credit note to download instead of the invoice",
+            "name": "number",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Processed"
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              }
+            }
           },
           "default": {
-            "description": "error",
+            "description": "Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "category": {
-      "type": "object",
-      "required": [
-        "title"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "id": {
+      },
+      "parameters": [
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "title": {
-          "type": "string",
-          "minLength": 1
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "checkout_order": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int64"
+    "/orders/{id}/invoices": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "invoices"
+        ],
+        "summary": "List the invoice and the credit notes issued for the order",
+        "operationId": "listInvoices",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/invoice"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "checkout_session": {
-      "type": "object",
-      "properties": {
-        "customer_email": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "checkout_session_secret": {
-      "type": "object",
-      "properties": {
-        "client_secret": {
-          "type": "string"
+    "/orders/{id}/messages": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "messages"
+        ],
+        "summary": "List the message thread of the order; the internal notes are listed to admins only",
+        "operationId": "listOrderMessages",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_message"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "httpcode": {
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "messages"
+        ],
+        "summary": "Post a message or an internal note (admins only) to the order thread",
+        "operationId": "postOrderMessage",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/order_message"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order_message"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
           "type": "integer",
-          "format": "int64"
-        },
-        "message": {
-          "type": "string"
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "order": {
-      "type": "object",
-      "required": [
-        "products",
-        "totalPrice",
-        "deliveryInfo"
-      ],
-      "properties": {
-        "dateCreated": {
+    "/orders/{id}/messages/read": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "messages"
+        ],
+        "summary": "Mark the messages of the other side of the order thread as read",
+        "operationId": "markOrderMessagesRead",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_message"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "dateUpdated": {
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/messages/{messageId}/attachments/{attachmentId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
+        "tags": [
+          "message"
+        ],
+        "summary": "Download the attachment of the order message",
+        "operationId": "getOrderMessageAttachment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              },
+              "Content-Type": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
           "type": "integer",
-          "format": "int64"
-        },
-        "deliveryInfo": {
-          "type": "string",
-          "minLength": 10
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "id": {
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "products": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/orderedProduct"
-          }
-        },
-        "status": {
-          "type": "string"
-        },
-        "totalPrice": {
-          "type": "number"
+          "name": "messageId",
+          "in": "path",
+          "required": true
         },
-        "userId": {
+        {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "name": "attachmentId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "orderedProduct": {
-      "type": "object",
-      "required": [
-        "productId",
-        "quantity",
-        "totalPrice"
-      ],
-      "properties": {
-        "inStock": {
-          "type": "boolean",
-          "readOnly": true
-        },
-        "orderId": {
+    "/orders/{id}/returns": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "List returns of the order",
+        "operationId": "listReturns",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_return"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Request return of the order lines",
+        "operationId": "requestReturn",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "productId": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "productName": {
-          "type": "string",
-          "readOnly": true
-        },
-        "quantity": {
-          "type": "integer"
-        },
-        "totalPrice": {
-          "type": "number"
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "payment": {
-      "type": "object",
-      "required": [
-        "orderId",
-        "amount"
-      ],
-      "properties": {
-        "amount": {
-          "type": "number"
-        },
-        "dateCreated": {
+    "/orders/{id}/returns/{returnId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Get return of the order",
+        "operationId": "getReturn",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "dateUpdated": {
-          "type": "integer",
-          "format": "int64"
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "id": {
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "orderId": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "status": {
-          "type": "string"
-        },
-        "userId": {
-          "type": "integer",
-          "format": "int64"
+          "name": "returnId",
+          "in": "path",
+          "required": true
         }
-      }
-    },
-    "principal": {
-      "type": "object",
-      "$ref": "#/definitions/user_info"
+      ]
     },
-    "product": {
-      "type": "object",
-      "required": [
-        "title",
-        "description"
-      ],
-      "properties": {
-        "categoryIds": {
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
+    "/orders/{id}/returns/{returnId}/decision": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
           }
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "readOnly": true
-        },
-        "images": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Approve the return issuing a return authorization or reject it",
+        "operationId": "decideReturn",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/return_decision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "numberInStock": {
-          "type": "integer"
-        },
-        "price": {
-          "type": "number"
-        },
-        "title": {
-          "type": "string",
-          "minLength": 1
         }
-      }
-    },
-    "user": {
-      "type": "object",
-      "required": [
-        "email"
-      ],
-      "properties": {
-        "dateCreated": {
+      },
+      "parameters": [
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "dateUpdated": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "email": {
-          "type": "string"
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "id": {
+        {
           "type": "integer",
           "format": "int64",
-          "readOnly": true
-        },
-        "name": {
-          "type": "string"
+          "name": "returnId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "user_info": {
-      "type": "object",
-      "properties": {
-        "ACLRole": {
-          "type": "string"
-        },
-        "accessToken": {
-          "type": "string"
-        },
-        "email": {
-          "type": "string"
-        },
-        "emailVerified": {
-          "type": "boolean"
-        },
-        "familyName": {
-          "type": "string"
-        },
-        "givenName": {
-          "type": "string"
-        },
-        "locale": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "picture": {
-          "type": "string"
-        },
-        "sub": {
-          "type": "string"
+    "/orders/{id}/returns/{returnId}/inspection": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Record the received items, restock the sellable ones and refund the return",
+        "operationId": "inspectReturn",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/return_inspection"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "user": {
-          "type": "object",
-          "$ref": "#/definitions/user"
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "returnId",
+          "in": "path",
+          "required": true
         }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "OauthSecurity": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "https://accounts.google.com/o/oauth2/v2/auth",
-      "tokenUrl": "https://oauth2.googleapis.com/token",
-      "scopes": {
-        "admin": "Admin scope",
-        "private": "Private scope",
-        "user": "User scope"
-      }
-    }
-  },
-  "security": [
-    {
-      "OauthSecurity": [
-        "user"
       ]
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https",
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Capstone project: back-end",
-    "title": "E-Store Back-end",
-    "version": "1.0.0"
-  },
-  "paths": {
-    "/auth/cb": {
-      "get": {
-        "security": [],
+    },
+    "/orders/{id}/returns/{returnId}/refund": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
         "tags": [
-          "auth"
+          "returns"
         ],
-        "summary": "Obtain access token",
-        "operationId": "getAccessToken",
+        "summary": "Retry the refund of the inspected return pending its refund",
+        "operationId": "retryReturnRefund",
         "responses": {
           "200": {
-            "description": "Login",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/principal"
+              "$ref": "#/definitions/order_return"
             }
           },
           "default": {
@@ -1541,43 +2135,46 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/categories": {
+    "/orders/{id}/shipments": {
       "get": {
-        "security": [],
-        "tags": [
-          "categories"
-        ],
-        "summary": "List categories",
-        "operationId": "listCategories",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "name": "offset",
-            "in": "query"
-          },
+        "security": [
           {
-            "type": "string",
-            "name": "search",
-            "in": "query"
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
           }
         ],
+        "tags": [
+          "shipments"
+        ],
+        "summary": "List shipments of the order",
+        "operationId": "listShipments",
         "responses": {
           "200": {
-            "description": "Get category list",
+            "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/category"
+                "$ref": "#/definitions/shipment"
               }
             }
           },
@@ -1598,16 +2195,17 @@ func init() {
           }
         ],
         "tags": [
-          "categories"
+          "shipments"
         ],
-        "summary": "Add category",
-        "operationId": "addCategory",
+        "summary": "Add shipment of the order items; the label is created by the carrier if no tracking number is given",
+        "operationId": "addShipment",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/category"
+              "$ref": "#/definitions/shipment"
             }
           }
         ],
@@ -1615,31 +2213,47 @@ func init() {
           "201": {
             "description": "Created",
             "schema": {
-              "$ref": "#/definitions/category"
+              "$ref": "#/definitions/shipment"
             }
           },
           "default": {
-            "description": "error",
+            "description": "Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/categories/{id}": {
+    "/orders/{id}/shipments/{shipmentId}": {
       "get": {
-        "security": [],
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
         "tags": [
-          "category"
+          "shipment"
         ],
-        "summary": "Get category by ID",
-        "operationId": "getCategory",
+        "summary": "Get shipment of the order",
+        "operationId": "getShipment",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/category"
+              "$ref": "#/definitions/shipment"
             }
           },
           "default": {
@@ -1650,6 +2264,24 @@ func init() {
           }
         }
       },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments/{shipmentId}/status": {
       "put": {
         "security": [
           {
@@ -1659,16 +2291,17 @@ func init() {
           }
         ],
         "tags": [
-          "category"
+          "shipment"
         ],
-        "summary": "Edit category by ID",
-        "operationId": "editCategory",
+        "summary": "Move shipment to another status manually (e. g., for carriers without integration)",
+        "operationId": "changeShipmentStatus",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/category"
+              "$ref": "#/definitions/shipment_status_change"
             }
           }
         ],
@@ -1676,7 +2309,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/category"
+              "$ref": "#/definitions/shipment"
             }
           },
           "default": {
@@ -1687,7 +2320,25 @@ func init() {
           }
         }
       },
-      "delete": {
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments/{shipmentId}/tracking": {
+      "post": {
         "security": [
           {
             "OauthSecurity": [
@@ -1696,13 +2347,16 @@ func init() {
           }
         ],
         "tags": [
-          "category"
+          "shipment"
         ],
-        "summary": "Delete category by ID",
-        "operationId": "deleteCategory",
+        "summary": "Poll the carrier for the shipment tracking status",
+        "operationId": "refreshShipmentTracking",
         "responses": {
-          "204": {
-            "description": "Deleted"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipment"
+            }
           },
           "default": {
             "description": "Error",
@@ -1719,47 +2373,18 @@ func init() {
           "name": "id",
           "in": "path",
           "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "/checkout/session": {
-      "get": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "checkout"
-        ],
-        "summary": "Get checkout session",
-        "operationId": "getCheckoutSession",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "session_id",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Get checkout session",
-            "schema": {
-              "$ref": "#/definitions/checkout_session"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
+    "/orders/{id}/status": {
+      "put": {
         "security": [
           {
             "OauthSecurity": [
@@ -1769,49 +2394,25 @@ func init() {
           }
         ],
         "tags": [
-          "checkout"
+          "order"
         ],
-        "summary": "Add checkout session",
-        "operationId": "addCheckoutSession",
+        "summary": "Move order to another status",
+        "operationId": "changeOrderStatus",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/checkout_order"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "$ref": "#/definitions/checkout_session_secret"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/order_status_change"
             }
           }
-        }
-      }
-    },
-    "/login": {
-      "get": {
-        "security": [],
-        "tags": [
-          "auth"
         ],
-        "summary": "Login through oauth2 server",
-        "operationId": "login",
         "responses": {
           "200": {
-            "description": "Login",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/principal"
+              "$ref": "#/definitions/order"
             }
           },
           "default": {
@@ -1821,9 +2422,18 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/orders": {
+    "/payments": {
       "get": {
         "security": [
           {
@@ -1833,52 +2443,56 @@ func init() {
             ]
           }
         ],
+        "description": "Lists the payments of the orders of the customer; the admins see all the payments",
         "tags": [
-          "orders"
+          "payments"
         ],
-        "summary": "List orders",
-        "operationId": "listOrders",
+        "summary": "List payments",
+        "operationId": "listPayments",
         "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
-          },
           {
             "type": "integer",
             "format": "int64",
-            "name": "offset",
+            "name": "orderId",
             "in": "query"
           },
           {
             "enum": [
-              "id",
-              "date_created",
-              "date_updated"
+              "intended",
+              "requires_action",
+              "processing",
+              "authorized",
+              "failed",
+              "complete",
+              "canceled",
+              "partially_refunded",
+              "refunded"
             ],
             "type": "string",
-            "name": "orderBy",
+            "name": "status",
             "in": "query"
           },
           {
-            "enum": [
-              "asc",
-              "desc"
-            ],
-            "type": "string",
-            "name": "order",
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Get order list",
+            "description": "Get payment list",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/order"
+                "$ref": "#/definitions/payment"
               }
             }
           },
@@ -1894,22 +2508,23 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
+        "description": "Records a payment made outside of the payment gateways; the payments made through them are recorded by the checkout. A complete payment pays its unpaid order.",
         "tags": [
-          "orders"
+          "payments"
         ],
-        "summary": "Add order",
-        "operationId": "addOrder",
+        "summary": "Add payment",
+        "operationId": "addPayment",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/order"
+              "$ref": "#/definitions/payment"
             }
           }
         ],
@@ -1917,7 +2532,7 @@ func init() {
           "201": {
             "description": "Created",
             "schema": {
-              "$ref": "#/definitions/order"
+              "$ref": "#/definitions/payment"
             }
           },
           "default": {
@@ -1929,94 +2544,65 @@ func init() {
         }
       }
     },
-    "/orders/{id}": {
+    "/payments/fake/sessions/{id}": {
       "get": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
+        "security": [],
+        "description": "Available when the fake payment gateway for tests and development is enabled",
+        "produces": [
+          "text/html"
         ],
         "tags": [
-          "order"
+          "payments"
         ],
-        "summary": "Get order by ID",
-        "operationId": "getOrder",
+        "summary": "Hosted payment page of the fake payment gateway",
+        "operationId": "getFakePaymentPage",
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Payment page",
             "schema": {
-              "$ref": "#/definitions/order"
+              "type": "string"
             }
           },
           "default": {
-            "description": "Error",
+            "description": "error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "put": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
+      "post": {
+        "security": [],
+        "description": "Simulates the customer paying, failing to pay or abandoning the payment; the outcome is sent to the payment webhook as a signed event",
         "tags": [
-          "order"
+          "payments"
         ],
-        "summary": "Edit order by ID",
-        "operationId": "editOrder",
+        "summary": "Complete the checkout session of the fake payment gateway",
+        "operationId": "completeFakePayment",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/order"
-            }
+            "enum": [
+              "succeeded",
+              "failed",
+              "canceled"
+            ],
+            "type": "string",
+            "name": "outcome",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/order"
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
+          "303": {
+            "description": "Redirect to the return URL of the checkout session",
+            "headers": {
+              "Location": {
+                "type": "string"
+              }
             }
-          }
-        }
-      },
-      "delete": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "order"
-        ],
-        "summary": "Delete order by ID",
-        "operationId": "deleteOrder",
-        "responses": {
-          "204": {
-            "description": "Deleted"
           },
           "default": {
-            "description": "Error",
+            "description": "error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2025,29 +2611,28 @@ func init() {
       },
       "parameters": [
         {
-          "type": "integer",
-          "format": "int64",
+          "type": "string",
           "name": "id",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/payments": {
+    "/payments/reconciliations": {
       "get": {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
+        "description": "The reports list the discrepancies only",
         "tags": [
-          "payments"
+          "reconciliations"
         ],
-        "summary": "List payments",
-        "operationId": "listPayments",
+        "summary": "List payment reconciliation reports",
+        "operationId": "listPaymentReconciliations",
         "parameters": [
           {
             "type": "integer",
@@ -2058,18 +2643,18 @@ func init() {
           },
           {
             "type": "integer",
-            "format": "int64",
+            "format": "int32",
             "name": "offset",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Get payment list",
+            "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/payment"
+                "$ref": "#/definitions/payment_reconciliation"
               }
             }
           },
@@ -2085,34 +2670,25 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
+        "description": "Checks the payments which are not final against their checkout sessions, fixes the statuses, expires the stale sessions and reports the differences; 409 is returned while a reconciliation is running",
         "tags": [
-          "payments"
-        ],
-        "summary": "Add payment",
-        "operationId": "addPayment",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/payment"
-            }
-          }
+          "reconciliations"
         ],
+        "summary": "Reconcile the payments with their gateways now",
+        "operationId": "reconcilePayments",
         "responses": {
           "201": {
             "description": "Created",
             "schema": {
-              "$ref": "#/definitions/payment"
+              "$ref": "#/definitions/payment_reconciliation"
             }
           },
           "default": {
-            "description": "error",
+            "description": "Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2120,6 +2696,45 @@ func init() {
         }
       }
     },
+    "/payments/reconciliations/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "reconciliations"
+        ],
+        "summary": "Get payment reconciliation report",
+        "operationId": "getPaymentReconciliation",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment_reconciliation"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/payments/{id}": {
       "get": {
         "security": [
@@ -2154,20 +2769,22 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
+        "description": "The order and the method of a payment cannot be changed; the amount and the status of the payments made through the payment gateways are set by the gateways.",
         "tags": [
           "payment"
         ],
-        "summary": "Edit payment by ID",
+        "summary": "Replace payment by ID",
         "operationId": "editPayment",
         "parameters": [
           {
+            "description": "Full representation of the resource; every writable property must be given",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/payment"
             }
@@ -2192,11 +2809,11 @@ func init() {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
+        "description": "Deletes the offline payment which has not been received",
         "tags": [
           "payment"
         ],
@@ -2214,73 +2831,7 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "integer",
-          "format": "int64",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/products": {
-      "get": {
-        "security": [],
-        "tags": [
-          "products"
-        ],
-        "summary": "List products",
-        "operationId": "getProducts",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "search",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "collectionFormat": "csv",
-            "name": "categoryIds",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Get product list",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/product"
-              }
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
+      "patch": {
         "security": [
           {
             "OauthSecurity": [
@@ -2288,49 +2839,32 @@ func init() {
             ]
           }
         ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
         "tags": [
-          "products"
+          "payment"
         ],
-        "summary": "Add product",
-        "operationId": "addProduct",
+        "summary": "Patch payment by ID",
+        "operationId": "patchPayment",
         "parameters": [
           {
+            "description": "JSON merge patch; the null values clear the properties",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/product"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "$ref": "#/definitions/product"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "object"
             }
           }
-        }
-      }
-    },
-    "/products/{id}": {
-      "get": {
-        "security": [],
-        "tags": [
-          "product"
         ],
-        "summary": "Get product by ID",
-        "operationId": "getProduct",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/product"
+              "$ref": "#/definitions/payment"
             }
           },
           "default": {
@@ -2341,7 +2875,18 @@ func init() {
           }
         }
       },
-      "put": {
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}/collect": {
+      "post": {
         "security": [
           {
             "OauthSecurity": [
@@ -2349,25 +2894,17 @@ func init() {
             ]
           }
         ],
+        "description": "Completes the cash on delivery of an order delivered without the carrier tracking it; the tracked deliveries collect it by themselves",
         "tags": [
-          "product"
-        ],
-        "summary": "Edit product by ID",
-        "operationId": "editProduct",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/product"
-            }
-          }
+          "payment"
         ],
+        "summary": "Mark the cash on delivery collected",
+        "operationId": "collectPayment",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/product"
+              "$ref": "#/definitions/payment"
             }
           },
           "default": {
@@ -2378,7 +2915,18 @@ func init() {
           }
         }
       },
-      "delete": {
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}/history": {
+      "get": {
         "security": [
           {
             "OauthSecurity": [
@@ -2387,13 +2935,19 @@ func init() {
           }
         ],
         "tags": [
-          "product"
+          "payment"
         ],
-        "summary": "Delete product by ID",
-        "operationId": "deleteProduct",
+        "summary": "List the changes of the payment made by the admins",
+        "operationId": "listPaymentHistory",
         "responses": {
-          "204": {
-            "description": "Deleted"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/payment_history_entry"
+              }
+            }
           },
           "default": {
             "description": "Error",
@@ -2413,26 +2967,26 @@ func init() {
         }
       ]
     },
-    "/user": {
-      "get": {
+    "/payments/{id}/receive": {
+      "post": {
         "security": [
           {
             "OauthSecurity": [
-              "admin",
-              "private"
+              "admin"
             ]
           }
         ],
+        "description": "Completes the bank transfer and pays its order",
         "tags": [
-          "user"
+          "payment"
         ],
-        "summary": "Get own user information",
-        "operationId": "getOwnUser",
+        "summary": "Mark the bank transfer received",
+        "operationId": "receivePayment",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/payment"
             }
           },
           "default": {
@@ -2442,9 +2996,18 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/users": {
+    "/payments/{id}/refunds": {
       "get": {
         "security": [
           {
@@ -2454,37 +3017,17 @@ func init() {
           }
         ],
         "tags": [
-          "users"
-        ],
-        "summary": "List users",
-        "operationId": "listUsers",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "default": 24,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "search",
-            "in": "query"
-          }
+          "refunds"
         ],
+        "summary": "List refunds of the payment",
+        "operationId": "listRefunds",
         "responses": {
           "200": {
-            "description": "Get user list",
+            "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/user"
+                "$ref": "#/definitions/refund"
               }
             }
           },
@@ -2504,17 +3047,19 @@ func init() {
             ]
           }
         ],
+        "description": "Refunds the given amount or the paid price of the given order items; without both the rest of the payment is refunded. The order and the payment become partially refunded or refunded.\n",
         "tags": [
-          "users"
+          "refunds"
         ],
-        "summary": "Add user",
-        "operationId": "addUser",
+        "summary": "Refund the payment in full or partially through its payment gateway",
+        "operationId": "createRefund",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/refund"
             }
           }
         ],
@@ -2522,229 +3067,10753 @@ func init() {
           "201": {
             "description": "Created",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/refund"
             }
           },
           "default": {
-            "description": "error",
+            "description": "Error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/products": {
+      "get": {
+        "security": [],
+        "tags": [
+          "products"
+        ],
+        "summary": "List products",
+        "operationId": "getProducts",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "csv",
+            "name": "categoryIds",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get product list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/product"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "products"
+        ],
+        "summary": "Add product",
+        "operationId": "addProduct",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/product"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/product"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/products/{id}": {
+      "get": {
+        "security": [],
+        "tags": [
+          "product"
+        ],
+        "summary": "Get product by ID",
+        "operationId": "getProduct",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/product"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "product"
+        ],
+        "summary": "Replace product by ID",
+        "operationId": "editProduct",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/product"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/product"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "product"
+        ],
+        "summary": "Delete product by ID",
+        "operationId": "deleteProduct",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "product"
+        ],
+        "summary": "Patch product by ID",
+        "operationId": "patchProduct",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/product"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/promotions": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotions"
+        ],
+        "summary": "List promotions and coupons",
+        "operationId": "listPromotions",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get promotion list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/promotion"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotions"
+        ],
+        "summary": "Add promotion or coupon",
+        "operationId": "addPromotion",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/promotions/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotion"
+        ],
+        "summary": "Get promotion by ID",
+        "operationId": "getPromotion",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotion"
+        ],
+        "summary": "Edit promotion by ID",
+        "operationId": "editPromotion",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotion"
+        ],
+        "summary": "Delete promotion by ID",
+        "operationId": "deletePromotion",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/reports/taxes": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "reports"
+        ],
+        "summary": "Get the tax breakdown of the paid orders created within the period",
+        "operationId": "getTaxReport",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "dateFrom",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "dateTo",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Tax breakdown by country, region, tax class and rate",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tax_report_line"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/returns": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "List returns of all orders",
+        "operationId": "listAllReturns",
+        "parameters": [
+          {
+            "enum": [
+              "requested",
+              "approved",
+              "rejected",
+              "received",
+              "refund_pending",
+              "refunded"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_return"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/methods": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "List shipping methods",
+        "operationId": "listShippingMethods",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "zoneId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get shipping method list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipping_method"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Add shipping method",
+        "operationId": "addShippingMethod",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/methods/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Get shipping method by ID",
+        "operationId": "getShippingMethod",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Edit shipping method by ID",
+        "operationId": "editShippingMethod",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Delete shipping method by ID",
+        "operationId": "deleteShippingMethod",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/shipping/rates": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Quote the shipping methods available for the address and the items or the cart",
+        "operationId": "getShippingRates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_rate_request"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shipping rates sorted by price",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipping_rate"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/zones": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "List shipping zones",
+        "operationId": "listShippingZones",
+        "responses": {
+          "200": {
+            "description": "Get shipping zone list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipping_zone"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Add shipping zone",
+        "operationId": "addShippingZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/zones/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Get shipping zone by ID",
+        "operationId": "getShippingZone",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Edit shipping zone by ID",
+        "operationId": "editShippingZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Delete shipping zone by ID",
+        "operationId": "deleteShippingZone",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/tax/zones": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "taxes"
+        ],
+        "summary": "List tax zones with their rates",
+        "operationId": "listTaxZones",
+        "responses": {
+          "200": {
+            "description": "Get tax zone list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tax_zone"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "taxes"
+        ],
+        "summary": "Add tax zone with its rates",
+        "operationId": "addTaxZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tax/zones/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "tax"
+        ],
+        "summary": "Get tax zone by ID",
+        "operationId": "getTaxZone",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "tax"
+        ],
+        "summary": "Edit tax zone by ID; the rates of the zone are replaced",
+        "operationId": "editTaxZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "tax"
+        ],
+        "summary": "Delete tax zone by ID",
+        "operationId": "deleteTaxZone",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Get own user information",
+        "operationId": "getOwnUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "List users",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "search",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get user list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Add user",
+        "operationId": "addUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Get user by ID",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Replace user by ID",
+        "operationId": "editUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Delete user by ID",
+        "operationId": "deleteUser",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Patch user by ID",
+        "operationId": "patchUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{id}/addresses": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "addresses"
+        ],
+        "summary": "List the address book of the user",
+        "operationId": "listAddresses",
+        "responses": {
+          "200": {
+            "description": "Get address list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/address"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "addresses"
+        ],
+        "summary": "Add address to the address book of the user",
+        "operationId": "addAddress",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{id}/addresses/{addressId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "address"
+        ],
+        "summary": "Get address of the address book of the user",
+        "operationId": "getAddress",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "address"
+        ],
+        "summary": "Edit address of the address book of the user",
+        "operationId": "editAddress",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "address"
+        ],
+        "summary": "Delete address from the address book of the user",
+        "operationId": "deleteAddress",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "addressId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/events": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "webhooks"
+        ],
+        "summary": "List received payment gateway webhook events",
+        "operationId": "listWebhookEvents",
+        "parameters": [
+          {
+            "enum": [
+              "pending",
+              "processing",
+              "processed",
+              "failed",
+              "dead"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "gateway",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook_event"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/events/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "webhooks"
+        ],
+        "summary": "Get received webhook event with its payload",
+        "operationId": "getWebhookEvent",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/webhook_event"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/events/{id}/replay": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Schedules the processed, failed or dead event to be processed again with the attempts reset",
+        "tags": [
+          "webhooks"
+        ],
+        "summary": "Process the webhook event again",
+        "operationId": "replayWebhookEvent",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/webhook_event"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/payments/{gateway}": {
+      "post": {
+        "security": [],
+        "description": "Receives the webhook events of the payment gateway, verified by the gateway signature. The events are stored and processed in background with retries; the repeated deliveries of an event are ignored.\n",
+        "tags": [
+          "webhooks",
+          "payments"
+        ],
+        "summary": "Process payment gateway event",
+        "operationId": "processPaymentEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "gateway",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Processed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/stripe/payments": {
+      "post": {
+        "security": [],
+        "tags": [
+          "webhooks",
+          "payments"
+        ],
+        "summary": "Process Stripe payment event",
+        "operationId": "processStripePayment",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "Stripe-Signature",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Processed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/tracking/{carrier}": {
+      "post": {
+        "security": [],
+        "tags": [
+          "webhooks",
+          "shipments"
+        ],
+        "summary": "Process carrier tracking event",
+        "operationId": "processTrackingEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "carrier",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "X-Tracking-Signature",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Processed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "address": {
+      "type": "object",
+      "required": [
+        "name",
+        "line1",
+        "city",
+        "country"
+      ],
+      "properties": {
+        "city": {
+          "type": "string",
+          "minLength": 1
+        },
+        "country": {
+          "description": "ISO 3166-1 alpha-2 country code",
+          "type": "string",
+          "maxLength": 2,
+          "minLength": 2
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "isDefaultBilling": {
+          "type": "boolean"
+        },
+        "isDefaultShipping": {
+          "type": "boolean"
+        },
+        "line1": {
+          "type": "string",
+          "minLength": 1
+        },
+        "line2": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "phone": {
+          "type": "string"
+        },
+        "postalCode": {
+          "type": "string"
+        },
+        "region": {
+          "description": "State, province or county code",
+          "type": "string"
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "cart": {
+      "type": "object",
+      "properties": {
+        "currency": {
+          "description": "ISO 4217 code of the store currency the cart is priced in; kept for the later requests",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$"
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cart_item"
+          }
+        },
+        "messages": {
+          "description": "Changes made to the cart items after re-validating prices and stock",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        },
+        "token": {
+          "description": "Signed token of an anonymous cart; send it in the X-Cart-Token header",
+          "type": "string",
+          "readOnly": true
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "cart_checkout": {
+      "type": "object",
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "billingAddressId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "couponCode": {
+          "type": "string"
+        },
+        "deliveryInfo": {
+          "description": "Free-text delivery instructions",
+          "type": "string"
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "shippingAddressId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "shippingMethodId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "cart_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity"
+      ],
+      "properties": {
+        "inStock": {
+          "type": "boolean",
+          "readOnly": true
+        },
+        "price": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "productName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        }
+      }
+    },
+    "category": {
+      "type": "object",
+      "required": [
+        "title"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "title": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "checkout_order": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "checkout_session": {
+      "type": "object",
+      "properties": {
+        "customer_email": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "checkout_session_secret": {
+      "type": "object",
+      "properties": {
+        "client_secret": {
+          "type": "string"
+        },
+        "gateway": {
+          "description": "Payment gateway of the checkout session",
+          "type": "string"
+        },
+        "session_id": {
+          "type": "string"
+        },
+        "url": {
+          "description": "Hosted payment page to redirect the customer to; empty for the embedded checkout",
+          "type": "string"
+        }
+      }
+    },
+    "currency_list": {
+      "type": "object",
+      "properties": {
+        "base": {
+          "description": "ISO 4217 code of the currency the catalog is priced in",
+          "type": "string"
+        },
+        "currencies": {
+          "description": "ISO 4217 codes of the currencies the customers may choose, the base one first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "httpcode": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "exchange_rate": {
+      "type": "object",
+      "required": [
+        "currency",
+        "rate"
+      ],
+      "properties": {
+        "currency": {
+          "description": "ISO 4217 code of the currency",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$"
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "priceEnding": {
+          "description": "Minor units the converted prices end with, e. g., 99 for x.99; less than the rounding increment",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "rate": {
+          "description": "Units of the currency a unit of the base currency is worth",
+          "type": "number",
+          "minimum": 0,
+          "exclusiveMinimum": true
+        },
+        "roundingIncrement": {
+          "description": "Minor units the converted prices are rounded to, e. g., 5 for 0.05 or 100 for whole units",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "roundingMode": {
+          "type": "string",
+          "enum": [
+            "nearest",
+            "up",
+            "down"
+          ]
+        }
+      }
+    },
+    "guest_order": {
+      "type": "object",
+      "required": [
+        "email",
+        "shippingAddress"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "couponCode": {
+          "type": "string"
+        },
+        "deliveryInfo": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string",
+          "maxLength": 254,
+          "minLength": 3
+        },
+        "products": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/orderedProduct"
+          }
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "shippingMethodId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "guest_order_access": {
+      "type": "object",
+      "properties": {
+        "accessToken": {
+          "description": "Signed order access token; send it in the X-Order-Token header",
+          "type": "string"
+        },
+        "order": {
+          "$ref": "#/definitions/order"
+        }
+      }
+    },
+    "invoice": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "dateIssued": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "invoice",
+            "credit_note"
+          ],
+          "readOnly": true
+        },
+        "number": {
+          "description": "Gapless sequential number within the document kind and the year of issue",
+          "type": "string",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "refundId": {
+          "description": "Refund the credit note is issued for, if any",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "returnId": {
+          "description": "Return the credit note is issued for, if any",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "taxTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        }
+      }
+    },
+    "money": {
+      "description": "Amount of money in the minor units of its currency (e. g., cents), so it is exact",
+      "type": "object",
+      "required": [
+        "amount",
+        "currency"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor units of the currency",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "description": "ISO 4217 currency code",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$"
+        }
+      }
+    },
+    "offline_checkout": {
+      "type": "object",
+      "required": [
+        "method"
+      ],
+      "properties": {
+        "id": {
+          "description": "Order to pay; given by the path for the guest orders",
+          "type": "integer",
+          "format": "int64"
+        },
+        "method": {
+          "type": "string",
+          "enum": [
+            "bank_transfer",
+            "cash_on_delivery"
+          ]
+        }
+      }
+    },
+    "order": {
+      "type": "object",
+      "required": [
+        "products",
+        "totalPrice"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "billingAddressId": {
+          "description": "Address book entry to use as the billing address if no billing address is given",
+          "type": "integer",
+          "format": "int64"
+        },
+        "couponCode": {
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "deliveryCountry": {
+          "description": "ISO 3166-1 alpha-2 code of the delivery country taken from the shipping address",
+          "type": "string",
+          "readOnly": true
+        },
+        "deliveryInfo": {
+          "description": "Free-text delivery instructions",
+          "type": "string"
+        },
+        "deliveryRegion": {
+          "description": "Code of the delivery region (state, province) taken from the shipping address",
+          "type": "string",
+          "readOnly": true
+        },
+        "discountTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "discounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_discount"
+          },
+          "readOnly": true
+        },
+        "guestEmail": {
+          "description": "Email of the customer who placed the order without an account",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "pricesIncludeTax": {
+          "type": "boolean",
+          "readOnly": true
+        },
+        "products": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/orderedProduct"
+          }
+        },
+        "refundedTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "returns": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_return"
+          },
+          "readOnly": true
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "shippingAddressId": {
+          "description": "Address book entry to use as the shipping address if no shipping address is given",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shippingMethodId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "shippingMethodName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "shippingPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending_payment",
+            "paid",
+            "processing",
+            "shipped",
+            "delivered",
+            "cancelled",
+            "partially_refunded",
+            "refunded"
+          ],
+          "readOnly": true
+        },
+        "statusHistory": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_status_history_entry"
+          },
+          "readOnly": true
+        },
+        "taxTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "taxes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_tax_line"
+          },
+          "readOnly": true
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money"
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "order_discount": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money"
+        },
+        "code": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "productId": {
+          "description": "Discounted product; zero for the discounts of the whole order",
+          "type": "integer",
+          "format": "int64"
+        },
+        "promotionId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "order_message": {
+      "type": "object",
+      "required": [
+        "body"
+      ],
+      "properties": {
+        "attachments": {
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "$ref": "#/definitions/order_message_attachment"
+          }
+        },
+        "authorId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "authorRole": {
+          "type": "string",
+          "enum": [
+            "customer",
+            "admin"
+          ],
+          "readOnly": true
+        },
+        "body": {
+          "type": "string",
+          "maxLength": 10000,
+          "minLength": 1
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateRead": {
+          "description": "When the message has been read by its recipient side",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "internal": {
+          "description": "Internal notes are visible to admins only",
+          "type": "boolean"
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "read": {
+          "description": "Whether the message has been read by the side of the current user",
+          "type": "boolean",
+          "readOnly": true
+        }
+      }
+    },
+    "order_message_attachment": {
+      "type": "object",
+      "required": [
+        "fileName"
+      ],
+      "properties": {
+        "content": {
+          "description": "Base64 encoded content; given on posting only",
+          "type": "string"
+        },
+        "contentType": {
+          "type": "string"
+        },
+        "fileName": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "order_return": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "comment": {
+          "description": "Customer comment",
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/return_item"
+          }
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "refundAmount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "refundId": {
+          "description": "Payment provider refund ID; empty for refunds settled outside the payment provider",
+          "type": "string",
+          "readOnly": true
+        },
+        "resolutionComment": {
+          "description": "Admin comment on the decision or inspection",
+          "type": "string",
+          "readOnly": true
+        },
+        "rmaNumber": {
+          "description": "Return merchandise authorization number issued on approval",
+          "type": "string",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "requested",
+            "approved",
+            "rejected",
+            "received",
+            "refund_pending",
+            "refunded"
+          ],
+          "readOnly": true
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "order_status_change": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending_payment",
+            "paid",
+            "processing",
+            "shipped",
+            "delivered",
+            "cancelled",
+            "partially_refunded",
+            "refunded"
+          ]
+        }
+      }
+    },
+    "order_status_history_entry": {
+      "type": "object",
+      "properties": {
+        "actorId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "actorRole": {
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "fromStatus": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "reason": {
+          "type": "string"
+        },
+        "toStatus": {
+          "type": "string"
+        }
+      }
+    },
+    "order_tax_line": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money"
+        },
+        "country": {
+          "type": "string"
+        },
+        "inclusive": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rate": {
+          "type": "number"
+        },
+        "region": {
+          "type": "string"
+        },
+        "taxClass": {
+          "type": "string"
+        },
+        "taxableAmount": {
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "orderedProduct": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity",
+        "totalPrice"
+      ],
+      "properties": {
+        "inStock": {
+          "type": "boolean",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "productName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "taxAmount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "payment": {
+      "type": "object",
+      "required": [
+        "orderId",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dueAt": {
+          "description": "Deadline of the bank transfer; the payment and the unpaid order are cancelled afterwards",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "failureCode": {
+          "description": "Reason of the last failed or canceled payment attempt as reported by the payment gateway (e. g., a card decline code)",
+          "type": "string",
+          "readOnly": true
+        },
+        "failureMessage": {
+          "description": "Message of the last failed payment attempt as reported by the payment gateway",
+          "type": "string",
+          "readOnly": true
+        },
+        "gateway": {
+          "description": "Payment gateway of the online payment",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "instructions": {
+          "description": "How the customer pays the offline payment",
+          "type": "string",
+          "readOnly": true
+        },
+        "method": {
+          "description": "How the payment is made; the payments without a method have been made online",
+          "type": "string",
+          "enum": [
+            "online",
+            "bank_transfer",
+            "cash_on_delivery",
+            "cash"
+          ]
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reference": {
+          "description": "Reference the customer gives with the bank transfer",
+          "type": "string",
+          "readOnly": true
+        },
+        "refundedAmount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "status": {
+          "description": "intended, requires_action, processing, authorized, failed, complete, canceled, partially_refunded or refunded; set from the payment gateway events",
+          "type": "string"
+        },
+        "userId": {
+          "description": "Customer of the order",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "payment_change": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
+    "payment_discrepancy": {
+      "description": "Difference between a payment and its gateway",
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "Amount of the payment",
+          "$ref": "#/definitions/money"
+        },
+        "checkoutSessionId": {
+          "type": "string"
+        },
+        "gateway": {
+          "type": "string"
+        },
+        "gatewayAmount": {
+          "description": "Amount of the checkout session",
+          "$ref": "#/definitions/money"
+        },
+        "gatewayStatus": {
+          "description": "Payment status reported by the gateway",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "paymentId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "paymentIntentId": {
+          "type": "string"
+        },
+        "resolution": {
+          "description": "The differing statuses are fixed if the gateway one is newer and the differing amounts are reported; error is set if the gateway could not be reached",
+          "type": "string",
+          "enum": [
+            "fixed",
+            "expired",
+            "reported",
+            "error"
+          ]
+        },
+        "status": {
+          "description": "Status of the payment before the reconciliation",
+          "type": "string"
+        }
+      }
+    },
+    "payment_history_entry": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "deleted"
+          ]
+        },
+        "actorId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/payment_change"
+          }
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "paymentId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "payment_reconciliation": {
+      "description": "Report of a reconciliation of the payments with their gateways",
+      "type": "object",
+      "properties": {
+        "actorId": {
+          "description": "Admin who has run the manual reconciliation",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "checked": {
+          "description": "Payments checked against their gateways",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateFinished": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "discrepancies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/payment_discrepancy"
+          },
+          "readOnly": true
+        },
+        "errors": {
+          "description": "Payments which could not be checked",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "expired": {
+          "description": "Stale checkout sessions expired",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "fixed": {
+          "description": "Payments whose status has been set to the one of the gateway",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "trigger": {
+          "type": "string",
+          "enum": [
+            "scheduled",
+            "manual"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "principal": {
+      "type": "object",
+      "$ref": "#/definitions/user_info"
+    },
+    "product": {
+      "type": "object",
+      "required": [
+        "title",
+        "description"
+      ],
+      "properties": {
+        "categoryIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "height": {
+          "description": "Height in centimeters",
+          "type": "number"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "images": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "length": {
+          "description": "Length in centimeters",
+          "type": "number"
+        },
+        "numberInStock": {
+          "type": "integer"
+        },
+        "price": {
+          "$ref": "#/definitions/money"
+        },
+        "prices": {
+          "description": "Prices set by hand in the other store currencies; the missing ones are converted from the base price",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/money"
+          }
+        },
+        "sellingPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "taxClass": {
+          "description": "Tax class of the product; empty for the standard class",
+          "type": "string"
+        },
+        "title": {
+          "type": "string",
+          "minLength": 1
+        },
+        "weight": {
+          "description": "Weight in kilograms",
+          "type": "number"
+        },
+        "width": {
+          "description": "Width in centimeters",
+          "type": "number"
+        }
+      }
+    },
+    "promotion": {
+      "type": "object",
+      "required": [
+        "title",
+        "kind"
+      ],
+      "properties": {
+        "active": {
+          "description": "Only active promotions are applied to orders",
+          "type": "boolean"
+        },
+        "amountOff": {
+          "description": "Amount off for fixed promotions",
+          "$ref": "#/definitions/money"
+        },
+        "buyQuantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "categoryIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "code": {
+          "description": "Coupon code; promotions without a code are applied automatically",
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "getQuantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "percentage",
+            "fixed",
+            "buy_x_get_y",
+            "free_shipping"
+          ]
+        },
+        "minOrderValue": {
+          "description": "Order subtotal the promotion requires",
+          "$ref": "#/definitions/money"
+        },
+        "productIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "title": {
+          "type": "string",
+          "minLength": 1
+        },
+        "usageCount": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "usageLimit": {
+          "description": "Maximum number of orders the promotion can be applied to; zero for unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "usageLimitPerCustomer": {
+          "description": "Maximum number of orders of one customer the promotion can be applied to; zero for unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "validFrom": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "validTo": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "Percentage off for percentage promotions",
+          "type": "number"
+        }
+      }
+    },
+    "refund": {
+      "type": "object",
+      "required": [
+        "reason"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount to refund; defaults to the sum of the item amounts or, without items, to the rest of the payment",
+          "$ref": "#/definitions/money"
+        },
+        "createdBy": {
+          "description": "Admin who issued the refund; zero for the refunds made through the payment gateway dashboard",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "gatewayRefundId": {
+          "description": "Payment gateway refund ID",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/refund_item"
+          }
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "paymentId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "reason": {
+          "type": "string",
+          "minLength": 1
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "succeeded",
+            "failed",
+            "canceled"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "refund_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount to refund for the items; defaults to their paid price",
+          "$ref": "#/definitions/money"
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "return_decision": {
+      "type": "object",
+      "required": [
+        "approved"
+      ],
+      "properties": {
+        "approved": {
+          "type": "boolean"
+        },
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
+    "return_inspection": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/return_inspection_item"
+          }
+        },
+        "refundAmount": {
+          "description": "Amount to refund instead of the paid price of the received items",
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "return_inspection_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "receivedQuantity"
+      ],
+      "properties": {
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "receivedQuantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "sellable": {
+          "description": "Sellable items are put back in stock",
+          "type": "boolean"
+        }
+      }
+    },
+    "return_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity",
+        "reason"
+      ],
+      "properties": {
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "reason": {
+          "type": "string",
+          "minLength": 1
+        },
+        "receivedQuantity": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "restockedQuantity": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "shipment": {
+      "type": "object",
+      "required": [
+        "carrier",
+        "items"
+      ],
+      "properties": {
+        "carrier": {
+          "description": "Carrier name; carriers without integration require a tracking number",
+          "type": "string",
+          "minLength": 1
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateDelivered": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateShipped": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/shipment_item"
+          }
+        },
+        "labelUrl": {
+          "type": "string",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "in_transit",
+            "out_for_delivery",
+            "delivered",
+            "exception"
+          ],
+          "readOnly": true
+        },
+        "statusDetails": {
+          "type": "string",
+          "readOnly": true
+        },
+        "trackingNumber": {
+          "description": "Created by the carrier together with the label if empty",
+          "type": "string"
+        },
+        "trackingUrl": {
+          "type": "string"
+        }
+      }
+    },
+    "shipment_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity"
+      ],
+      "properties": {
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "shipment_status_change": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "details": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "in_transit",
+            "out_for_delivery",
+            "delivered",
+            "exception"
+          ]
+        }
+      }
+    },
+    "shipping_method": {
+      "type": "object",
+      "required": [
+        "zoneId",
+        "name",
+        "kind"
+      ],
+      "properties": {
+        "active": {
+          "description": "Only active methods are offered",
+          "type": "boolean"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "freeOverAmount": {
+          "description": "Order subtotal starting from which free-over methods are free",
+          "$ref": "#/definitions/money"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "flat",
+            "weight",
+            "price_tiers",
+            "free_over"
+          ]
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "price": {
+          "description": "Flat price; base price of weight-based methods; price below the threshold of free-over methods",
+          "$ref": "#/definitions/money"
+        },
+        "ratePerKg": {
+          "description": "Price per kilogram of weight-based methods without tiers",
+          "$ref": "#/definitions/money"
+        },
+        "tiers": {
+          "description": "Prices starting from the weight (weight-based methods) or the order subtotal (price-tiered methods)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/shipping_rate_tier"
+          }
+        },
+        "zoneId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "shipping_rate": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string"
+        },
+        "methodId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "methodName": {
+          "type": "string"
+        },
+        "price": {
+          "$ref": "#/definitions/money"
+        },
+        "zoneName": {
+          "type": "string"
+        }
+      }
+    },
+    "shipping_rate_request": {
+      "type": "object",
+      "required": [
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "$ref": "#/definitions/address"
+        },
+        "items": {
+          "description": "Items to quote; the cart items are quoted if empty",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cart_item"
+          }
+        }
+      }
+    },
+    "shipping_rate_tier": {
+      "type": "object",
+      "required": [
+        "price"
+      ],
+      "properties": {
+        "minSubtotal": {
+          "description": "Order subtotal the tier of a price-tiered method starts from",
+          "$ref": "#/definitions/money"
+        },
+        "minWeight": {
+          "description": "Weight in kilograms the tier of a weight-based method starts from",
+          "type": "number",
+          "minimum": 0
+        },
+        "price": {
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "shipping_zone": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "countries": {
+          "description": "ISO 3166-1 alpha-2 country codes, optionally with a region (e.g., \"US-CA\"); empty for the rest of the world",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "tax_rate": {
+      "type": "object",
+      "required": [
+        "name",
+        "rate"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rate": {
+          "description": "Rate in percent",
+          "type": "number",
+          "minimum": 0
+        },
+        "taxClass": {
+          "description": "Tax class of the products the rate applies to; empty for the standard class",
+          "type": "string"
+        }
+      }
+    },
+    "tax_report_line": {
+      "type": "object",
+      "properties": {
+        "country": {
+          "type": "string"
+        },
+        "inclusive": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "ordersCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rate": {
+          "type": "number"
+        },
+        "region": {
+          "type": "string"
+        },
+        "taxAmount": {
+          "$ref": "#/definitions/money"
+        },
+        "taxClass": {
+          "type": "string"
+        },
+        "taxableAmount": {
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "tax_zone": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "country": {
+          "description": "ISO 3166-1 alpha-2 country code; empty for the default zone",
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tax_rate"
+          }
+        },
+        "region": {
+          "description": "Region code within the country; empty for the whole country",
+          "type": "string"
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "required": [
+        "email"
+      ],
+      "properties": {
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "email": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "user_info": {
+      "type": "object",
+      "properties": {
+        "ACLRole": {
+          "type": "string"
+        },
+        "accessToken": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string"
+        },
+        "emailVerified": {
+          "type": "boolean"
+        },
+        "familyName": {
+          "type": "string"
+        },
+        "givenName": {
+          "type": "string"
+        },
+        "locale": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "picture": {
+          "type": "string"
+        },
+        "sub": {
+          "type": "string"
+        },
+        "user": {
+          "type": "object",
+          "$ref": "#/definitions/user"
+        }
+      }
+    },
+    "webhook_event": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateProcessed": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "eventId": {
+          "description": "Payment gateway event ID the repeated deliveries are recognized by",
+          "type": "string",
+          "readOnly": true
+        },
+        "gateway": {
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "lastError": {
+          "type": "string",
+          "readOnly": true
+        },
+        "nextAttemptAt": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "payload": {
+          "description": "Received payload; returned by ID only",
+          "type": "string",
+          "readOnly": true
+        },
+        "status": {
+          "description": "Failed events are retried with backoff; dead ones have run out of attempts",
+          "type": "string",
+          "enum": [
+            "pending",
+            "processing",
+            "processed",
+            "failed",
+            "dead"
+          ],
+          "readOnly": true
+        },
+        "type": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "OauthSecurity": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "https://accounts.google.com/o/oauth2/v2/auth",
+      "tokenUrl": "https://oauth2.googleapis.com/token",
+      "scopes": {
+        "admin": "Admin scope",
+        "private": "Private scope",
+        "user": "User scope"
+      }
+    }
+  },
+  "security": [
+    {
+      "OauthSecurity": [
+        "user"
+      ]
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https",
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Capstone project: back-end",
+    "title": "E-Store Back-end",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/auth/cb": {
+      "get": {
+        "security": [],
+        "tags": [
+          "auth"
+        ],
+        "summary": "Obtain access token",
+        "operationId": "getAccessToken",
+        "responses": {
+          "200": {
+            "description": "Login",
+            "schema": {
+              "$ref": "#/definitions/principal"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/cart": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Get the cart of the current user or the anonymous cart identified by the cart token",
+        "operationId": "getCart",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/cart"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Replace the cart items",
+        "operationId": "updateCart",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cart"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/cart"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Remove all the cart items",
+        "operationId": "clearCart",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Cleared"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/cart/checkout": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "cart"
+        ],
+        "summary": "Convert the cart into an order",
+        "operationId": "checkoutCart",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cart_checkout"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/categories": {
+      "get": {
+        "security": [],
+        "tags": [
+          "categories"
+        ],
+        "summary": "List categories",
+        "operationId": "listCategories",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "search",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get category list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/category"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "categories"
+        ],
+        "summary": "Add category",
+        "operationId": "addCategory",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/category"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/category"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/categories/{id}": {
+      "get": {
+        "security": [],
+        "tags": [
+          "category"
+        ],
+        "summary": "Get category by ID",
+        "operationId": "getCategory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/category"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "category"
+        ],
+        "summary": "Replace category by ID",
+        "operationId": "editCategory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/category"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/category"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "category"
+        ],
+        "summary": "Delete category by ID",
+        "operationId": "deleteCategory",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "category"
+        ],
+        "summary": "Patch category by ID",
+        "operationId": "patchCategory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/category"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/checkout/offline": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "The bank transfer gets a payment reference, the instructions and a deadline; the order stays pending until the transfer is received. The orders paid cash on delivery are fulfilled right away and paid when delivered. The open checkout sessions of the order are expired.",
+        "tags": [
+          "checkout"
+        ],
+        "summary": "Pay the order by bank transfer or cash on delivery",
+        "operationId": "addOfflinePayment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/offline_checkout"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/checkout/session": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "checkout"
+        ],
+        "summary": "Get checkout session",
+        "operationId": "getCheckoutSession",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "session_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get checkout session",
+            "schema": {
+              "$ref": "#/definitions/checkout_session"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "checkout"
+        ],
+        "summary": "Add checkout session",
+        "operationId": "addCheckoutSession",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/checkout_order"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/checkout_session_secret"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/currencies": {
+      "get": {
+        "security": [],
+        "tags": [
+          "currencies"
+        ],
+        "summary": "List the currencies the customers may choose",
+        "operationId": "listCurrencies",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/currency_list"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/currencies/rates": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "currencies"
+        ],
+        "summary": "List the exchange rates of the base currency",
+        "operationId": "listExchangeRates",
+        "responses": {
+          "200": {
+            "description": "Get exchange rate list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/exchange_rate"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/currencies/rates/{currency}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "currencies"
+        ],
+        "summary": "Get the exchange rate of the base currency to the currency",
+        "operationId": "getExchangeRate",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/exchange_rate"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "currencies"
+        ],
+        "summary": "Set the exchange rate of the base currency to the currency with its rounding rules",
+        "operationId": "setExchangeRate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/exchange_rate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/exchange_rate"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "currencies"
+        ],
+        "summary": "Delete the exchange rate of the base currency to the currency",
+        "operationId": "deleteExchangeRate",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "pattern": "^[A-Z]{3}$",
+          "type": "string",
+          "name": "currency",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/guest/orders": {
+      "post": {
+        "security": [],
+        "tags": [
+          "guest"
+        ],
+        "summary": "Place an order without an account; the products are taken from the anonymous cart if not given",
+        "operationId": "addGuestOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/guest_order"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/guest_order_access"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/guest/orders/{id}": {
+      "get": {
+        "security": [],
+        "tags": [
+          "guest"
+        ],
+        "summary": "Get the guest order authorized by its access token",
+        "operationId": "getGuestOrder",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
+        }
+      ]
+    },
+    "/guest/orders/{id}/checkout/offline": {
+      "post": {
+        "security": [],
+        "tags": [
+          "guest"
+        ],
+        "summary": "Pay the guest order authorized by its access token by bank transfer or cash on delivery",
+        "operationId": "addGuestOfflinePayment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/offline_checkout"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
+        }
+      ]
+    },
+    "/guest/orders/{id}/checkout/session": {
+      "post": {
+        "security": [],
+        "tags": [
+          "guest"
+        ],
+        "summary": "Add checkout session of the guest order authorized by its access token",
+        "operationId": "addGuestCheckoutSession",
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/checkout_session_secret"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
+        }
+      ]
+    },
+    "/guest/orders/{id}/claim": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "guest"
+        ],
+        "summary": "Move the guest order to the account of the current user having the verified order email",
+        "operationId": "claimGuestOrder",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "X-Order-Token",
+          "in": "header",
+          "required": true
+        }
+      ]
+    },
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "auth"
+        ],
+        "summary": "Login through oauth2 server",
+        "operationId": "login",
+        "responses": {
+          "200": {
+            "description": "Login",
+            "schema": {
+              "$ref": "#/definitions/principal"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orders": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "orders"
+        ],
+        "summary": "List orders",
+        "operationId": "listOrders",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "id",
+              "date_created",
+              "date_updated"
+            ],
+            "type": "string",
+            "name": "orderBy",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "pending_payment",
+                "paid",
+                "processing",
+                "shipped",
+                "delivered",
+                "cancelled",
+                "partially_refunded",
+                "refunded"
+              ],
+              "type": "string"
+            },
+            "description": "Order statuses to match",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or after this Unix time",
+            "name": "dateFrom",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or before this Unix time",
+            "name": "dateTo",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Part of the customer email or name",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders containing the product",
+            "name": "productId",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "minTotal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "maxTotal",
+            "in": "query"
+          },
+          {
+            "pattern": "^[A-Z]{3}$",
+            "type": "string",
+            "description": "Orders priced in this ISO 4217 currency",
+            "name": "currency",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Orders having a payment in this status",
+            "name": "paymentStatus",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Text to search in the delivery info",
+            "name": "search",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get order list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "orders"
+        ],
+        "summary": "Add order",
+        "operationId": "addOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/export": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "text/csv",
+          "application/json"
+        ],
+        "tags": [
+          "orders"
+        ],
+        "summary": "Export the orders matching the filter as CSV or JSON",
+        "operationId": "exportOrders",
+        "parameters": [
+          {
+            "enum": [
+              "csv",
+              "json"
+            ],
+            "type": "string",
+            "default": "csv",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "pending_payment",
+                "paid",
+                "processing",
+                "shipped",
+                "delivered",
+                "cancelled",
+                "partially_refunded",
+                "refunded"
+              ],
+              "type": "string"
+            },
+            "description": "Order statuses to match",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or after this Unix time",
+            "name": "dateFrom",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders created at or before this Unix time",
+            "name": "dateTo",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Part of the customer email or name",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Orders containing the product",
+            "name": "productId",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "minTotal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Order total in the minor units of its currency",
+            "name": "maxTotal",
+            "in": "query"
+          },
+          {
+            "pattern": "^[A-Z]{3}$",
+            "type": "string",
+            "description": "Orders priced in this ISO 4217 currency",
+            "name": "currency",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Orders having a payment in this status",
+            "name": "paymentStatus",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Text to search in the delivery info",
+            "name": "search",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Exported orders",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order by ID",
+        "operationId": "getOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Replace order by ID",
+        "operationId": "editOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete order by ID",
+        "operationId": "deleteOrder",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Patch order by ID",
+        "operationId": "patchOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/invoice": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "produces": [
+          "application/pdf",
+          "application/json"
+        ],
+        "tags": [
+          "invoice"
+        ],
+        "summary": "Download the invoice or a credit note of the order as PDF",
+        "operationId": "getInvoice",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Number of the credit note to download instead of the invoice",
+            "name": "number",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/invoices": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "invoices"
+        ],
+        "summary": "List the invoice and the credit notes issued for the order",
+        "operationId": "listInvoices",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/invoice"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/messages": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "messages"
+        ],
+        "summary": "List the message thread of the order; the internal notes are listed to admins only",
+        "operationId": "listOrderMessages",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_message"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "messages"
+        ],
+        "summary": "Post a message or an internal note (admins only) to the order thread",
+        "operationId": "postOrderMessage",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/order_message"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order_message"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/messages/read": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "messages"
+        ],
+        "summary": "Mark the messages of the other side of the order thread as read",
+        "operationId": "markOrderMessagesRead",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_message"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/messages/{messageId}/attachments/{attachmentId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
+        "tags": [
+          "message"
+        ],
+        "summary": "Download the attachment of the order message",
+        "operationId": "getOrderMessageAttachment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              },
+              "Content-Type": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "messageId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "attachmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "List returns of the order",
+        "operationId": "listReturns",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_return"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Request return of the order lines",
+        "operationId": "requestReturn",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns/{returnId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Get return of the order",
+        "operationId": "getReturn",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns/{returnId}/decision": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Approve the return issuing a return authorization or reject it",
+        "operationId": "decideReturn",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/return_decision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns/{returnId}/inspection": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Record the received items, restock the sellable ones and refund the return",
+        "operationId": "inspectReturn",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/return_inspection"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns/{returnId}/refund": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "Retry the refund of the inspected return pending its refund",
+        "operationId": "retryReturnRefund",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order_return"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "shipments"
+        ],
+        "summary": "List shipments of the order",
+        "operationId": "listShipments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipment"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipments"
+        ],
+        "summary": "Add shipment of the order items; the label is created by the carrier if no tracking number is given",
+        "operationId": "addShipment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipment"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/shipment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments/{shipmentId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "shipment"
+        ],
+        "summary": "Get shipment of the order",
+        "operationId": "getShipment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments/{shipmentId}/status": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipment"
+        ],
+        "summary": "Move shipment to another status manually (e. g., for carriers without integration)",
+        "operationId": "changeShipmentStatus",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipment_status_change"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments/{shipmentId}/tracking": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipment"
+        ],
+        "summary": "Poll the carrier for the shipment tracking status",
+        "operationId": "refreshShipmentTracking",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/status": {
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Move order to another status",
+        "operationId": "changeOrderStatus",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/order_status_change"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/order"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "Lists the payments of the orders of the customer; the admins see all the payments",
+        "tags": [
+          "payments"
+        ],
+        "summary": "List payments",
+        "operationId": "listPayments",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "orderId",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "intended",
+              "requires_action",
+              "processing",
+              "authorized",
+              "failed",
+              "complete",
+              "canceled",
+              "partially_refunded",
+              "refunded"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get payment list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/payment"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Records a payment made outside of the payment gateways; the payments made through them are recorded by the checkout. A complete payment pays its unpaid order.",
+        "tags": [
+          "payments"
+        ],
+        "summary": "Add payment",
+        "operationId": "addPayment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/payments/fake/sessions/{id}": {
+      "get": {
+        "security": [],
+        "description": "Available when the fake payment gateway for tests and development is enabled",
+        "produces": [
+          "text/html"
+        ],
+        "tags": [
+          "payments"
+        ],
+        "summary": "Hosted payment page of the fake payment gateway",
+        "operationId": "getFakePaymentPage",
+        "responses": {
+          "200": {
+            "description": "Payment page",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "description": "Simulates the customer paying, failing to pay or abandoning the payment; the outcome is sent to the payment webhook as a signed event",
+        "tags": [
+          "payments"
+        ],
+        "summary": "Complete the checkout session of the fake payment gateway",
+        "operationId": "completeFakePayment",
+        "parameters": [
+          {
+            "enum": [
+              "succeeded",
+              "failed",
+              "canceled"
+            ],
+            "type": "string",
+            "name": "outcome",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "303": {
+            "description": "Redirect to the return URL of the checkout session",
+            "headers": {
+              "Location": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/reconciliations": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "The reports list the discrepancies only",
+        "tags": [
+          "reconciliations"
+        ],
+        "summary": "List payment reconciliation reports",
+        "operationId": "listPaymentReconciliations",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/payment_reconciliation"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Checks the payments which are not final against their checkout sessions, fixes the statuses, expires the stale sessions and reports the differences; 409 is returned while a reconciliation is running",
+        "tags": [
+          "reconciliations"
+        ],
+        "summary": "Reconcile the payments with their gateways now",
+        "operationId": "reconcilePayments",
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/payment_reconciliation"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/payments/reconciliations/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "reconciliations"
+        ],
+        "summary": "Get payment reconciliation report",
+        "operationId": "getPaymentReconciliation",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment_reconciliation"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "payment"
+        ],
+        "summary": "Get payment by ID",
+        "operationId": "getPayment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "The order and the method of a payment cannot be changed; the amount and the status of the payments made through the payment gateways are set by the gateways.",
+        "tags": [
+          "payment"
+        ],
+        "summary": "Replace payment by ID",
+        "operationId": "editPayment",
+        "parameters": [
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Deletes the offline payment which has not been received",
+        "tags": [
+          "payment"
+        ],
+        "summary": "Delete payment by ID",
+        "operationId": "deletePaymet",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "payment"
+        ],
+        "summary": "Patch payment by ID",
+        "operationId": "patchPayment",
+        "parameters": [
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}/collect": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Completes the cash on delivery of an order delivered without the carrier tracking it; the tracked deliveries collect it by themselves",
+        "tags": [
+          "payment"
+        ],
+        "summary": "Mark the cash on delivery collected",
+        "operationId": "collectPayment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}/history": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "payment"
+        ],
+        "summary": "List the changes of the payment made by the admins",
+        "operationId": "listPaymentHistory",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/payment_history_entry"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}/receive": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Completes the bank transfer and pays its order",
+        "tags": [
+          "payment"
+        ],
+        "summary": "Mark the bank transfer received",
+        "operationId": "receivePayment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/payment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/payments/{id}/refunds": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "refunds"
+        ],
+        "summary": "List refunds of the payment",
+        "operationId": "listRefunds",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/refund"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Refunds the given amount or the paid price of the given order items; without both the rest of the payment is refunded. The order and the payment become partially refunded or refunded.\n",
+        "tags": [
+          "refunds"
+        ],
+        "summary": "Refund the payment in full or partially through its payment gateway",
+        "operationId": "createRefund",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/refund"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/refund"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/products": {
+      "get": {
+        "security": [],
+        "tags": [
+          "products"
+        ],
+        "summary": "List products",
+        "operationId": "getProducts",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "csv",
+            "name": "categoryIds",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get product list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/product"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "products"
+        ],
+        "summary": "Add product",
+        "operationId": "addProduct",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/product"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/product"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/products/{id}": {
+      "get": {
+        "security": [],
+        "tags": [
+          "product"
+        ],
+        "summary": "Get product by ID",
+        "operationId": "getProduct",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/product"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "product"
+        ],
+        "summary": "Replace product by ID",
+        "operationId": "editProduct",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/product"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/product"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "product"
+        ],
+        "summary": "Delete product by ID",
+        "operationId": "deleteProduct",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "product"
+        ],
+        "summary": "Patch product by ID",
+        "operationId": "patchProduct",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/product"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/promotions": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotions"
+        ],
+        "summary": "List promotions and coupons",
+        "operationId": "listPromotions",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get promotion list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/promotion"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotions"
+        ],
+        "summary": "Add promotion or coupon",
+        "operationId": "addPromotion",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/promotions/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotion"
+        ],
+        "summary": "Get promotion by ID",
+        "operationId": "getPromotion",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotion"
+        ],
+        "summary": "Edit promotion by ID",
+        "operationId": "editPromotion",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/promotion"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "promotion"
+        ],
+        "summary": "Delete promotion by ID",
+        "operationId": "deletePromotion",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/reports/taxes": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "reports"
+        ],
+        "summary": "Get the tax breakdown of the paid orders created within the period",
+        "operationId": "getTaxReport",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "dateFrom",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "dateTo",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Tax breakdown by country, region, tax class and rate",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tax_report_line"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/returns": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "returns"
+        ],
+        "summary": "List returns of all orders",
+        "operationId": "listAllReturns",
+        "parameters": [
+          {
+            "enum": [
+              "requested",
+              "approved",
+              "rejected",
+              "received",
+              "refund_pending",
+              "refunded"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/order_return"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/methods": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "List shipping methods",
+        "operationId": "listShippingMethods",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "zoneId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get shipping method list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipping_method"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Add shipping method",
+        "operationId": "addShippingMethod",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/methods/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Get shipping method by ID",
+        "operationId": "getShippingMethod",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Edit shipping method by ID",
+        "operationId": "editShippingMethod",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_method"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Delete shipping method by ID",
+        "operationId": "deleteShippingMethod",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/shipping/rates": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "user"
+            ]
+          },
+          {}
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Quote the shipping methods available for the address and the items or the cart",
+        "operationId": "getShippingRates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ISO 4217 code of the store currency to price in; the base currency by default",
+            "name": "X-Currency",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "X-Cart-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_rate_request"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shipping rates sorted by price",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipping_rate"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/zones": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "List shipping zones",
+        "operationId": "listShippingZones",
+        "responses": {
+          "200": {
+            "description": "Get shipping zone list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/shipping_zone"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Add shipping zone",
+        "operationId": "addShippingZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shipping/zones/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Get shipping zone by ID",
+        "operationId": "getShippingZone",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Edit shipping zone by ID",
+        "operationId": "editShippingZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/shipping_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "shipping"
+        ],
+        "summary": "Delete shipping zone by ID",
+        "operationId": "deleteShippingZone",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/tax/zones": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "taxes"
+        ],
+        "summary": "List tax zones with their rates",
+        "operationId": "listTaxZones",
+        "responses": {
+          "200": {
+            "description": "Get tax zone list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tax_zone"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "taxes"
+        ],
+        "summary": "Add tax zone with its rates",
+        "operationId": "addTaxZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tax/zones/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "tax"
+        ],
+        "summary": "Get tax zone by ID",
+        "operationId": "getTaxZone",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "tax"
+        ],
+        "summary": "Edit tax zone by ID; the rates of the zone are replaced",
+        "operationId": "editTaxZone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/tax_zone"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "tax"
+        ],
+        "summary": "Delete tax zone by ID",
+        "operationId": "deleteTaxZone",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Get own user information",
+        "operationId": "getOwnUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "List users",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "search",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get user list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Add user",
+        "operationId": "addUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Get user by ID",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags of the cached versions",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "304": {
+            "description": "Not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Replace user by ID",
+        "operationId": "editUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being replaced",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "description": "Full representation of the resource; every writable property must be given",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Delete user by ID",
+        "operationId": "deleteUser",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "description": "Updates the given properties only, following the JSON Merge Patch (RFC 7396) semantics",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json"
+        ],
+        "tags": [
+          "user"
+        ],
+        "summary": "Patch user by ID",
+        "operationId": "patchUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the version being patched",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "JSON merge patch; the null values clear the properties",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/user"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the resource"
+              }
+            }
+          },
+          "412": {
+            "description": "The resource has been modified since the given ETag",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{id}/addresses": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "addresses"
+        ],
+        "summary": "List the address book of the user",
+        "operationId": "listAddresses",
+        "responses": {
+          "200": {
+            "description": "Get address list",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/address"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "addresses"
+        ],
+        "summary": "Add address to the address book of the user",
+        "operationId": "addAddress",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{id}/addresses/{addressId}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "address"
+        ],
+        "summary": "Get address of the address book of the user",
+        "operationId": "getAddress",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "address"
+        ],
+        "summary": "Edit address of the address book of the user",
+        "operationId": "editAddress",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/address"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin",
+              "private"
+            ]
+          }
+        ],
+        "tags": [
+          "address"
+        ],
+        "summary": "Delete address from the address book of the user",
+        "operationId": "deleteAddress",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "addressId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/events": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "webhooks"
+        ],
+        "summary": "List received payment gateway webhook events",
+        "operationId": "listWebhookEvents",
+        "parameters": [
+          {
+            "enum": [
+              "pending",
+              "processing",
+              "processed",
+              "failed",
+              "dead"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "gateway",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 24,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook_event"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/events/{id}": {
+      "get": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "tags": [
+          "webhooks"
+        ],
+        "summary": "Get received webhook event with its payload",
+        "operationId": "getWebhookEvent",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/webhook_event"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/events/{id}/replay": {
+      "post": {
+        "security": [
+          {
+            "OauthSecurity": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Schedules the processed, failed or dead event to be processed again with the attempts reset",
+        "tags": [
+          "webhooks"
+        ],
+        "summary": "Process the webhook event again",
+        "operationId": "replayWebhookEvent",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/webhook_event"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/payments/{gateway}": {
+      "post": {
+        "security": [],
+        "description": "Receives the webhook events of the payment gateway, verified by the gateway signature. The events are stored and processed in background with retries; the repeated deliveries of an event are ignored.\n",
+        "tags": [
+          "webhooks",
+          "payments"
+        ],
+        "summary": "Process payment gateway event",
+        "operationId": "processPaymentEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "gateway",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Processed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/stripe/payments": {
+      "post": {
+        "security": [],
+        "tags": [
+          "webhooks",
+          "payments"
+        ],
+        "summary": "Process Stripe payment event",
+        "operationId": "processStripePayment",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "Stripe-Signature",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Processed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/tracking/{carrier}": {
+      "post": {
+        "security": [],
+        "tags": [
+          "webhooks",
+          "shipments"
+        ],
+        "summary": "Process carrier tracking event",
+        "operationId": "processTrackingEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "carrier",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "X-Tracking-Signature",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Processed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "address": {
+      "type": "object",
+      "required": [
+        "name",
+        "line1",
+        "city",
+        "country"
+      ],
+      "properties": {
+        "city": {
+          "type": "string",
+          "minLength": 1
+        },
+        "country": {
+          "description": "ISO 3166-1 alpha-2 country code",
+          "type": "string",
+          "maxLength": 2,
+          "minLength": 2
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "isDefaultBilling": {
+          "type": "boolean"
+        },
+        "isDefaultShipping": {
+          "type": "boolean"
+        },
+        "line1": {
+          "type": "string",
+          "minLength": 1
+        },
+        "line2": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "phone": {
+          "type": "string"
+        },
+        "postalCode": {
+          "type": "string"
+        },
+        "region": {
+          "description": "State, province or county code",
+          "type": "string"
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "cart": {
+      "type": "object",
+      "properties": {
+        "currency": {
+          "description": "ISO 4217 code of the store currency the cart is priced in; kept for the later requests",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$"
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cart_item"
+          }
+        },
+        "messages": {
+          "description": "Changes made to the cart items after re-validating prices and stock",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        },
+        "token": {
+          "description": "Signed token of an anonymous cart; send it in the X-Cart-Token header",
+          "type": "string",
+          "readOnly": true
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "cart_checkout": {
+      "type": "object",
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "billingAddressId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "couponCode": {
+          "type": "string"
+        },
+        "deliveryInfo": {
+          "description": "Free-text delivery instructions",
+          "type": "string"
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "shippingAddressId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "shippingMethodId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "cart_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity"
+      ],
+      "properties": {
+        "inStock": {
+          "type": "boolean",
+          "readOnly": true
+        },
+        "price": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "productName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        }
+      }
+    },
+    "category": {
+      "type": "object",
+      "required": [
+        "title"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "title": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "checkout_order": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "checkout_session": {
+      "type": "object",
+      "properties": {
+        "customer_email": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "checkout_session_secret": {
+      "type": "object",
+      "properties": {
+        "client_secret": {
+          "type": "string"
+        },
+        "gateway": {
+          "description": "Payment gateway of the checkout session",
+          "type": "string"
+        },
+        "session_id": {
+          "type": "string"
+        },
+        "url": {
+          "description": "Hosted payment page to redirect the customer to; empty for the embedded checkout",
+          "type": "string"
+        }
+      }
+    },
+    "currency_list": {
+      "type": "object",
+      "properties": {
+        "base": {
+          "description": "ISO 4217 code of the currency the catalog is priced in",
+          "type": "string"
+        },
+        "currencies": {
+          "description": "ISO 4217 codes of the currencies the customers may choose, the base one first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "httpcode": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "exchange_rate": {
+      "type": "object",
+      "required": [
+        "currency",
+        "rate"
+      ],
+      "properties": {
+        "currency": {
+          "description": "ISO 4217 code of the currency",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$"
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "priceEnding": {
+          "description": "Minor units the converted prices end with, e. g., 99 for x.99; less than the rounding increment",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "rate": {
+          "description": "Units of the currency a unit of the base currency is worth",
+          "type": "number",
+          "minimum": 0,
+          "exclusiveMinimum": true
+        },
+        "roundingIncrement": {
+          "description": "Minor units the converted prices are rounded to, e. g., 5 for 0.05 or 100 for whole units",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "roundingMode": {
+          "type": "string",
+          "enum": [
+            "nearest",
+            "up",
+            "down"
+          ]
+        }
+      }
+    },
+    "guest_order": {
+      "type": "object",
+      "required": [
+        "email",
+        "shippingAddress"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "couponCode": {
+          "type": "string"
+        },
+        "deliveryInfo": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string",
+          "maxLength": 254,
+          "minLength": 3
+        },
+        "products": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/orderedProduct"
+          }
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "shippingMethodId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "guest_order_access": {
+      "type": "object",
+      "properties": {
+        "accessToken": {
+          "description": "Signed order access token; send it in the X-Order-Token header",
+          "type": "string"
+        },
+        "order": {
+          "$ref": "#/definitions/order"
+        }
+      }
+    },
+    "invoice": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "dateIssued": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "invoice",
+            "credit_note"
+          ],
+          "readOnly": true
+        },
+        "number": {
+          "description": "Gapless sequential number within the document kind and the year of issue",
+          "type": "string",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "refundId": {
+          "description": "Refund the credit note is issued for, if any",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "returnId": {
+          "description": "Return the credit note is issued for, if any",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "taxTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        }
+      }
+    },
+    "money": {
+      "description": "Amount of money in the minor units of its currency (e. g., cents), so it is exact",
+      "type": "object",
+      "required": [
+        "amount",
+        "currency"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor units of the currency",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "description": "ISO 4217 currency code",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$"
+        }
+      }
+    },
+    "offline_checkout": {
+      "type": "object",
+      "required": [
+        "method"
+      ],
+      "properties": {
+        "id": {
+          "description": "Order to pay; given by the path for the guest orders",
+          "type": "integer",
+          "format": "int64"
+        },
+        "method": {
+          "type": "string",
+          "enum": [
+            "bank_transfer",
+            "cash_on_delivery"
+          ]
+        }
+      }
+    },
+    "order": {
+      "type": "object",
+      "required": [
+        "products",
+        "totalPrice"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "billingAddressId": {
+          "description": "Address book entry to use as the billing address if no billing address is given",
+          "type": "integer",
+          "format": "int64"
+        },
+        "couponCode": {
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "deliveryCountry": {
+          "description": "ISO 3166-1 alpha-2 code of the delivery country taken from the shipping address",
+          "type": "string",
+          "readOnly": true
+        },
+        "deliveryInfo": {
+          "description": "Free-text delivery instructions",
+          "type": "string"
+        },
+        "deliveryRegion": {
+          "description": "Code of the delivery region (state, province) taken from the shipping address",
+          "type": "string",
+          "readOnly": true
+        },
+        "discountTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "discounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_discount"
+          },
+          "readOnly": true
+        },
+        "guestEmail": {
+          "description": "Email of the customer who placed the order without an account",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "pricesIncludeTax": {
+          "type": "boolean",
+          "readOnly": true
+        },
+        "products": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/orderedProduct"
+          }
+        },
+        "refundedTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "returns": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_return"
+          },
+          "readOnly": true
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/address"
+        },
+        "shippingAddressId": {
+          "description": "Address book entry to use as the shipping address if no shipping address is given",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shippingMethodId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "shippingMethodName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "shippingPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending_payment",
+            "paid",
+            "processing",
+            "shipped",
+            "delivered",
+            "cancelled",
+            "partially_refunded",
+            "refunded"
+          ],
+          "readOnly": true
+        },
+        "statusHistory": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_status_history_entry"
+          },
+          "readOnly": true
+        },
+        "taxTotal": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "taxes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/order_tax_line"
+          },
+          "readOnly": true
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money"
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "order_discount": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money"
+        },
+        "code": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "productId": {
+          "description": "Discounted product; zero for the discounts of the whole order",
+          "type": "integer",
+          "format": "int64"
+        },
+        "promotionId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "order_message": {
+      "type": "object",
+      "required": [
+        "body"
+      ],
+      "properties": {
+        "attachments": {
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "$ref": "#/definitions/order_message_attachment"
+          }
+        },
+        "authorId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "authorRole": {
+          "type": "string",
+          "enum": [
+            "customer",
+            "admin"
+          ],
+          "readOnly": true
+        },
+        "body": {
+          "type": "string",
+          "maxLength": 10000,
+          "minLength": 1
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateRead": {
+          "description": "When the message has been read by its recipient side",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "internal": {
+          "description": "Internal notes are visible to admins only",
+          "type": "boolean"
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "read": {
+          "description": "Whether the message has been read by the side of the current user",
+          "type": "boolean",
+          "readOnly": true
+        }
+      }
+    },
+    "order_message_attachment": {
+      "type": "object",
+      "required": [
+        "fileName"
+      ],
+      "properties": {
+        "content": {
+          "description": "Base64 encoded content; given on posting only",
+          "type": "string"
+        },
+        "contentType": {
+          "type": "string"
+        },
+        "fileName": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "order_return": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "comment": {
+          "description": "Customer comment",
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/return_item"
+          }
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "refundAmount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "refundId": {
+          "description": "Payment provider refund ID; empty for refunds settled outside the payment provider",
+          "type": "string",
+          "readOnly": true
+        },
+        "resolutionComment": {
+          "description": "Admin comment on the decision or inspection",
+          "type": "string",
+          "readOnly": true
+        },
+        "rmaNumber": {
+          "description": "Return merchandise authorization number issued on approval",
+          "type": "string",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "requested",
+            "approved",
+            "rejected",
+            "received",
+            "refund_pending",
+            "refunded"
+          ],
+          "readOnly": true
+        },
+        "userId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "order_status_change": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending_payment",
+            "paid",
+            "processing",
+            "shipped",
+            "delivered",
+            "cancelled",
+            "partially_refunded",
+            "refunded"
+          ]
+        }
+      }
+    },
+    "order_status_history_entry": {
+      "type": "object",
+      "properties": {
+        "actorId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "actorRole": {
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "fromStatus": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "reason": {
+          "type": "string"
+        },
+        "toStatus": {
+          "type": "string"
+        }
+      }
+    },
+    "order_tax_line": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money"
+        },
+        "country": {
+          "type": "string"
+        },
+        "inclusive": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rate": {
+          "type": "number"
+        },
+        "region": {
+          "type": "string"
+        },
+        "taxClass": {
+          "type": "string"
+        },
+        "taxableAmount": {
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "orderedProduct": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity",
+        "totalPrice"
+      ],
+      "properties": {
+        "inStock": {
+          "type": "boolean",
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "productName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "taxAmount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "totalPrice": {
+          "$ref": "#/definitions/money"
+        }
+      }
+    },
+    "payment": {
+      "type": "object",
+      "required": [
+        "orderId",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "$ref": "#/definitions/money"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dueAt": {
+          "description": "Deadline of the bank transfer; the payment and the unpaid order are cancelled afterwards",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "failureCode": {
+          "description": "Reason of the last failed or canceled payment attempt as reported by the payment gateway (e. g., a card decline code)",
+          "type": "string",
+          "readOnly": true
+        },
+        "failureMessage": {
+          "description": "Message of the last failed payment attempt as reported by the payment gateway",
+          "type": "string",
+          "readOnly": true
+        },
+        "gateway": {
+          "description": "Payment gateway of the online payment",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "instructions": {
+          "description": "How the customer pays the offline payment",
+          "type": "string",
+          "readOnly": true
+        },
+        "method": {
+          "description": "How the payment is made; the payments without a method have been made online",
+          "type": "string",
+          "enum": [
+            "online",
+            "bank_transfer",
+            "cash_on_delivery",
+            "cash"
+          ]
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reference": {
+          "description": "Reference the customer gives with the bank transfer",
+          "type": "string",
+          "readOnly": true
+        },
+        "refundedAmount": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "status": {
+          "description": "intended, requires_action, processing, authorized, failed, complete, canceled, partially_refunded or refunded; set from the payment gateway events",
+          "type": "string"
+        },
+        "userId": {
+          "description": "Customer of the order",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "payment_change": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
+    "payment_discrepancy": {
+      "description": "Difference between a payment and its gateway",
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "Amount of the payment",
+          "$ref": "#/definitions/money"
+        },
+        "checkoutSessionId": {
+          "type": "string"
+        },
+        "gateway": {
+          "type": "string"
+        },
+        "gatewayAmount": {
+          "description": "Amount of the checkout session",
+          "$ref": "#/definitions/money"
+        },
+        "gatewayStatus": {
+          "description": "Payment status reported by the gateway",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "paymentId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "paymentIntentId": {
+          "type": "string"
+        },
+        "resolution": {
+          "description": "The differing statuses are fixed if the gateway one is newer and the differing amounts are reported; error is set if the gateway could not be reached",
+          "type": "string",
+          "enum": [
+            "fixed",
+            "expired",
+            "reported",
+            "error"
+          ]
+        },
+        "status": {
+          "description": "Status of the payment before the reconciliation",
+          "type": "string"
+        }
+      }
+    },
+    "payment_history_entry": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "deleted"
+          ]
+        },
+        "actorId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/payment_change"
+          }
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "paymentId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "payment_reconciliation": {
+      "description": "Report of a reconciliation of the payments with their gateways",
+      "type": "object",
+      "properties": {
+        "actorId": {
+          "description": "Admin who has run the manual reconciliation",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "checked": {
+          "description": "Payments checked against their gateways",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateFinished": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "discrepancies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/payment_discrepancy"
+          },
+          "readOnly": true
+        },
+        "errors": {
+          "description": "Payments which could not be checked",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "expired": {
+          "description": "Stale checkout sessions expired",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "fixed": {
+          "description": "Payments whose status has been set to the one of the gateway",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "trigger": {
+          "type": "string",
+          "enum": [
+            "scheduled",
+            "manual"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "principal": {
+      "type": "object",
+      "$ref": "#/definitions/user_info"
+    },
+    "product": {
+      "type": "object",
+      "required": [
+        "title",
+        "description"
+      ],
+      "properties": {
+        "categoryIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "height": {
+          "description": "Height in centimeters",
+          "type": "number"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "images": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "length": {
+          "description": "Length in centimeters",
+          "type": "number"
+        },
+        "numberInStock": {
+          "type": "integer"
+        },
+        "price": {
+          "$ref": "#/definitions/money"
+        },
+        "prices": {
+          "description": "Prices set by hand in the other store currencies; the missing ones are converted from the base price",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/money"
+          }
+        },
+        "sellingPrice": {
+          "$ref": "#/definitions/money",
+          "readOnly": true
+        },
+        "taxClass": {
+          "description": "Tax class of the product; empty for the standard class",
+          "type": "string"
+        },
+        "title": {
+          "type": "string",
+          "minLength": 1
+        },
+        "weight": {
+          "description": "Weight in kilograms",
+          "type": "number"
+        },
+        "width": {
+          "description": "Width in centimeters",
+          "type": "number"
+        }
+      }
+    },
+    "promotion": {
+      "type": "object",
+      "required": [
+        "title",
+        "kind"
+      ],
+      "properties": {
+        "active": {
+          "description": "Only active promotions are applied to orders",
+          "type": "boolean"
+        },
+        "amountOff": {
+          "description": "Amount off for fixed promotions",
+          "$ref": "#/definitions/money"
+        },
+        "buyQuantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "categoryIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "code": {
+          "description": "Coupon code; promotions without a code are applied automatically",
+          "type": "string"
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "getQuantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "percentage",
+            "fixed",
+            "buy_x_get_y",
+            "free_shipping"
+          ]
+        },
+        "minOrderValue": {
+          "description": "Order subtotal the promotion requires",
+          "$ref": "#/definitions/money"
+        },
+        "productIds": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "title": {
+          "type": "string",
+          "minLength": 1
+        },
+        "usageCount": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "usageLimit": {
+          "description": "Maximum number of orders the promotion can be applied to; zero for unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "usageLimitPerCustomer": {
+          "description": "Maximum number of orders of one customer the promotion can be applied to; zero for unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "validFrom": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "validTo": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "Percentage off for percentage promotions",
+          "type": "number"
+        }
+      }
+    },
+    "refund": {
+      "type": "object",
+      "required": [
+        "reason"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount to refund; defaults to the sum of the item amounts or, without items, to the rest of the payment",
+          "$ref": "#/definitions/money"
+        },
+        "createdBy": {
+          "description": "Admin who issued the refund; zero for the refunds made through the payment gateway dashboard",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "gatewayRefundId": {
+          "description": "Payment gateway refund ID",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/refund_item"
+          }
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "paymentId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "reason": {
+          "type": "string",
+          "minLength": 1
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "succeeded",
+            "failed",
+            "canceled"
+          ],
+          "readOnly": true
+        }
       }
     },
-    "/users/{id}": {
-      "get": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "user"
-        ],
-        "summary": "Get user by ID",
-        "operationId": "getUser",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "refund_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount to refund for the items; defaults to their paid price",
+          "$ref": "#/definitions/money"
+        },
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
-      },
-      "put": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "user"
-        ],
-        "summary": "Edit user by ID",
-        "operationId": "editUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "return_decision": {
+      "type": "object",
+      "required": [
+        "approved"
+      ],
+      "properties": {
+        "approved": {
+          "type": "boolean"
+        },
+        "comment": {
+          "type": "string"
         }
-      },
-      "delete": {
-        "security": [
-          {
-            "OauthSecurity": [
-              "admin",
-              "private"
-            ]
-          }
-        ],
-        "tags": [
-          "user"
-        ],
-        "summary": "Delete user by ID",
-        "operationId": "deleteUser",
-        "responses": {
-          "204": {
-            "description": "Deleted"
-          },
-          "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "return_inspection": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/return_inspection_item"
           }
+        },
+        "refundAmount": {
+          "description": "Amount to refund instead of the paid price of the received items",
+          "$ref": "#/definitions/money"
         }
-      },
-      "parameters": [
-        {
+      }
+    },
+    "return_inspection_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "receivedQuantity"
+      ],
+      "properties": {
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "receivedQuantity": {
           "type": "integer",
           "format": "int64",
-          "name": "id",
-          "in": "path",
-          "required": true
+          "minimum": 0
+        },
+        "sellable": {
+          "description": "Sellable items are put back in stock",
+          "type": "boolean"
         }
-      ]
+      }
     },
-    "/webhooks/stripe/payments": {
-      "post": {
-        "security": [],
-        "tags": [
-          "webhooks",
-          "payments"
-        ],
-        "summary": "Process Stripe payment event",
-        "operationId": "processStripePayment",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "Stripe-Signature",
-            "in": "header",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Processed"
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "return_item": {
+      "type": "object",
+      "required": [
+        "productId",
+        "quantity",
+        "reason"
+      ],
+      "properties": {
+        "productId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "reason": {
+          "type": "string",
+          "minLength": 1
+        },
+        "receivedQuantity": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "restockedQuantity": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
         }
       }
-    }
-  },
-  "definitions": {
-    "category": {
+    },
+    "shipment": {
       "type": "object",
       "required": [
-        "title"
+        "carrier",
+        "items"
       ],
       "properties": {
-        "description": {
+        "carrier": {
+          "description": "Carrier name; carriers without integration require a tracking number",
           "type": "string",
           "minLength": 1
         },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateDelivered": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateShipped": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
         "id": {
           "type": "integer",
           "format": "int64",
           "readOnly": true
         },
-        "title": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/shipment_item"
+          }
+        },
+        "labelUrl": {
           "type": "string",
-          "minLength": 1
+          "readOnly": true
+        },
+        "orderId": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "in_transit",
+            "out_for_delivery",
+            "delivered",
+            "exception"
+          ],
+          "readOnly": true
+        },
+        "statusDetails": {
+          "type": "string",
+          "readOnly": true
+        },
+        "trackingNumber": {
+          "description": "Created by the carrier together with the label if empty",
+          "type": "string"
+        },
+        "trackingUrl": {
+          "type": "string"
         }
       }
     },
-    "checkout_order": {
+    "shipment_item": {
       "type": "object",
       "required": [
-        "id"
+        "productId",
+        "quantity"
       ],
       "properties": {
-        "id": {
+        "productId": {
           "type": "integer",
           "format": "int64"
-        }
-      }
-    },
-    "checkout_session": {
-      "type": "object",
-      "properties": {
-        "customer_email": {
-          "type": "string"
         },
-        "status": {
-          "type": "string"
-        }
-      }
-    },
-    "checkout_session_secret": {
-      "type": "object",
-      "properties": {
-        "client_secret": {
-          "type": "string"
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
-    "error": {
+    "shipment_status_change": {
       "type": "object",
       "required": [
-        "message"
+        "status"
       ],
       "properties": {
-        "httpcode": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "message": {
+        "details": {
           "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "in_transit",
+            "out_for_delivery",
+            "delivered",
+            "exception"
+          ]
         }
       }
     },
-    "order": {
+    "shipping_method": {
       "type": "object",
       "required": [
-        "products",
-        "totalPrice",
-        "deliveryInfo"
+        "zoneId",
+        "name",
+        "kind"
       ],
       "properties": {
+        "active": {
+          "description": "Only active methods are offered",
+          "type": "boolean"
+        },
         "dateCreated": {
           "type": "integer",
           "format": "int64",
@@ -2752,77 +13821,123 @@ func init() {
         },
         "dateUpdated": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "readOnly": true
         },
-        "deliveryInfo": {
-          "type": "string",
-          "minLength": 10
+        "freeOverAmount": {
+          "description": "Order subtotal starting from which free-over methods are free",
+          "$ref": "#/definitions/money"
         },
         "id": {
           "type": "integer",
           "format": "int64",
           "readOnly": true
         },
-        "products": {
+        "kind": {
+          "type": "string",
+          "enum": [
+            "flat",
+            "weight",
+            "price_tiers",
+            "free_over"
+          ]
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "price": {
+          "description": "Flat price; base price of weight-based methods; price below the threshold of free-over methods",
+          "$ref": "#/definitions/money"
+        },
+        "ratePerKg": {
+          "description": "Price per kilogram of weight-based methods without tiers",
+          "$ref": "#/definitions/money"
+        },
+        "tiers": {
+          "description": "Prices starting from the weight (weight-based methods) or the order subtotal (price-tiered methods)",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/orderedProduct"
+            "$ref": "#/definitions/shipping_rate_tier"
           }
         },
-        "status": {
+        "zoneId": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "shipping_rate": {
+      "type": "object",
+      "properties": {
+        "kind": {
           "type": "string"
         },
-        "totalPrice": {
-          "type": "number"
-        },
-        "userId": {
+        "methodId": {
           "type": "integer",
           "format": "int64"
+        },
+        "methodName": {
+          "type": "string"
+        },
+        "price": {
+          "$ref": "#/definitions/money"
+        },
+        "zoneName": {
+          "type": "string"
         }
       }
     },
-    "orderedProduct": {
+    "shipping_rate_request": {
       "type": "object",
       "required": [
-        "productId",
-        "quantity",
-        "totalPrice"
+        "address"
       ],
       "properties": {
-        "inStock": {
-          "type": "boolean",
-          "readOnly": true
-        },
-        "orderId": {
-          "type": "integer",
-          "format": "int64",
-          "readOnly": true
-        },
-        "productId": {
-          "type": "integer",
-          "format": "int64"
+        "address": {
+          "$ref": "#/definitions/address"
         },
-        "productName": {
-          "type": "string",
-          "readOnly": true
+        "items": {
+          "description": "Items to quote; the cart items are quoted if empty",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cart_item"
+          }
+        }
+      }
+    },
+    "shipping_rate_tier": {
+      "type": "object",
+      "required": [
+        "price"
+      ],
+      "properties": {
+        "minSubtotal": {
+          "description": "Order subtotal the tier of a price-tiered method starts from",
+          "$ref": "#/definitions/money"
         },
-        "quantity": {
-          "type": "integer"
+        "minWeight": {
+          "description": "Weight in kilograms the tier of a weight-based method starts from",
+          "type": "number",
+          "minimum": 0
         },
-        "totalPrice": {
-          "type": "number"
+        "price": {
+          "$ref": "#/definitions/money"
         }
       }
     },
-    "payment": {
+    "shipping_zone": {
       "type": "object",
       "required": [
-        "orderId",
-        "amount"
+        "name"
       ],
       "properties": {
-        "amount": {
-          "type": "number"
+        "countries": {
+          "description": "ISO 3166-1 alpha-2 country codes, optionally with a region (e.g., \"US-CA\"); empty for the rest of the world",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
         "dateCreated": {
           "type": "integer",
@@ -2831,68 +13946,113 @@ func init() {
         },
         "dateUpdated": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "readOnly": true
         },
         "id": {
           "type": "integer",
           "format": "int64",
           "readOnly": true
         },
-        "orderId": {
-          "type": "integer",
-          "format": "int64"
+        "name": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "tax_rate": {
+      "type": "object",
+      "required": [
+        "name",
+        "rate"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
         },
-        "status": {
+        "rate": {
+          "description": "Rate in percent",
+          "type": "number",
+          "minimum": 0
+        },
+        "taxClass": {
+          "description": "Tax class of the products the rate applies to; empty for the standard class",
+          "type": "string"
+        }
+      }
+    },
+    "tax_report_line": {
+      "type": "object",
+      "properties": {
+        "country": {
           "type": "string"
         },
-        "userId": {
+        "inclusive": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "ordersCount": {
           "type": "integer",
           "format": "int64"
+        },
+        "rate": {
+          "type": "number"
+        },
+        "region": {
+          "type": "string"
+        },
+        "taxAmount": {
+          "$ref": "#/definitions/money"
+        },
+        "taxClass": {
+          "type": "string"
+        },
+        "taxableAmount": {
+          "$ref": "#/definitions/money"
         }
       }
     },
-    "principal": {
-      "type": "object",
-      "$ref": "#/definitions/user_info"
-    },
-    "product": {
+    "tax_zone": {
       "type": "object",
       "required": [
-        "title",
-        "description"
+        "name"
       ],
       "properties": {
-        "categoryIds": {
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
-          }
+        "country": {
+          "description": "ISO 3166-1 alpha-2 country code; empty for the default zone",
+          "type": "string"
         },
-        "description": {
-          "type": "string",
-          "minLength": 1
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
         },
         "id": {
           "type": "integer",
           "format": "int64",
           "readOnly": true
         },
-        "images": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rates": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/tax_rate"
           }
         },
-        "numberInStock": {
-          "type": "integer"
-        },
-        "price": {
-          "type": "number"
-        },
-        "title": {
-          "type": "string",
-          "minLength": 1
+        "region": {
+          "description": "Region code within the country; empty for the whole country",
+          "type": "string"
         }
       }
     },
@@ -2909,7 +14069,8 @@ func init() {
         },
         "dateUpdated": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "readOnly": true
         },
         "email": {
           "type": "string"
@@ -2962,6 +14123,75 @@ func init() {
           "$ref": "#/definitions/user"
         }
       }
+    },
+    "webhook_event": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateCreated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateProcessed": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "dateUpdated": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "eventId": {
+          "description": "Payment gateway event ID the repeated deliveries are recognized by",
+          "type": "string",
+          "readOnly": true
+        },
+        "gateway": {
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "lastError": {
+          "type": "string",
+          "readOnly": true
+        },
+        "nextAttemptAt": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "payload": {
+          "description": "Received payload; returned by ID only",
+          "type": "string",
+          "readOnly": true
+        },
+        "status": {
+          "description": "Failed events are retried with backoff; dead ones have run out of attempts",
+          "type": "string",
+          "enum": [
+            "pending",
+            "processing",
+            "processed",
+            "failed",
+            "dead"
+          ],
+          "readOnly": true
+        },
+        "type": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
     }
   },
   "securityDefinitions": {
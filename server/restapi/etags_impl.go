@@ -0,0 +1,87 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+)
+
+// entityETag formats the version of a resource as a strong entity tag
+func entityETag(version int64) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// etagMatches checks the comma-separated entity tags of an If-Match or If-None-Match header against the version;
+// "*" matches any version. The weak tags are compared weakly, so they never match for If-Match.
+func etagMatches(header string, version int64, weak bool) bool {
+	etag := entityETag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if weak {
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotModified checks the If-None-Match header of a GET request against the current version
+func isNotModified(ifNoneMatch *string, version int64) bool {
+	return ifNoneMatch != nil && etagMatches(*ifNoneMatch, version, true)
+}
+
+// checkIfMatch finds the current version of the row and checks it against the If-Match header;
+// the returned version is the one the update must be conditioned on
+func checkIfMatch(ctx context.Context, idb bun.IDB, table string, id int64, ifMatch string) (int64, errors.Error) {
+	var version sql.NullInt64
+	query := idb.NewSelect().TableExpr(table).Column("version").Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx, &version)
+	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return 0, errors.New(404, "Could not find record %d of %s!", id, table)
+		}
+		Logger.Error("ERROR %v: Could not find record %d of %s version!\n", sqlErr, id, table)
+		return 0, errors.New(500, "ERROR: Could not find record %d of %s!", id, table)
+	}
+	if !etagMatches(ifMatch, version.Int64, false) {
+		return 0, errors.New(412, "Record %d of %s has been modified; its current ETag is %s!", id, table,
+			entityETag(version.Int64))
+	}
+	return version.Int64, nil
+}
+
+// checkVersionedUpdate reports a concurrent change of the row the update has been conditioned on the version of
+func checkVersionedUpdate(res sql.Result, table string, id int64) errors.Error {
+	affected, sqlErr := res.RowsAffected()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find the updated record %d of %s!\n", sqlErr, id, table)
+		return errors.New(500, "ERROR: Could not update record %d of %s!", id, table)
+	}
+	if affected == 0 {
+		return errors.New(412, "Record %d of %s has been modified concurrently!", id, table)
+	}
+	return nil
+}
+
+// touchVersion increases the version of the row changed partially (e. g., a status transition),
+// so the cached representations and the pending updates of the row become stale
+func touchVersion(ctx context.Context, idb bun.IDB, table string, id int64) errors.Error {
+	query := idb.NewUpdate().TableExpr(table).
+		Set("version = COALESCE(version, 0) + 1").
+		Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update record %d of %s version!\n", sqlErr, id, table)
+		return errors.New(500, "ERROR: Could not update record %d of %s!", id, table)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/payments"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"html/template"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the outcomes of the fake hosted payment page
+const (
+	fakePaymentSucceeded = "succeeded"
+	fakePaymentFailed    = "failed"
+	fakePaymentCanceled  = "canceled"
+)
+
+// fakeGateway is a local payment gateway for tests and development: the checkout sessions are kept in memory
+// and paid on its hosted payment page, which delivers the outcomes as webhook events signed
+// with a hex HMAC-SHA256 of the payload in the Fake-Signature header
+type fakeGateway struct {
+	mutex      sync.Mutex
+	lastNumber int64
+	sessions   map[string]*fakeCheckoutSession
+	refunded   map[string]int64
+	// refunds maps the payment intent IDs and the references to the refunds issued
+	refunds       map[string]*GatewayRefund
+	webhookSecret string
+	// base URL of the hosted payment pages
+	baseURL string
+	// deliver sends the signed event to the payment webhook
+	deliver func(payload []byte, signature string) error
+}
+
+type fakeCheckoutSession struct {
+	GatewayCheckoutSession
+	Request  *CheckoutSessionRequest
+	Captured int64
+}
+
+func newFakeGateway(webhookSecret string, baseURL string, deliver func(payload []byte, signature string) error) *fakeGateway {
+	return &fakeGateway{
+		sessions:      make(map[string]*fakeCheckoutSession),
+		refunded:      make(map[string]int64),
+		refunds:       make(map[string]*GatewayRefund),
+		webhookSecret: webhookSecret,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		deliver:       deliver,
+	}
+}
+
+func (g *fakeGateway) Name() string {
+	return fakeGatewayName
+}
+
+func (g *fakeGateway) CreateCheckoutSession(ctx context.Context, request *CheckoutSessionRequest) (*GatewayCheckoutSession, error) {
+	if request.Amount <= 0 {
+		return nil, fmt.Errorf("order %d has nothing to pay", request.OrderID)
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.lastNumber++
+	id := fmt.Sprintf("fake_cs_%010d", g.lastNumber)
+	sess := &fakeCheckoutSession{
+		GatewayCheckoutSession: GatewayCheckoutSession{
+			ID:              id,
+			ClientSecret:    id + "_secret",
+			URL:             g.baseURL + "/payments/fake/sessions/" + id,
+			Status:          "open",
+			PaymentStatus:   "unpaid",
+			PaymentIntentID: fmt.Sprintf("fake_pi_%010d", g.lastNumber),
+			AmountTotal:     request.Amount,
+			Currency:        request.Currency,
+		},
+		Request: request,
+	}
+	g.sessions[id] = sess
+	result := sess.GatewayCheckoutSession
+	return &result, nil
+}
+
+func (g *fakeGateway) getSession(id string) (*fakeCheckoutSession, error) {
+	sess, ok := g.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown checkout session %s", id)
+	}
+	return sess, nil
+}
+
+func (g *fakeGateway) findIntentSession(paymentIntentID string) (*fakeCheckoutSession, error) {
+	for _, sess := range g.sessions {
+		if sess.PaymentIntentID == paymentIntentID {
+			return sess, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown payment intent %s", paymentIntentID)
+}
+
+func (g *fakeGateway) GetCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	sess, err := g.getSession(id)
+	if err != nil {
+		return nil, err
+	}
+	result := sess.GatewayCheckoutSession
+	return &result, nil
+}
+
+// ExpireCheckoutSession expires the open session and, like Stripe, delivers the event of it
+func (g *fakeGateway) ExpireCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error) {
+	g.mutex.Lock()
+	sess, err := g.getSession(id)
+	if err != nil {
+		g.mutex.Unlock()
+		return nil, err
+	}
+	if sess.Status != "open" {
+		g.mutex.Unlock()
+		return nil, fmt.Errorf("checkout session %s is %s", id, sess.Status)
+	}
+	sess.Status = "expired"
+	event := g.newEvent(sess, paymentEventCheckoutExpired)
+	result := sess.GatewayCheckoutSession
+	g.mutex.Unlock()
+
+	if err = g.send(event); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (g *fakeGateway) CapturePayment(ctx context.Context, paymentIntentID string, amount int64) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	sess, err := g.findIntentSession(paymentIntentID)
+	if err != nil {
+		return err
+	}
+	if sess.PaymentStatus != "paid" {
+		return fmt.Errorf("payment intent %s has not been paid", paymentIntentID)
+	}
+	if amount > sess.Request.Amount {
+		return fmt.Errorf("amount %d exceeds the authorized %d", amount, sess.Request.Amount)
+	}
+	sess.Captured = amount
+	return nil
+}
+
+func (g *fakeGateway) RefundPayment(ctx context.Context, paymentIntentID string, amount int64, reference string) (*GatewayRefund, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	sess, err := g.findIntentSession(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	if sess.PaymentStatus != "paid" {
+		return nil, fmt.Errorf("payment intent %s has not been paid", paymentIntentID)
+	}
+	key := paymentIntentID + "/" + reference
+	if r, ok := g.refunds[key]; ok && reference != "" {
+		return r, nil
+	}
+	if g.refunded[paymentIntentID]+amount > sess.Request.Amount {
+		return nil, fmt.Errorf("refunds of payment intent %s exceed its amount %d", paymentIntentID,
+			sess.Request.Amount)
+	}
+	g.refunded[paymentIntentID] += amount
+	g.lastNumber++
+	r := &GatewayRefund{ID: fmt.Sprintf("fake_re_%010d", g.lastNumber), Amount: amount, Status: "succeeded"}
+	g.refunds[key] = r
+	return r, nil
+}
+
+func (g *fakeGateway) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(g.webhookSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (g *fakeGateway) ParseWebhookEvent(payload []byte, header http.Header) (*PaymentEvent, error) {
+	signature := header.Get("Fake-Signature")
+	if g.webhookSecret == "" || !hmac.Equal([]byte(strings.ToLower(signature)), []byte(g.Sign(payload))) {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	event := new(PaymentEvent)
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	if event.ID == "" || event.Type == "" {
+		return nil, fmt.Errorf("no event ID or type")
+	}
+	return event, nil
+}
+
+// Complete applies the outcome chosen on the hosted payment page to the checkout session and delivers
+// the signed event of it; the URL the customer returns to is returned
+func (g *fakeGateway) Complete(id string, outcome string) (string, error) {
+	g.mutex.Lock()
+	sess, err := g.getSession(id)
+	if err != nil {
+		g.mutex.Unlock()
+		return "", err
+	}
+	if sess.Status != "open" {
+		g.mutex.Unlock()
+		return "", fmt.Errorf("checkout session %s is %s", id, sess.Status)
+	}
+	var eventType string
+	switch outcome {
+	case fakePaymentSucceeded:
+		sess.Status = "complete"
+		sess.PaymentStatus = "paid"
+		eventType = paymentEventCheckoutCompleted
+	case fakePaymentFailed:
+		// the customer may retry on the same page
+		sess.PaymentStatus = "unpaid"
+		eventType = paymentEventPaymentFailed
+	case fakePaymentCanceled:
+		sess.Status = "expired"
+		eventType = paymentEventCheckoutExpired
+	default:
+		g.mutex.Unlock()
+		return "", fmt.Errorf("unknown outcome %s", outcome)
+	}
+	event := g.newEvent(sess, eventType)
+	if outcome == fakePaymentFailed {
+		event.FailureCode = "card_declined"
+		event.FailureMessage = "The card has been declined by the fake payment gateway."
+	}
+	returnURL := strings.ReplaceAll(sess.Request.ReturnURL, "{CHECKOUT_SESSION_ID}", id)
+	if outcome == fakePaymentFailed {
+		returnURL = sess.URL
+	}
+	g.mutex.Unlock()
+
+	if err = g.send(event); err != nil {
+		return "", err
+	}
+	return returnURL, nil
+}
+
+// newEvent returns the event of the current state of the session; the caller holds the mutex
+func (g *fakeGateway) newEvent(sess *fakeCheckoutSession, eventType string) *PaymentEvent {
+	g.lastNumber++
+	return &PaymentEvent{
+		ID:                fmt.Sprintf("fake_evt_%010d", g.lastNumber),
+		Type:              eventType,
+		Created:           time.Now().Unix(),
+		CheckoutSessionID: sess.ID,
+		PaymentIntentID:   sess.PaymentIntentID,
+		OrderID:           sess.Request.OrderID,
+		SessionStatus:     sess.Status,
+		PaymentStatus:     sess.PaymentStatus,
+	}
+}
+
+// send delivers the signed event to the payment webhook
+func (g *fakeGateway) send(event *PaymentEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if g.deliver != nil {
+		return g.deliver(payload, g.Sign(payload))
+	}
+	return nil
+}
+
+var fakePaymentPageTemplate = template.Must(template.New("fakePaymentPage").Funcs(template.FuncMap{
+	"money": money.Format,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Fake payment of order {{.Request.OrderID}}</title></head>
+<body>
+<h1>Fake payment gateway</h1>
+<p>No real payment is made. Order {{.Request.OrderID}}, session {{.ID}} ({{.Status}}, {{.PaymentStatus}}).</p>
+<table>
+{{range .Request.LineItems}}<tr><td>{{.Name}}</td><td>{{.Quantity}} x {{money .UnitAmount $.Request.Currency}}</td></tr>
+{{end}}{{if gt .Request.DiscountAmount 0}}<tr><td>Discounts</td><td>-{{money .Request.DiscountAmount .Request.Currency}}</td></tr>
+{{end}}<tr><th>Total</th><th>{{money .Request.Amount .Request.Currency}} {{.Request.Currency}}</th></tr>
+</table>
+{{if eq .Status "open"}}
+<form method="post" action="{{.URL}}?outcome=succeeded"><button type="submit">Pay</button></form>
+<form method="post" action="{{.URL}}?outcome=failed"><button type="submit">Decline</button></form>
+<form method="post" action="{{.URL}}?outcome=canceled"><button type="submit">Cancel</button></form>
+{{end}}
+</body>
+</html>
+`))
+
+// Page renders the hosted payment page of the checkout session
+func (g *fakeGateway) Page(id string) (string, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	sess, err := g.getSession(id)
+	if err != nil {
+		return "", err
+	}
+	var page bytes.Buffer
+	if err = fakePaymentPageTemplate.Execute(&page, sess); err != nil {
+		return "", err
+	}
+	return page.String(), nil
+}
+
+// deliverFakePaymentEvent hands the signed events of the fake gateway to the payment webhook in process,
+// so the checkout flow runs without network
+func deliverFakePaymentEvent(payload []byte, signature string) error {
+	header := make(http.Header)
+	header.Set("Fake-Signature", signature)
+	err := processPaymentEvent(fakeGatewayName, payload, header)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// getFakeGateway returns the fake gateway if it is enabled
+func getFakeGateway() (*fakeGateway, bool) {
+	gateway, ok := getPaymentGateway(fakeGatewayName).(*fakeGateway)
+	return gateway, ok
+}
+
+func getFakePaymentPage(params *payments.GetFakePaymentPageParams) (string, errors.Error) {
+	gateway, ok := getFakeGateway()
+	if !ok {
+		return "", errors.New(404, "Fake payment gateway is not enabled!")
+	}
+	page, err := gateway.Page(params.ID)
+	if err != nil {
+		return "", errors.New(404, "Could not find checkout session %s: %s", params.ID, err.Error())
+	}
+	return page, nil
+}
+
+func completeFakePayment(params *payments.CompleteFakePaymentParams) (string, errors.Error) {
+	gateway, ok := getFakeGateway()
+	if !ok {
+		return "", errors.New(404, "Fake payment gateway is not enabled!")
+	}
+	location, err := gateway.Complete(params.ID, params.Outcome)
+	if err != nil {
+		return "", errors.New(409, "Could not complete checkout session %s: %s", params.ID, err.Error())
+	}
+	return location, nil
+}
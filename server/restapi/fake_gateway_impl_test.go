@@ -0,0 +1,212 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// newTestFakeGateway returns a fake gateway collecting the delivered events instead of processing them
+func newTestFakeGateway(t *testing.T) (*fakeGateway, *[]*PaymentEvent) {
+	var events []*PaymentEvent
+	var gateway *fakeGateway
+	gateway = newFakeGateway("secret", "http://localhost/", func(payload []byte, signature string) error {
+		header := make(http.Header)
+		header.Set("Fake-Signature", signature)
+		event, err := gateway.ParseWebhookEvent(payload, header)
+		if err != nil {
+			t.Errorf("ParseWebhookEvent() of a delivered event = %s", err)
+			return err
+		}
+		events = append(events, event)
+		return nil
+	})
+	return gateway, &events
+}
+
+func TestFakeGatewayComplete(t *testing.T) {
+	tests := []struct {
+		name              string
+		outcome           string
+		wantEvent         string
+		wantStatus        string
+		wantPaymentStatus string
+		wantFailureCode   string
+		wantReturnURL     string
+	}{
+		{"succeeded", fakePaymentSucceeded, paymentEventCheckoutCompleted, "complete", "paid", "",
+			"http://shop/orders/1?session=fake_cs_0000000001"},
+		{"failed", fakePaymentFailed, paymentEventPaymentFailed, "open", "unpaid", "card_declined",
+			"http://localhost/payments/fake/sessions/fake_cs_0000000001"},
+		{"canceled", fakePaymentCanceled, paymentEventCheckoutExpired, "expired", "unpaid", "",
+			"http://shop/orders/1?session=fake_cs_0000000001"},
+	}
+	for _, tt := range tests {
+		gateway, events := newTestFakeGateway(t)
+		sess, err := gateway.CreateCheckoutSession(context.Background(), &CheckoutSessionRequest{OrderID: 1,
+			Currency: "USD", Amount: 1000, ReturnURL: "http://shop/orders/1?session={CHECKOUT_SESSION_ID}"})
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		returnURL, err := gateway.Complete(sess.ID, tt.outcome)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		if returnURL != tt.wantReturnURL {
+			t.Errorf("%s: return URL = %s, want %s", tt.name, returnURL, tt.wantReturnURL)
+		}
+		if len(*events) != 1 {
+			t.Fatalf("%s: %d events delivered, want 1", tt.name, len(*events))
+		}
+		event := (*events)[0]
+		if event.Type != tt.wantEvent || event.OrderID != 1 || event.CheckoutSessionID != sess.ID ||
+			event.PaymentIntentID != sess.PaymentIntentID || event.FailureCode != tt.wantFailureCode {
+			t.Errorf("%s: event = %+v, want %s of session %s", tt.name, event, tt.wantEvent, sess.ID)
+		}
+		current, _ := gateway.GetCheckoutSession(context.Background(), sess.ID)
+		if current.Status != tt.wantStatus || current.PaymentStatus != tt.wantPaymentStatus {
+			t.Errorf("%s: session %s, %s, want %s, %s", tt.name, current.Status, current.PaymentStatus,
+				tt.wantStatus, tt.wantPaymentStatus)
+		}
+		_, err = gateway.Complete(sess.ID, fakePaymentSucceeded)
+		if (err == nil) != (tt.wantStatus == "open") {
+			t.Errorf("%s: Complete() of the %s session again = %v", tt.name, tt.wantStatus, err)
+		}
+	}
+
+	gateway, _ := newTestFakeGateway(t)
+	if _, err := gateway.CreateCheckoutSession(context.Background(), &CheckoutSessionRequest{OrderID: 1}); err == nil {
+		t.Errorf("CreateCheckoutSession() of nothing to pay succeeded")
+	}
+	if _, err := gateway.Complete("fake_cs_unknown", fakePaymentSucceeded); err == nil {
+		t.Errorf("Complete() of an unknown session succeeded")
+	}
+}
+
+func TestFakeGatewayParseWebhookEvent(t *testing.T) {
+	gateway, _ := newTestFakeGateway(t)
+	payload, _ := json.Marshal(&PaymentEvent{ID: "fake_evt_1", Type: paymentEventCheckoutCompleted})
+	noID, _ := json.Marshal(&PaymentEvent{Type: paymentEventCheckoutCompleted})
+	other := newFakeGateway("other", "", nil)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		wantErr   bool
+	}{
+		{"signed", payload, gateway.Sign(payload), false},
+		{"upper case signature", payload, strings.ToUpper(gateway.Sign(payload)), false},
+		{"unsigned", payload, "", true},
+		{"signed with another secret", payload, other.Sign(payload), true},
+		{"tampered payload", append(payload[:len(payload)-1:len(payload)-1], ' ', '}'), gateway.Sign(payload), true},
+		{"no event ID", noID, gateway.Sign(noID), true},
+		{"not JSON", []byte("event"), gateway.Sign([]byte("event")), true},
+	}
+	for _, tt := range tests {
+		header := make(http.Header)
+		header.Set("Fake-Signature", tt.signature)
+		event, err := gateway.ParseWebhookEvent(tt.payload, header)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ParseWebhookEvent() error = %v, want error %t", tt.name, err, tt.wantErr)
+		}
+		if err == nil && event.ID != "fake_evt_1" {
+			t.Errorf("%s: event ID = %s, want fake_evt_1", tt.name, event.ID)
+		}
+	}
+
+	unsigned := newFakeGateway("", "", nil)
+	header := make(http.Header)
+	header.Set("Fake-Signature", unsigned.Sign(payload))
+	if _, err := unsigned.ParseWebhookEvent(payload, header); err == nil {
+		t.Errorf("ParseWebhookEvent() without a webhook secret succeeded")
+	}
+}
+
+func TestFakeGatewayRefundPayment(t *testing.T) {
+	ctx := context.Background()
+	gateway, _ := newTestFakeGateway(t)
+	unpaid, _ := gateway.CreateCheckoutSession(ctx, &CheckoutSessionRequest{OrderID: 1, Currency: "USD", Amount: 1000})
+	paid, _ := gateway.CreateCheckoutSession(ctx, &CheckoutSessionRequest{OrderID: 2, Currency: "USD", Amount: 1000})
+	if _, err := gateway.Complete(paid.ID, fakePaymentSucceeded); err != nil {
+		t.Fatal(err)
+	}
+
+	refundIDs := make(map[string]string)
+	tests := []struct {
+		name       string
+		intent     string
+		amount     int64
+		reference  string
+		wantErr    bool
+		wantAmount int64
+		// the earlier refund of the reference is returned again
+		wantRepeated bool
+	}{
+		{"unpaid", unpaid.PaymentIntentID, 100, "RMA-1", true, 0, false},
+		{"unknown payment intent", "fake_pi_unknown", 100, "RMA-1", true, 0, false},
+		{"partial", paid.PaymentIntentID, 400, "RMA-1", false, 400, false},
+		{"same reference", paid.PaymentIntentID, 400, "RMA-1", false, 400, true},
+		{"same reference of another amount", paid.PaymentIntentID, 900, "RMA-1", false, 400, true},
+		{"exceeding the rest", paid.PaymentIntentID, 700, "RMA-2", true, 0, false},
+		{"rest", paid.PaymentIntentID, 600, "RMA-2", false, 600, false},
+		{"fully refunded", paid.PaymentIntentID, 1, "RMA-3", true, 0, false},
+	}
+	for _, tt := range tests {
+		refund, err := gateway.RefundPayment(ctx, tt.intent, tt.amount, tt.reference)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: RefundPayment() error = %v, want error %t", tt.name, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if refund.Amount != tt.wantAmount || refund.Status != "succeeded" {
+			t.Errorf("%s: refund = %+v, want %d succeeded", tt.name, refund, tt.wantAmount)
+		}
+		if id, ok := refundIDs[tt.reference]; ok != tt.wantRepeated || ok && id != refund.ID {
+			t.Errorf("%s: refund %s, earlier refund of %s %q", tt.name, refund.ID, tt.reference, id)
+		}
+		refundIDs[tt.reference] = refund.ID
+	}
+}
+
+func TestGetPaymentGateway(t *testing.T) {
+	gateway := registerTestGateway(t)
+	stripe, hasStripe := paymentGateways[stripeGatewayName]
+	registerPaymentGateway(newStripeGateway("", ""))
+	t.Cleanup(func() {
+		if hasStripe {
+			paymentGateways[stripeGatewayName] = stripe
+		} else {
+			delete(paymentGateways, stripeGatewayName)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		gateway string
+		want    string
+	}{
+		{"exact", "test", testGatewayName},
+		{"upper case", "TEST", testGatewayName},
+		{"padded", " Test ", testGatewayName},
+		{"unnamed payments of Stripe", "", stripeGatewayName},
+		{"blank", "  ", stripeGatewayName},
+		{"not enabled", "fake", ""},
+	}
+	for _, tt := range tests {
+		found := getPaymentGateway(tt.gateway)
+		name := ""
+		if found != nil {
+			name = found.Name()
+		}
+		if name != tt.want {
+			t.Errorf("%s: getPaymentGateway(%q) = %q, want %q", tt.name, tt.gateway, name, tt.want)
+		}
+	}
+	if activePaymentGateway() != gateway {
+		t.Errorf("activePaymentGateway() is not the configured test gateway")
+	}
+}
@@ -0,0 +1,182 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/uptrace/bun"
+)
+
+// newTestStore points the API at a new database set up as on startup; the previous one is restored after the test
+func newTestStore(t *testing.T) *bun.DB {
+	testDB := newTestDB(t)
+	previous := db
+	db = testDB
+	t.Cleanup(func() { db = previous })
+	SetUpDB()
+	return testDB
+}
+
+func testRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	return request
+}
+
+func testAdmin() *models.Principal {
+	return &models.Principal{UserInfo: models.UserInfo{ACLRole: "admin", User: &models.User{ID: 1}}}
+}
+
+// testCustomer is the customer of the user ID; the test orders belong to user 1
+func testCustomer(userID int64) *models.Principal {
+	return &models.Principal{UserInfo: models.UserInfo{ACLRole: "private", User: &models.User{ID: userID}}}
+}
+
+func testAddress() *models.Address {
+	return &models.Address{Name: swag.String("Jane Doe"), Line1: swag.String("Main Street 1"),
+		City: swag.String("Berlin"), Country: swag.String("DE"), PostalCode: "10115"}
+}
+
+// addTestProduct adds a product of the base currency price with the number of items in stock
+func addTestProduct(t *testing.T, price int64, inStock int64) *dbModels.Product {
+	product := &dbModels.Product{Title: swag.String(fmt.Sprintf("Product %d", price)),
+		Description: swag.String("Test product"), Price: price, Currency: baseCurrency(),
+		NumberInStock: inStock}
+	if _, err := db.NewInsert().Model(product).ExcludeColumn("id").Returning("id").Exec(context.Background(), &product.ID); err != nil {
+		t.Fatal(err)
+	}
+	return product
+}
+
+// addTestOrder adds an order of user 1 in the status with a line of the quantity of every product;
+// the total is the sum of the product prices
+func addTestOrder(t *testing.T, status string, quantity int64, products ...*dbModels.Product) *dbModels.Order {
+	ctx := context.Background()
+	order := &dbModels.Order{Currency: baseCurrency(), Status: status, UserID: 1,
+		DateCreated: time.Now().Unix(), Version: 1}
+	for _, product := range products {
+		order.TotalPrice += product.Price * quantity
+	}
+	if _, err := db.NewInsert().Model(order).ExcludeColumn("id").Returning("id").Exec(ctx, &order.ID); err != nil {
+		t.Fatal(err)
+	}
+	for _, product := range products {
+		line := &dbModels.OrderedProduct{OrderID: order.ID, ProductID: swag.Int64(product.ID),
+			Quantity: swag.Int64(quantity), TotalPrice: product.Price * quantity}
+		if _, err := db.NewInsert().Model(line).ExcludeColumn("id").Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+		order.Products = append(order.Products, line)
+	}
+	return order
+}
+
+// addTestPayment adds a payment of the whole order total made through the test gateway
+func addTestPayment(t *testing.T, order *dbModels.Order, status string) *dbModels.Payment {
+	payment := &dbModels.Payment{Amount: order.TotalPrice, Currency: order.Currency, OrderID: order.ID,
+		UserID: order.UserID, Status: status, Gateway: testGatewayName,
+		CheckoutSessionID: fmt.Sprintf("cs_%d", order.ID), PaymentIntentId: fmt.Sprintf("pi_%d", order.ID)}
+	if _, err := db.NewInsert().Model(payment).ExcludeColumn("id").Returning("id").Exec(context.Background(), &payment.ID); err != nil {
+		t.Fatal(err)
+	}
+	return payment
+}
+
+func countTestRows(t *testing.T, table string, where string, args ...interface{}) int {
+	count, err := db.NewSelect().TableExpr(table).Where(where, args...).Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+const testGatewayName = "test"
+
+var errTestGateway = fmt.Errorf("the test gateway is unavailable")
+
+// testGateway is a payment gateway keeping the refunds in memory; refunding fails while refundErr is set
+type testGateway struct {
+	mutex      sync.Mutex
+	lastNumber int64
+	refundErr  error
+	// refunds are the refunds issued by reference
+	refunds map[string]*GatewayRefund
+}
+
+// registerTestGateway registers a new test gateway as the active one for the test
+func registerTestGateway(t *testing.T) *testGateway {
+	gateway := &testGateway{refunds: make(map[string]*GatewayRefund)}
+	registerPaymentGateway(gateway)
+	active := ApiConfiguration.Payments.Gateway
+	ApiConfiguration.Payments.Gateway = testGatewayName
+	t.Cleanup(func() {
+		delete(paymentGateways, testGatewayName)
+		ApiConfiguration.Payments.Gateway = active
+	})
+	return gateway
+}
+
+func (g *testGateway) Name() string {
+	return testGatewayName
+}
+
+func (g *testGateway) CreateCheckoutSession(ctx context.Context, request *CheckoutSessionRequest) (*GatewayCheckoutSession, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.lastNumber++
+	return &GatewayCheckoutSession{ID: fmt.Sprintf("cs_test_%d", g.lastNumber), Status: "open",
+		PaymentStatus: "unpaid", PaymentIntentID: fmt.Sprintf("pi_test_%d", g.lastNumber),
+		AmountTotal: request.Amount, Currency: request.Currency}, nil
+}
+
+func (g *testGateway) GetCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error) {
+	return &GatewayCheckoutSession{ID: id, Status: "open", PaymentStatus: "unpaid"}, nil
+}
+
+func (g *testGateway) ExpireCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error) {
+	return &GatewayCheckoutSession{ID: id, Status: "expired", PaymentStatus: "unpaid"}, nil
+}
+
+func (g *testGateway) CapturePayment(ctx context.Context, paymentIntentID string, amount int64) error {
+	return nil
+}
+
+func (g *testGateway) RefundPayment(ctx context.Context, paymentIntentID string, amount int64, reference string) (*GatewayRefund, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.refundErr != nil {
+		return nil, g.refundErr
+	}
+	if r, ok := g.refunds[reference]; ok {
+		return r, nil
+	}
+	g.lastNumber++
+	r := &GatewayRefund{ID: fmt.Sprintf("re_test_%d", g.lastNumber), Amount: amount, Status: "succeeded"}
+	g.refunds[reference] = r
+	return r, nil
+}
+
+func (g *testGateway) ParseWebhookEvent(payload []byte, header http.Header) (*PaymentEvent, error) {
+	return nil, fmt.Errorf("the test gateway has no webhooks")
+}
+
+// addTestReturn adds the approved return of the quantity of every ordered product
+func addTestReturn(t *testing.T, order *dbModels.Order, quantity int64) *dbModels.OrderReturn {
+	orderReturn := &dbModels.OrderReturn{OrderID: order.ID, UserID: order.UserID, Currency: order.Currency,
+		RmaNumber: fmt.Sprintf("RMA-TEST-%06d", order.ID), Status: models.OrderReturnStatusApproved,
+		DateCreated: time.Now().Unix()}
+	for _, line := range order.Products {
+		orderReturn.Items = append(orderReturn.Items, &dbModels.ReturnItem{ProductID: *line.ProductID,
+			Quantity: quantity, Reason: "Damaged"})
+	}
+	if _, err := db.NewInsert().Model(orderReturn).ExcludeColumn("id").Returning("id").Exec(context.Background(), &orderReturn.ID); err != nil {
+		t.Fatal(err)
+	}
+	return orderReturn
+}
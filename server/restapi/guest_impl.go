@@ -0,0 +1,230 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/guest"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+const orderTokenKind = "order"
+
+// orderTokenTTL is the time a guest order token is valid for, long enough to follow the order up to its return
+const orderTokenTTL = 365 * 24 * time.Hour
+
+// isGuestCheckoutEnabled tells whether the guest orders can be placed; their tokens are the only way to reach them,
+// so they must not be signed with a random secret lost on restart
+func isGuestCheckoutEnabled() bool {
+	return ApiConfiguration.TokenSecret != ""
+}
+
+func addGuestOrder(params *guest.AddGuestOrderParams) (*models.GuestOrderAccess, errors.Error) {
+	if !isGuestCheckoutEnabled() {
+		return nil, errors.New(503, "Guest checkout is not available!")
+	}
+	ctx := params.HTTPRequest.Context()
+	email, err := normalizeGuestEmail(*params.Body.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	products := params.Body.Products
+	var dbCart *dbModels.Cart
+	currency, err := resolveCurrency(params.XCurrency, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		// the anonymous cart is resolved by its token only
+		dbCart, err = resolveCart(ctx, nil, params.XCartToken, false)
+		if err != nil {
+			return nil, err
+		}
+		if params.XCurrency == nil && dbCart != nil && dbCart.Currency != "" {
+			currency = dbCart.Currency
+		}
+		products, err = orderedProductsFromCart(ctx, dbCart, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	item := &models.Order{
+		BillingAddress:   params.Body.BillingAddress,
+		CouponCode:       params.Body.CouponCode,
+		DeliveryInfo:     params.Body.DeliveryInfo,
+		Products:         products,
+		ShippingAddress:  params.Body.ShippingAddress,
+		ShippingMethodID: params.Body.ShippingMethodID,
+		TotalPrice:       dbModels.MoneyDTO(0, currency),
+	}
+	dbModel := dbModels.NewOrderFrom(item)
+	dbModel.Currency = currency
+	dbModel.GuestEmail = email
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+	dbModel.Status = models.OrderStatusPendingPayment
+
+	err = insertOrder(ctx, dbModel, item, orderActorCustomer, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbCart != nil {
+		dbCart.Items = nil
+		err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+			return saveCartItems(ctx, tx, dbCart)
+		})
+		if err != nil {
+			Logger.Error("Could not empty cart %d after creating guest order %d: %s", dbCart.ID, dbModel.ID, err.Error())
+		}
+	}
+	return &models.GuestOrderAccess{
+		AccessToken: signToken(orderTokenKind, dbModel.ID, orderTokenTTL),
+		Order:       dbModel.ToDTO(),
+	}, nil
+}
+
+// normalizeGuestEmail validates the guest email and strips the display name and the spaces from it
+func normalizeGuestEmail(email string) (string, errors.Error) {
+	address, parseErr := mail.ParseAddress(strings.TrimSpace(email))
+	if parseErr != nil {
+		return "", errors.New(400, "Invalid email %s: %s", email, parseErr.Error())
+	}
+	return address.Address, nil
+}
+
+// orderedProductsFromCart converts the cart items revalidated in the currency to the ordered products
+func orderedProductsFromCart(ctx context.Context, dbCart *dbModels.Cart, currency string) ([]*models.OrderedProduct, errors.Error) {
+	if dbCart == nil {
+		return nil, errors.New(400, "Cart is empty!")
+	}
+	cartDTO, err := revalidateCart(ctx, dbCart, currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(cartDTO.Items) == 0 {
+		return nil, errors.New(400, "Cart is empty!")
+	}
+
+	orderedProducts := make([]*models.OrderedProduct, len(cartDTO.Items))
+	for i, item := range cartDTO.Items {
+		if item.InStock == nil || !*item.InStock {
+			return nil, errors.New(409, "Product %d (%s) is out of stock!", *item.ProductID, item.ProductName)
+		}
+		orderedProducts[i] = &models.OrderedProduct{
+			ProductID:  item.ProductID,
+			Quantity:   item.Quantity,
+			TotalPrice: item.TotalPrice,
+		}
+	}
+	return orderedProducts, nil
+}
+
+// getGuestOrderByToken finds the guest order the access token has been issued for;
+// the claimed orders are accessible to their accounts only
+func getGuestOrderByToken(orderID int64, token string) (*dbModels.Order, errors.Error) {
+	tokenOrderID, err := parseSignedToken(orderTokenKind, token)
+	if err != nil {
+		return nil, err
+	}
+	if tokenOrderID != orderID {
+		return nil, errors.New(403, "Invalid %s token!", orderTokenKind)
+	}
+	dbModel, err := getOrderFromDB(orderID, true, 0)
+	if err != nil {
+		return nil, err
+	}
+	if dbModel.GuestEmail == "" {
+		return nil, errors.New(403, "Order %d is not a guest order!", orderID)
+	}
+	if dbModel.UserID > 0 {
+		return nil, errors.New(403, "Order %d has been claimed into an account; sign in to access it!", orderID)
+	}
+	return dbModel, nil
+}
+
+func getGuestOrder(params *guest.GetGuestOrderParams) (*models.Order, errors.Error) {
+	dbModel, err := getGuestOrderByToken(params.ID, params.XOrderToken)
+	if err != nil {
+		return nil, err
+	}
+	dbModel.StatusHistory, err = getOrderStatusHistory(dbModel.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func createGuestCheckoutSession(params *guest.AddGuestCheckoutSessionParams) (*models.CheckoutSessionSecret, errors.Error) {
+	dbModel, err := getGuestOrderByToken(params.ID, params.XOrderToken)
+	if err != nil {
+		return nil, err
+	}
+	if dbModel.Status != models.OrderStatusPendingPayment {
+		return nil, errors.New(409, "Order %d in status '%s' cannot be paid!", dbModel.ID, dbModel.Status)
+	}
+	return createOrderCheckoutSession(params.HTTPRequest.Context(), dbModel, 0)
+}
+
+// claimGuestOrder moves the guest order to the account of the current user; the user must have signed in
+// with the verified email the order has been placed with, so the token alone does not hand the order over
+func claimGuestOrder(params *guest.ClaimGuestOrderParams, principal *models.Principal) (*models.Order, errors.Error) {
+	if principal == nil || principal.User == nil || principal.User.ID < 1 {
+		return nil, errors.New(403, "Unregistered users are forbidden!")
+	}
+	dbModel, err := getGuestOrderByToken(params.ID, params.XOrderToken)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.EmailVerified {
+		return nil, errors.New(403, "Email of the current user is not verified!")
+	}
+	if principal.User.Email == nil || !strings.EqualFold(*principal.User.Email, dbModel.GuestEmail) {
+		return nil, errors.New(403, "Order %d has been placed with another email!", dbModel.ID)
+	}
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel.UserID = principal.User.ID
+		dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+		query := tx.NewUpdate().Model(dbModel).Column("user_id", "date_updated").
+			Where("id = ?", dbModel.ID).
+			Where("user_id IS NULL")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not claim order %d for user %d!\n", sqlErr, dbModel.ID, principal.User.ID)
+			return errors.New(500, "ERROR: Could not claim order %d!", dbModel.ID)
+		}
+		if affected, _ := res.RowsAffected(); affected == 0 {
+			return errors.New(409, "Order %d has been claimed already!", dbModel.ID)
+		}
+		err := touchVersion(ctx, tx, "orders", dbModel.ID)
+		if err != nil {
+			return err
+		}
+		for _, table := range []string{"payments", "promotion_redemptions", "order_returns"} {
+			query := tx.NewUpdate().TableExpr(table).
+				Set("user_id = ?", principal.User.ID).
+				Where("order_id = ?", dbModel.ID)
+			Logger.Debug("Built the query %s\n", query)
+			if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+				Logger.Error("ERROR %v: Could not claim order %d %s for user %d!\n",
+					sqlErr, dbModel.ID, table, principal.User.ID)
+				return errors.New(500, "ERROR: Could not claim order %d!", dbModel.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
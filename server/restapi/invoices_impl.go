@@ -0,0 +1,542 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/pdf"
+	"estore-backend/server/restapi/operations/invoice"
+	"estore-backend/server/restapi/operations/invoices"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Prefixes of the invoice and the credit note numbers, e. g. INV-2026-000042
+const (
+	invoiceNumberPrefix    = "INV"
+	creditNoteNumberPrefix = "CN"
+)
+
+// invoiceLine is a line item printed on an invoice or a credit note; the amounts of the documents are
+// in the minor units of the order currency
+type invoiceLine struct {
+	Description string
+	Quantity    int64
+	UnitPrice   int64
+	Total       int64
+}
+
+// invoiceTaxLine is a line of the tax breakdown printed on an invoice or a credit note
+type invoiceTaxLine struct {
+	Name          string
+	Rate          float64
+	TaxableAmount int64
+	Amount        int64
+}
+
+// invoiceContent is everything printed on an invoice or a credit note
+type invoiceContent struct {
+	Title         string
+	Number        string
+	InvoiceNumber string // the invoice a credit note corrects
+	DateIssued    int64
+	Order         *dbModels.Order
+	Lines         []*invoiceLine
+	Taxes         []*invoiceTaxLine
+	TaxesIncluded bool
+	TaxTotal      int64
+	Total         int64
+	Reference     string
+}
+
+// issueInvoice issues the invoice of the order once it is paid; the number is taken in the transaction
+// moving the order to the paid status, so a rolled back transaction leaves no gap in the numbering
+func issueInvoice(ctx context.Context, idb bun.IDB, orderID int64) errors.Error {
+	existing, err := findInvoices(ctx, idb, orderID, models.InvoiceKindInvoice)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return nil
+	}
+	dbOrder, err := getInvoicedOrder(ctx, idb, orderID)
+	if err != nil {
+		return err
+	}
+
+	content := &invoiceContent{
+		Title:         "Invoice",
+		Order:         dbOrder,
+		Lines:         orderInvoiceLines(dbOrder),
+		Taxes:         orderInvoiceTaxLines(dbOrder, 1, 1),
+		TaxesIncluded: dbOrder.PricesIncludeTax,
+		TaxTotal:      dbOrder.TaxTotal,
+		Total:         dbOrder.TotalPrice,
+	}
+	return addInvoice(ctx, idb, models.InvoiceKindInvoice, invoiceNumberPrefix, content, 0, 0)
+}
+
+// creditedItem is an item of the order a credit note is issued for
+type creditedItem struct {
+	ProductID int64
+	Quantity  int64
+	// credited amount of the items; zero for their paid price
+	Amount int64
+}
+
+// creditedRefund is a refund of the order a credit note is issued for
+type creditedRefund struct {
+	Amount int64
+	// reference printed on the credit note (e. g., "Return: RMA-2026-000042")
+	Reference string
+	// prefix of the item line descriptions
+	ItemPrefix string
+	Items      []*creditedItem
+	ReturnID   int64
+	RefundID   int64
+}
+
+// returnCreditedRefund describes the refund of the received items of the return
+func returnCreditedRefund(orderReturn *dbModels.OrderReturn) *creditedRefund {
+	result := &creditedRefund{
+		Amount:     orderReturn.RefundAmount,
+		Reference:  "Return: " + orderReturn.RmaNumber,
+		ItemPrefix: "Return: ",
+		Items:      make([]*creditedItem, 0, len(orderReturn.Items)),
+		ReturnID:   orderReturn.ID,
+	}
+	for _, item := range orderReturn.Items {
+		result.Items = append(result.Items, &creditedItem{ProductID: item.ProductID, Quantity: item.ReceivedQuantity})
+	}
+	return result
+}
+
+// issueCreditNote issues a credit note for the refunded amount of the invoiced order;
+// the lines are the credited items of the refund if it is given, otherwise the rest of the invoice is credited.
+// Orders without an invoice have nothing to be credited.
+func issueCreditNote(ctx context.Context, idb bun.IDB, orderID int64, refund *creditedRefund) errors.Error {
+	issued, err := findInvoices(ctx, idb, orderID, "")
+	if err != nil {
+		return err
+	}
+	var orderInvoice *dbModels.Invoice
+	var credited int64 = 0
+	for _, i := range issued {
+		if i.Kind == models.InvoiceKindInvoice {
+			orderInvoice = i
+		} else {
+			credited += i.Amount
+		}
+	}
+	if orderInvoice == nil {
+		return nil
+	}
+	creditable := orderInvoice.Amount - credited
+	amount := creditable
+	if refund != nil {
+		amount = money.Min(refund.Amount, creditable)
+	}
+	if amount <= 0 {
+		return nil
+	}
+	dbOrder, err := getInvoicedOrder(ctx, idb, orderID)
+	if err != nil {
+		return err
+	}
+
+	content := &invoiceContent{
+		Title:         "Credit note",
+		InvoiceNumber: orderInvoice.Number,
+		Order:         dbOrder,
+		TaxesIncluded: true,
+		Total:         amount,
+	}
+	var lines []*invoiceLine
+	if refund != nil {
+		content.Reference = refund.Reference
+		lines = creditedInvoiceLines(dbOrder, refund)
+	} else if amount == orderInvoice.Amount {
+		lines = orderInvoiceLines(dbOrder)
+		content.TaxesIncluded = dbOrder.PricesIncludeTax
+	}
+	var linesTotal int64 = 0
+	for _, line := range lines {
+		linesTotal += line.Total
+	}
+	if !content.TaxesIncluded {
+		linesTotal += dbOrder.TaxTotal
+	}
+	if adjustment := amount - linesTotal; adjustment != 0 {
+		description := "Refund adjustment"
+		if len(lines) == 0 {
+			description = fmt.Sprintf("Refund of order %d", orderID)
+		}
+		lines = append(lines, &invoiceLine{Description: description, Quantity: 1, UnitPrice: adjustment, Total: adjustment})
+	}
+	content.Lines = lines
+
+	// the taxes of the credited amount are the share of the order taxes
+	content.Taxes = orderInvoiceTaxLines(dbOrder, amount, dbOrder.TotalPrice)
+	for _, line := range content.Taxes {
+		content.TaxTotal += line.Amount
+	}
+
+	var returnID, refundID int64 = 0, 0
+	if refund != nil {
+		returnID = refund.ReturnID
+		refundID = refund.RefundID
+	}
+	return addInvoice(ctx, idb, models.InvoiceKindCreditNote, creditNoteNumberPrefix, content, returnID, refundID)
+}
+
+// addInvoice numbers, renders and stores the document
+func addInvoice(ctx context.Context, idb bun.IDB, kind string, prefix string, content *invoiceContent, returnID int64,
+	refundID int64) errors.Error {
+	now := time.Now().In(time.UTC)
+	dbModel := &dbModels.Invoice{
+		Amount:     content.Total,
+		Currency:   content.Order.Currency,
+		DateIssued: now.Unix(),
+		Kind:       kind,
+		OrderID:    content.Order.ID,
+		ReturnID:   returnID,
+		RefundID:   refundID,
+		TaxTotal:   content.TaxTotal,
+		Year:       now.Year(),
+	}
+	sequence, err := nextInvoiceSequence(ctx, idb, kind, dbModel.Year)
+	if err != nil {
+		return err
+	}
+	dbModel.Sequence = sequence
+	dbModel.Number = fmt.Sprintf("%s-%d-%06d", prefix, dbModel.Year, sequence)
+	content.Number = dbModel.Number
+	content.DateIssued = dbModel.DateIssued
+	dbModel.Document = renderInvoice(content)
+
+	query := idb.NewInsert().Model(dbModel).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add order %d %s %s!\n", sqlErr, dbModel.OrderID, kind, dbModel.Number)
+		return errors.New(500, "ERROR: Could not issue order %d %s!", dbModel.OrderID, kind)
+	}
+	Logger.Info("Issued order %d %s %s", dbModel.OrderID, kind, dbModel.Number)
+	return nil
+}
+
+// nextInvoiceSequence returns the next number of the documents of the kind issued in the year;
+// the unique index on the kind, the year and the sequence rejects concurrent transactions taking the same number
+func nextInvoiceSequence(ctx context.Context, idb bun.IDB, kind string, year int) (int64, errors.Error) {
+	var last int64
+	query := idb.NewSelect().Model((*dbModels.Invoice)(nil)).
+		ColumnExpr("COALESCE(MAX(sequence), 0)").
+		Where("kind = ?", kind).
+		Where("year = ?", year)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx, &last)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find the last %s number of %d!\n", sqlErr, kind, year)
+		return 0, errors.New(500, "ERROR: Could not number %s!", kind)
+	}
+	return last + 1, nil
+}
+
+// getInvoicedOrder loads the order with everything printed on its documents
+func getInvoicedOrder(ctx context.Context, idb bun.IDB, orderID int64) (*dbModels.Order, errors.Error) {
+	dbModel := new(dbModels.Order)
+	query := queryOrder(idb.NewSelect().Model(dbModel), true, 0).
+		Relation("User").
+		Where("?TableAlias.id = ?", orderID)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d!", orderID)
+	}
+	return dbModel, nil
+}
+
+// orderInvoiceLines lists the ordered products, the shipping and the discounts
+func orderInvoiceLines(order *dbModels.Order) []*invoiceLine {
+	lines := make([]*invoiceLine, 0, len(order.Products)+len(order.Discounts)+1)
+	for _, product := range order.Products {
+		line := &invoiceLine{Description: product.ProductName, Quantity: *product.Quantity, Total: product.TotalPrice}
+		if line.Quantity > 0 {
+			line.UnitPrice = money.Share(line.Total, 1, line.Quantity)
+		}
+		lines = append(lines, line)
+	}
+	if order.ShippingPrice > 0 {
+		lines = append(lines, &invoiceLine{Description: "Shipping: " + order.ShippingMethodName, Quantity: 1,
+			UnitPrice: order.ShippingPrice, Total: order.ShippingPrice})
+	}
+	for _, discount := range order.Discounts {
+		description := discount.Title
+		if discount.Code != "" {
+			description = fmt.Sprintf("%s (%s)", discount.Title, discount.Code)
+		}
+		lines = append(lines, &invoiceLine{Description: description, Quantity: 1,
+			UnitPrice: -discount.Amount, Total: -discount.Amount})
+	}
+	return lines
+}
+
+// creditedInvoiceLines lists the credited items of the refund at their paid prices unless their amounts are given
+func creditedInvoiceLines(order *dbModels.Order, refund *creditedRefund) []*invoiceLine {
+	taxableAmounts := allocateDiscounts(order)
+	names := make(map[int64]string)
+	for _, product := range order.Products {
+		names[*product.ProductID] = product.ProductName
+	}
+	lines := make([]*invoiceLine, 0, len(refund.Items))
+	for _, item := range refund.Items {
+		if item.Quantity == 0 {
+			continue
+		}
+		total := paidPrice(order, taxableAmounts, item.ProductID, item.Quantity)
+		if item.Amount > 0 {
+			total = item.Amount
+		}
+		lines = append(lines, &invoiceLine{
+			Description: refund.ItemPrefix + names[item.ProductID],
+			Quantity:    item.Quantity,
+			UnitPrice:   money.Share(total, 1, item.Quantity),
+			Total:       total,
+		})
+	}
+	return lines
+}
+
+// orderInvoiceTaxLines sums up the order taxes by the tax and the rate; the credit notes get the part of them
+// proportional to the credited part of the whole order total
+func orderInvoiceTaxLines(order *dbModels.Order, part int64, whole int64) []*invoiceTaxLine {
+	byKey := make(map[string]*invoiceTaxLine)
+	for _, tax := range order.Taxes {
+		key := fmt.Sprintf("%s|%f", tax.Name, tax.Rate)
+		line, ok := byKey[key]
+		if !ok {
+			line = &invoiceTaxLine{Name: tax.Name, Rate: tax.Rate}
+			byKey[key] = line
+		}
+		line.TaxableAmount += tax.TaxableAmount
+		line.Amount += tax.Amount
+	}
+	result := make([]*invoiceTaxLine, 0, len(byKey))
+	for _, line := range byKey {
+		line.TaxableAmount = money.Share(line.TaxableAmount, part, whole)
+		line.Amount = money.Share(line.Amount, part, whole)
+		result = append(result, line)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Rate < result[j].Rate
+	})
+	return result
+}
+
+func formatInvoiceAmount(amount int64, currency string) string {
+	return money.New(amount, currency).String()
+}
+
+func formatInvoiceAddress(address dbModels.AddressFields) []string {
+	lines := make([]string, 0, 5)
+	for _, line := range []string{address.Name, address.Line1, address.Line2,
+		address.PostalCode + " " + address.City, address.Region + " " + address.Country} {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// renderInvoice renders the invoice or the credit note to PDF
+func renderInvoice(content *invoiceContent) []byte {
+	const (
+		left       = 50.0
+		right      = pdf.PageWidth - 50
+		bottom     = pdf.PageHeight - 60
+		lineHeight = 14.0
+	)
+	doc := pdf.New()
+	doc.AddPage()
+	y := 60.0
+	newLine := func(height float64) {
+		y += height
+		if y > bottom {
+			doc.AddPage()
+			y = 60
+		}
+	}
+
+	company := ApiConfiguration.Invoices.Company
+	doc.Text(left, y, 16, true, company.Name)
+	doc.TextRight(right, y, 16, true, content.Title)
+	newLine(20)
+	companyLines := append([]string{}, company.AddressLines...)
+	if company.VATNumber != "" {
+		companyLines = append(companyLines, "VAT number: "+company.VATNumber)
+	}
+	if company.Email != "" {
+		companyLines = append(companyLines, company.Email)
+	}
+	details := []string{
+		"Number: " + content.Number,
+		"Date: " + time.Unix(content.DateIssued, 0).In(time.UTC).Format("2006-01-02"),
+		fmt.Sprintf("Order: %d", content.Order.ID),
+	}
+	if content.InvoiceNumber != "" {
+		details = append(details, "Corrects invoice: "+content.InvoiceNumber)
+	}
+	if content.Reference != "" {
+		details = append(details, content.Reference)
+	}
+	for i := 0; i < len(companyLines) || i < len(details); i++ {
+		if i < len(companyLines) {
+			doc.Text(left, y, 10, false, companyLines[i])
+		}
+		if i < len(details) {
+			doc.TextRight(right, y, 10, false, details[i])
+		}
+		newLine(lineHeight)
+	}
+
+	newLine(lineHeight)
+	billing := formatInvoiceAddress(content.Order.BillingAddress)
+	if len(billing) == 0 {
+		billing = formatInvoiceAddress(content.Order.ShippingAddress)
+	}
+	if content.Order.User != nil && content.Order.User.Email != "" {
+		billing = append(billing, content.Order.User.Email)
+	}
+	shipping := formatInvoiceAddress(content.Order.ShippingAddress)
+	doc.Text(left, y, 10, true, "Bill to")
+	doc.Text(left+260, y, 10, true, "Ship to")
+	newLine(lineHeight)
+	for i := 0; i < len(billing) || i < len(shipping); i++ {
+		if i < len(billing) {
+			doc.Text(left, y, 10, false, billing[i])
+		}
+		if i < len(shipping) {
+			doc.Text(left+260, y, 10, false, shipping[i])
+		}
+		newLine(lineHeight)
+	}
+
+	newLine(lineHeight)
+	doc.Text(left, y, 10, true, "Description")
+	doc.TextRight(right-200, y, 10, true, "Quantity")
+	doc.TextRight(right-100, y, 10, true, "Unit price")
+	doc.TextRight(right, y, 10, true, "Amount")
+	newLine(6)
+	doc.Line(left, y, right, y)
+	newLine(lineHeight)
+	currency := content.Order.Currency
+	var subtotal int64 = 0
+	for _, line := range content.Lines {
+		doc.Text(left, y, 10, false, line.Description)
+		doc.TextRight(right-200, y, 10, false, fmt.Sprintf("%d", line.Quantity))
+		doc.TextRight(right-100, y, 10, false, formatInvoiceAmount(line.UnitPrice, currency))
+		doc.TextRight(right, y, 10, false, formatInvoiceAmount(line.Total, currency))
+		subtotal += line.Total
+		newLine(lineHeight)
+	}
+	newLine(-lineHeight + 6)
+	doc.Line(left, y, right, y)
+	newLine(lineHeight)
+
+	total := func(label string, amount int64, bold bool) {
+		doc.TextRight(right-100, y, 10, bold, label)
+		doc.TextRight(right, y, 10, bold, formatInvoiceAmount(amount, currency))
+		newLine(lineHeight)
+	}
+	taxLabel := func(line *invoiceTaxLine) string {
+		return fmt.Sprintf("%s %.2f%% of %s", line.Name, line.Rate, formatInvoiceAmount(line.TaxableAmount, currency))
+	}
+	if content.TaxesIncluded {
+		total("Total", content.Total, true)
+		for _, line := range content.Taxes {
+			total("incl. "+taxLabel(line), line.Amount, false)
+		}
+	} else {
+		total("Subtotal", subtotal, false)
+		for _, line := range content.Taxes {
+			total(taxLabel(line), line.Amount, false)
+		}
+		total("Total", content.Total, true)
+	}
+	return doc.Bytes()
+}
+
+func getInvoice(params *invoice.GetInvoiceParams, principal *models.Principal) (io.ReadCloser, string, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, "", err
+	}
+	// restricts the non-admin users to their own orders
+	_, err = getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	dbModel := new(dbModels.Invoice)
+	query := db.NewSelect().Model(dbModel).Where("order_id = ?", params.ID)
+	if params.Number != nil && *params.Number != "" {
+		query.Where("number = ?", *params.Number)
+	} else {
+		query.Where("kind = ?", models.InvoiceKindInvoice)
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d invoice %v!\n", sqlErr, params.ID, params.Number)
+		return nil, "", errors.New(404, "Could not find the invoice of order %d!", params.ID)
+	}
+	return io.NopCloser(bytes.NewReader(dbModel.Document)), dbModel.Number + ".pdf", nil
+}
+
+func allInvoices(params *invoices.ListInvoicesParams, principal *models.Principal) ([]*models.Invoice, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	_, err = getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbInvoices, err := findInvoices(params.HTTPRequest.Context(), db, params.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	return dbModels.InvoiceDTOsFromInvoices(dbInvoices), nil
+}
+
+// findInvoices finds the documents of the order, all of them if no kind is given
+func findInvoices(ctx context.Context, idb bun.IDB, orderID int64, kind string) ([]*dbModels.Invoice, errors.Error) {
+	result := make([]*dbModels.Invoice, 0)
+	query := idb.NewSelect().Model(&result).ExcludeColumn("document").Where("order_id = ?", orderID).Order("id ASC")
+	if kind != "" {
+		query.Where("kind = ?", kind)
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d invoices!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d invoices!", orderID)
+	}
+	return result, nil
+}
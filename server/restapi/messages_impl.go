@@ -0,0 +1,243 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/message"
+	"estore-backend/server/restapi/operations/messages"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Maximal size of a decoded order message attachment
+const maxOrderMessageAttachmentSize = 5 * 1024 * 1024
+
+// orderMessageRoleOf returns the side of the order thread the principal belongs to
+func orderMessageRoleOf(principal *models.Principal) (string, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return "", err
+	}
+	if isAdmin {
+		return models.OrderMessageAuthorRoleAdmin, nil
+	}
+	return models.OrderMessageAuthorRoleCustomer, nil
+}
+
+// checkOrderThreadAccess restricts the customers to the threads of their own orders
+func checkOrderThreadAccess(orderID int64, principal *models.Principal) (string, errors.Error) {
+	role, err := orderMessageRoleOf(principal)
+	if err != nil {
+		return "", err
+	}
+	_, err = getOrderFromDB(orderID, role == models.OrderMessageAuthorRoleAdmin, principal.User.ID)
+	if err != nil {
+		return "", err
+	}
+	return role, nil
+}
+
+func postOrderMessage(params *messages.PostOrderMessageParams, principal *models.Principal) (*models.OrderMessage, errors.Error) {
+	role, err := checkOrderThreadAccess(params.ID, principal)
+	if err != nil {
+		return nil, err
+	}
+	if params.Body.Internal && role != models.OrderMessageAuthorRoleAdmin {
+		return nil, errors.New(403, "Only admins are allowed to add internal notes!")
+	}
+	body := strings.TrimSpace(*params.Body.Body)
+	if body == "" {
+		return nil, errors.New(400, "Message body cannot be empty!")
+	}
+
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel := &dbModels.OrderMessage{
+		AuthorID:    principal.User.ID,
+		AuthorRole:  role,
+		Body:        body,
+		DateCreated: nowUnixEpoch,
+		Internal:    params.Body.Internal,
+		OrderID:     params.ID,
+	}
+	dbModel.Attachments, err = decodeOrderMessageAttachments(params.ID, params.Body.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewInsert().Model(dbModel).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %d message!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not add order %d message!", params.ID)
+		}
+		dbModel.ID, sqlErr = res.LastInsertId()
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find last insert ID for order %d message!", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not add order %d message!", params.ID)
+		}
+
+		for _, attachment := range dbModel.Attachments {
+			attachment.MessageID = dbModel.ID
+			query := tx.NewInsert().Model(attachment).ExcludeColumn("id")
+			Logger.Debug("Built the query %s\n", query)
+
+			res, sqlErr := query.Exec(ctx)
+			if sqlErr != nil {
+				Logger.Error("ERROR %v: Could not add order %d message %d attachment %s!\n",
+					sqlErr, params.ID, dbModel.ID, attachment.FileName)
+				return errors.New(500, "ERROR: Could not add order %d message attachment!", params.ID)
+			}
+			attachment.ID, sqlErr = res.LastInsertId()
+			if sqlErr != nil {
+				Logger.Error("ERROR %v: Could not find last insert ID for order %d message %d attachment!",
+					sqlErr, params.ID, dbModel.ID)
+				return errors.New(500, "ERROR: Could not add order %d message attachment!", params.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(role), nil
+}
+
+// decodeOrderMessageAttachments decodes the base64 content of the posted attachments;
+// the content type is detected from the content if not given
+func decodeOrderMessageAttachments(orderID int64, attachments []*models.OrderMessageAttachment) ([]*dbModels.OrderMessageAttachment, errors.Error) {
+	result := make([]*dbModels.OrderMessageAttachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		fileName := filepath.Base(strings.TrimSpace(*attachment.FileName))
+		if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+			return nil, errors.New(400, "Attachment file name cannot be empty!")
+		}
+		content, decodeErr := base64.StdEncoding.DecodeString(attachment.Content)
+		if decodeErr != nil {
+			return nil, errors.New(400, "Attachment %s content is not base64 encoded: %s", fileName, decodeErr.Error())
+		}
+		if len(content) == 0 {
+			return nil, errors.New(400, "Attachment %s is empty!", fileName)
+		}
+		if len(content) > maxOrderMessageAttachmentSize {
+			return nil, errors.New(413, "Attachment %s exceeds %d bytes!", fileName, maxOrderMessageAttachmentSize)
+		}
+		contentType := strings.TrimSpace(attachment.ContentType)
+		if contentType == "" {
+			contentType = http.DetectContentType(content)
+		}
+		result = append(result, &dbModels.OrderMessageAttachment{
+			Content:     content,
+			ContentType: contentType,
+			FileName:    fileName,
+			OrderID:     orderID,
+			Size:        int64(len(content)),
+		})
+	}
+	return result, nil
+}
+
+func allOrderMessages(params *messages.ListOrderMessagesParams, principal *models.Principal) ([]*models.OrderMessage, errors.Error) {
+	role, err := checkOrderThreadAccess(params.ID, principal)
+	if err != nil {
+		return nil, err
+	}
+	dbMessages, err := findOrderMessages(params.HTTPRequest.Context(), db, params.ID, role)
+	if err != nil {
+		return nil, err
+	}
+	return dbModels.OrderMessageDTOsFromOrderMessages(dbMessages, role), nil
+}
+
+// markOrderMessagesRead marks the messages of the other side as read by the side of the principal;
+// a message read by any of the admins is read by the staff
+func markOrderMessagesRead(params *messages.MarkOrderMessagesReadParams, principal *models.Principal) ([]*models.OrderMessage, errors.Error) {
+	role, err := checkOrderThreadAccess(params.ID, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	var dbMessages []*dbModels.OrderMessage
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		column := "date_read_by_customer"
+		if role == models.OrderMessageAuthorRoleAdmin {
+			column = "date_read_by_staff"
+		}
+		query := tx.NewUpdate().Model((*dbModels.OrderMessage)(nil)).
+			Set("? = ?", bun.Ident(column), time.Now().In(time.UTC).Unix()).
+			Where("order_id = ?", params.ID).
+			Where("author_role != ?", role).
+			Where("internal = ?", false).
+			Where("? = 0", bun.Ident(column))
+		Logger.Debug("Built the query %s\n", query)
+
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not mark order %d messages read!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not mark order %d messages read!", params.ID)
+		}
+
+		dbMessages, err = findOrderMessages(ctx, tx, params.ID, role)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModels.OrderMessageDTOsFromOrderMessages(dbMessages, role), nil
+}
+
+func getOrderMessageAttachment(params *message.GetOrderMessageAttachmentParams, principal *models.Principal) (*dbModels.OrderMessageAttachment, io.ReadCloser, errors.Error) {
+	role, err := checkOrderThreadAccess(params.ID, principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dbModel := new(dbModels.OrderMessageAttachment)
+	query := db.NewSelect().Model(dbModel).
+		Relation("Message").
+		Where("?TableAlias.id = ?", params.AttachmentID).
+		Where("?TableAlias.message_id = ?", params.MessageID).
+		Where("?TableAlias.order_id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil || (dbModel.Message.Internal && role != models.OrderMessageAuthorRoleAdmin) {
+		Logger.Error("ERROR %v: Could not find order %d message %d attachment %d!\n",
+			sqlErr, params.ID, params.MessageID, params.AttachmentID)
+		return nil, nil, errors.New(404, "Could not find order %d message %d attachment %d!",
+			params.ID, params.MessageID, params.AttachmentID)
+	}
+	return dbModel, io.NopCloser(bytes.NewReader(dbModel.Content)), nil
+}
+
+// findOrderMessages finds the order thread with the attachments; the internal notes are found for admins only
+func findOrderMessages(ctx context.Context, idb bun.IDB, orderID int64, role string) ([]*dbModels.OrderMessage, errors.Error) {
+	result := make([]*dbModels.OrderMessage, 0)
+	query := idb.NewSelect().Model(&result).
+		Relation("Attachments", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.ExcludeColumn("content")
+		}).
+		Where("order_id = ?", orderID).
+		Order("id ASC")
+	if role != models.OrderMessageAuthorRoleAdmin {
+		query.Where("internal = ?", false)
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d messages!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d messages!", orderID)
+	}
+	return result, nil
+}
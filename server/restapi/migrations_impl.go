@@ -0,0 +1,225 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"fmt"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+	"strings"
+)
+
+// moneyColumns maps the tables to their legacy decimal amount columns; every amount moves to the
+// column of the same name with the "_minor" suffix
+var moneyColumns = []struct {
+	table   string
+	columns []string
+}{
+	{"products", []string{"price"}},
+	{"orders", []string{"total_price", "discount_total", "tax_total", "shipping_price", "refunded_total"}},
+	{"ordered_products", []string{"total_price", "tax_amount"}},
+	{"order_discounts", []string{"amount"}},
+	{"order_tax_lines", []string{"amount", "taxable_amount"}},
+	{"order_returns", []string{"refund_amount"}},
+	{"invoices", []string{"amount", "tax_total"}},
+	{"payments", []string{"amount", "refunded_amount"}},
+	{"refunds", []string{"amount"}},
+	{"promotions", []string{"min_order_value"}},
+	{"shipping_methods", []string{"price", "rate_per_kg", "free_over_amount"}},
+}
+
+// currencyTables lists the tables whose rows record the currency of their amounts
+var currencyTables = []string{"products", "orders", "order_returns", "invoices", "payments", "refunds",
+	"promotions", "shipping_methods"}
+
+// minorUnitsMarker is the key the migration adds to the converted reconciliation discrepancies, which have
+// no other sign of the units of their amounts, so that a retried migration skips them
+const minorUnitsMarker = "minorUnits"
+
+// moneyMinorUnitsMigration converts the amounts stored by the older versions as decimal numbers to the integer
+// minor units of the base currency, rounding half to even, and stamps the rows with the base currency.
+// The legacy columns are kept untouched; the migration has no rollback so that it runs only once, and a retried
+// migration skips the amounts already converted.
+func moneyMinorUnitsMigration() migrate.Migration {
+	return migrate.Migration{
+		Name:    "20261021090000",
+		Comment: "money_minor_units",
+		Up: func(ctx context.Context, db *bun.DB) error {
+			return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+				return migrateMoneyToMinorUnits(ctx, tx, baseCurrency())
+			})
+		},
+	}
+}
+
+func migrateMoneyToMinorUnits(ctx context.Context, idb bun.IDB, currency string) error {
+	// the refund items are converted before the refund amounts, so a retry skips the refunds already converted
+	refundColumns, err := tableColumns(ctx, idb, "refunds")
+	if err != nil {
+		return fmt.Errorf("could not read table refunds columns: %w", err)
+	}
+	if refundColumns["amount"] {
+		err = migrateJSONColumn(ctx, idb, "refunds", "items", "amount IS NOT NULL AND amount_minor IS NULL",
+			func(items []map[string]interface{}) {
+				for _, item := range items {
+					convertJSONAmount(item, "amount", currency)
+				}
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, t := range moneyColumns {
+		existing, err := tableColumns(ctx, idb, t.table)
+		if err != nil {
+			return fmt.Errorf("could not read table %s columns: %w", t.table, err)
+		}
+		for _, column := range t.columns {
+			if !existing[column] {
+				continue
+			}
+			err = migrateMoneyColumn(ctx, idb, t.table, column, column+"_minor", "", currency)
+			if err != nil {
+				return err
+			}
+		}
+		if t.table == "promotions" && existing["value"] {
+			// the value of the fixed promotions was the amount off
+			err = migrateMoneyColumn(ctx, idb, t.table, "value", "amount_off_minor",
+				fmt.Sprintf("kind = '%s'", models.PromotionKindFixed), currency)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, table := range currencyTables {
+		query := idb.NewUpdate().Table(table).Set("currency = ?", currency).Where("currency IS NULL OR currency = ''")
+		Logger.Debug("Built the query %s\n", query)
+		if _, err := query.Exec(ctx); err != nil {
+			return fmt.Errorf("could not set table %s currency: %w", table, err)
+		}
+	}
+
+	err = migrateShippingTiers(ctx, idb, currency)
+	if err != nil {
+		return err
+	}
+	return migrateJSONColumn(ctx, idb, "payment_reconciliations", "discrepancies", "", func(items []map[string]interface{}) {
+		for _, item := range items {
+			if _, ok := item[minorUnitsMarker]; ok {
+				continue
+			}
+			item[minorUnitsMarker] = true
+			convertJSONAmount(item, "amount", currency)
+			// the currency of the discrepancies was the one reported by the gateway
+			gatewayCurrency, _ := item["currency"].(string)
+			if _, ok := item["gatewayAmount"]; ok && gatewayCurrency != "" {
+				gatewayCurrency = strings.ToUpper(gatewayCurrency)
+				item["gatewayCurrency"] = gatewayCurrency
+				convertJSONAmount(item, "gatewayAmount", gatewayCurrency)
+			}
+			item["currency"] = currency
+		}
+	})
+}
+
+// migrateMoneyColumn fills the empty minor units column from the legacy decimal column
+func migrateMoneyColumn(ctx context.Context, idb bun.IDB, table string, legacy string, minor string,
+	condition string, currency string) error {
+	rows := make([]struct {
+		ID    int64   `bun:"id"`
+		Value float64 `bun:"value"`
+	}, 0)
+	query := idb.NewSelect().Table(table).ColumnExpr("id").ColumnExpr("? AS value", bun.Ident(legacy)).
+		Where("? IS NOT NULL", bun.Ident(legacy)).Where("? IS NULL", bun.Ident(minor))
+	if condition != "" {
+		query = query.Where(condition)
+	}
+	Logger.Debug("Built the query %s\n", query)
+	if err := query.Scan(ctx, &rows); err != nil {
+		return fmt.Errorf("could not read %s.%s: %w", table, legacy, err)
+	}
+	for _, row := range rows {
+		update := idb.NewUpdate().Table(table).Set("? = ?", bun.Ident(minor), money.FromMajor(row.Value, currency)).
+			Where("id = ?", row.ID)
+		if _, err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("could not set %s.%s of row %d: %w", table, minor, row.ID, err)
+		}
+	}
+	Logger.Info("Migrated %d amounts of %s.%s to %s", len(rows), table, legacy, minor)
+	return nil
+}
+
+// migrateJSONColumn rewrites the JSON array column of the rows matching the condition by the convert function
+func migrateJSONColumn(ctx context.Context, idb bun.IDB, table string, column string, condition string,
+	convert func(items []map[string]interface{})) error {
+	rows := make([]struct {
+		ID    int64  `bun:"id"`
+		Value string `bun:"value"`
+	}, 0)
+	query := idb.NewSelect().Table(table).ColumnExpr("id").ColumnExpr("? AS value", bun.Ident(column)).
+		Where("? IS NOT NULL", bun.Ident(column))
+	if condition != "" {
+		query = query.Where(condition)
+	}
+	Logger.Debug("Built the query %s\n", query)
+	if err := query.Scan(ctx, &rows); err != nil {
+		return fmt.Errorf("could not read %s.%s: %w", table, column, err)
+	}
+	for _, row := range rows {
+		items := make([]map[string]interface{}, 0)
+		if err := json.Unmarshal([]byte(row.Value), &items); err != nil || len(items) == 0 {
+			continue
+		}
+		convert(items)
+		value, err := json.Marshal(items)
+		if err != nil {
+			return fmt.Errorf("could not encode %s.%s of row %d: %w", table, column, row.ID, err)
+		}
+		update := idb.NewUpdate().Table(table).Set("? = ?", bun.Ident(column), string(value)).Where("id = ?", row.ID)
+		if _, err = update.Exec(ctx); err != nil {
+			return fmt.Errorf("could not set %s.%s of row %d: %w", table, column, row.ID, err)
+		}
+	}
+	return nil
+}
+
+// migrateShippingTiers splits the legacy tier minimum value into the weight of the weight-based methods
+// and the subtotal of the price-tiered ones and converts the tier prices
+func migrateShippingTiers(ctx context.Context, idb bun.IDB, currency string) error {
+	for _, kind := range []string{models.ShippingMethodKindWeight, models.ShippingMethodKindPriceTiers} {
+		isWeight := kind == models.ShippingMethodKindWeight
+		condition := fmt.Sprintf("kind = '%s'", kind)
+		err := migrateJSONColumn(ctx, idb, "shipping_methods", "tiers", condition, func(tiers []map[string]interface{}) {
+			for _, tier := range tiers {
+				minValue, ok := tier["minValue"].(float64)
+				if !ok {
+					// already migrated
+					continue
+				}
+				delete(tier, "minValue")
+				if isWeight {
+					tier["minWeight"] = minValue
+				} else {
+					tier["minSubtotal"] = money.FromMajor(minValue, currency)
+				}
+				convertJSONAmount(tier, "price", currency)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertJSONAmount replaces the decimal amount of the JSON object key with its minor units
+func convertJSONAmount(item map[string]interface{}, key string, currency string) {
+	if amount, ok := item[key].(float64); ok {
+		item[key] = money.FromMajor(amount, currency)
+	}
+}
@@ -0,0 +1,151 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/logger"
+	"estore-backend/server/models"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/driver/sqliteshim"
+)
+
+// newTestDB creates the tables of the models in a new database
+func newTestDB(t *testing.T, modelTables ...interface{}) *bun.DB {
+	Logger = logger.New()
+	sqldb, err := sql.Open(sqliteshim.ShimName, "file:"+filepath.Join(t.TempDir(), "test.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDB := bun.NewDB(sqldb, sqlitedialect.New())
+	t.Cleanup(func() { testDB.Close() })
+	testDB.RegisterModel((*dbModels.ProductToCategory)(nil))
+
+	for _, m := range modelTables {
+		if _, err = testDB.NewCreateTable().Model(m).Exec(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return testDB
+}
+
+// legacyMoneyDB creates the tables of the current models in a new database and adds the decimal amount columns
+// of the older versions to them
+func legacyMoneyDB(t *testing.T) *bun.DB {
+	testDB := newTestDB(t, &dbModels.Product{}, &dbModels.Order{}, &dbModels.OrderedProduct{},
+		&dbModels.OrderDiscount{}, &dbModels.OrderTaxLine{}, &dbModels.OrderReturn{}, &dbModels.Invoice{},
+		&dbModels.Payment{}, &dbModels.Refund{}, &dbModels.Promotion{}, &dbModels.ShippingMethod{},
+		&dbModels.PaymentReconciliation{})
+	ctx := context.Background()
+	legacyColumns := append(moneyColumns, struct {
+		table   string
+		columns []string
+	}{"promotions", []string{"value"}})
+	for _, legacy := range legacyColumns {
+		existing, err := tableColumns(ctx, testDB, legacy.table)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, column := range legacy.columns {
+			if existing[column] {
+				continue
+			}
+			_, err = testDB.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN ? real", bun.Ident(legacy.table), bun.Ident(column))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return testDB
+}
+
+func TestMigrateMoneyToMinorUnits(t *testing.T) {
+	testDB := legacyMoneyDB(t)
+	ctx := context.Background()
+	fixture := []string{
+		`INSERT INTO products (id, price) VALUES (1, 12.345), (2, 0.29)`,
+		`INSERT INTO promotions (id, kind, value, min_order_value) VALUES (1, '` + models.PromotionKindFixed +
+			`', 5.5, 20), (2, 'percent', 10, NULL)`,
+		`INSERT INTO refunds (id, amount, items) VALUES (1, 10.5, '[{"productId":1,"quantity":1,"amount":10.5}]')`,
+		// a refund made after the upgrade has no legacy amount
+		`INSERT INTO refunds (id, amount_minor, currency, items) VALUES
+			(2, 700, 'USD', '[{"productId":1,"quantity":1,"amount":700}]')`,
+		`INSERT INTO shipping_methods (id, kind, price, tiers) VALUES
+			(1, '` + models.ShippingMethodKindPriceTiers + `', 5, '[{"minValue":50,"price":2.5}]'),
+			(2, '` + models.ShippingMethodKindWeight + `', 4, '[{"minValue":1.5,"price":7.25}]')`,
+		`INSERT INTO payment_reconciliations (id, discrepancies) VALUES
+			(1, '[{"paymentId":1,"amount":20.25,"currency":"eur","gatewayAmount":20.5}]')`,
+	}
+	for _, query := range fixture {
+		if _, err := testDB.ExecContext(ctx, query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// a retried migration must not convert the amounts again
+	for run := 1; run <= 2; run++ {
+		if err := migrateMoneyToMinorUnits(ctx, testDB, "USD"); err != nil {
+			t.Fatalf("run %d: %s", run, err)
+		}
+	}
+
+	amounts := []struct {
+		query string
+		want  int64
+	}{
+		{"SELECT price_minor FROM products WHERE id = 1", 1234},
+		{"SELECT price_minor FROM products WHERE id = 2", 29},
+		{"SELECT amount_off_minor FROM promotions WHERE id = 1", 550},
+		{"SELECT min_order_value_minor FROM promotions WHERE id = 1", 2000},
+		{"SELECT count(*) FROM promotions WHERE id = 2 AND amount_off_minor IS NULL", 1},
+		{"SELECT amount_minor FROM refunds WHERE id = 1", 1050},
+		{"SELECT amount_minor FROM refunds WHERE id = 2", 700},
+		{"SELECT price_minor FROM shipping_methods WHERE id = 1", 500},
+		{"SELECT count(*) FROM products WHERE currency = 'USD'", 2},
+	}
+	for _, a := range amounts {
+		var got int64
+		if err := testDB.QueryRowContext(ctx, a.query).Scan(&got); err != nil {
+			t.Fatalf("%s: %s", a.query, err)
+		}
+		if got != a.want {
+			t.Errorf("%s = %d, want %d", a.query, got, a.want)
+		}
+	}
+
+	items := []struct {
+		query string
+		want  []map[string]interface{}
+	}{
+		{"SELECT items FROM refunds WHERE id = 1",
+			[]map[string]interface{}{{"productId": 1.0, "quantity": 1.0, "amount": 1050.0}}},
+		{"SELECT items FROM refunds WHERE id = 2",
+			[]map[string]interface{}{{"productId": 1.0, "quantity": 1.0, "amount": 700.0}}},
+		{"SELECT tiers FROM shipping_methods WHERE id = 1",
+			[]map[string]interface{}{{"minSubtotal": 5000.0, "price": 250.0}}},
+		{"SELECT tiers FROM shipping_methods WHERE id = 2",
+			[]map[string]interface{}{{"minWeight": 1.5, "price": 725.0}}},
+		{"SELECT discrepancies FROM payment_reconciliations WHERE id = 1",
+			[]map[string]interface{}{{"paymentId": 1.0, "amount": 2025.0, "currency": "USD", "gatewayAmount": 2050.0,
+				"gatewayCurrency": "EUR", minorUnitsMarker: true}}},
+	}
+	for _, i := range items {
+		var value string
+		if err := testDB.QueryRowContext(ctx, i.query).Scan(&value); err != nil {
+			t.Fatalf("%s: %s", i.query, err)
+		}
+		got := make([]map[string]interface{}, 0)
+		if err := json.Unmarshal([]byte(value), &got); err != nil {
+			t.Fatalf("%s: %s", i.query, err)
+		}
+		if !reflect.DeepEqual(got, i.want) {
+			t.Errorf("%s = %v, want %v", i.query, got, i.want)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package restapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/checkout"
+	"estore-backend/server/restapi/operations/guest"
+	"estore-backend/server/restapi/operations/payment"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBankTransferDeadlineDays = 7
+	// interval of cancelling the bank transfers past their deadlines in seconds
+	offlinePaymentDeadlinesInterval = 10 * 60
+)
+
+// the failure codes of the canceled offline payments
+const (
+	offlinePaymentOverdueCode        = "overdue"
+	offlinePaymentSupersededCode     = "superseded"
+	offlinePaymentOrderCancelledCode = "order_cancelled"
+)
+
+func bankTransferDeadlineDays() int64 {
+	if ApiConfiguration.Payments.BankTransfer.DeadlineDays > 0 {
+		return ApiConfiguration.Payments.BankTransfer.DeadlineDays
+	}
+	return defaultBankTransferDeadlineDays
+}
+
+func createOfflinePayment(params *checkout.AddOfflinePaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	// reject unregistered users, restrict further queries by user's ID for non-admin users
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	if params.Body.ID == 0 {
+		return nil, errors.New(400, "Order ID is required!")
+	}
+	order, err := getOrderFromDB(params.Body.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	return createOrderOfflinePayment(params.HTTPRequest.Context(), order, principal.User.ID, *params.Body.Method)
+}
+
+func createGuestOfflinePayment(params *guest.AddGuestOfflinePaymentParams) (*models.Payment, errors.Error) {
+	order, err := getGuestOrderByToken(params.ID, params.XOrderToken)
+	if err != nil {
+		return nil, err
+	}
+	return createOrderOfflinePayment(params.HTTPRequest.Context(), order, 0, *params.Body.Method)
+}
+
+// createOrderOfflinePayment records the bank transfer or the cash on delivery of the order and reserves the ordered
+// stock; the open offline payment of the same method is returned instead, and the other open payments
+// of the order are canceled. The orders paid cash on delivery are moved to processing right away.
+func createOrderOfflinePayment(ctx context.Context, order *dbModels.Order, userID int64, method string) (*models.Payment, errors.Error) {
+	switch method {
+	case models.PaymentMethodBankTransfer:
+		if ApiConfiguration.Payments.BankTransfer.IBAN == "" {
+			return nil, errors.New(400, "Bank transfers are not accepted!")
+		}
+	case models.PaymentMethodCashOnDelivery:
+		if !ApiConfiguration.Payments.CashOnDelivery.Enabled {
+			return nil, errors.New(400, "Cash on delivery is not accepted!")
+		}
+	default:
+		return nil, errors.New(400, "Payment method %s is not an offline one!", method)
+	}
+	unlock, err := lockOrderCheckout(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := checkOrderPayable(order); err != nil {
+		return nil, err
+	}
+	existing, err := findOpenOfflinePayments(ctx, db, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range existing {
+		if p.Method == method {
+			return p.ToDTO(), nil
+		}
+	}
+	// the customer pays offline, so the online checkout sessions of the order are not to be paid any more
+	if _, err = sweepOrderCheckoutSessions(ctx, order, nil); err != nil {
+		return nil, err
+	}
+
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel := &dbModels.Payment{
+		Amount:   order.TotalPrice,
+		Currency: order.Currency,
+		OrderID:  order.ID,
+		Status:   paymentStatusIntended,
+		UserID:   userID,
+		Method:   method,
+	}
+	if method == models.PaymentMethodBankTransfer {
+		reference, err := newPaymentReference(order.ID)
+		if err != nil {
+			return nil, err
+		}
+		dbModel.Reference = reference
+		dbModel.DueAt = nowUnixEpoch + bankTransferDeadlineDays()*24*60*60
+	}
+	dbModel.Instructions = offlinePaymentInstructions(dbModel)
+
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		for _, p := range existing {
+			err := cancelOfflinePayment(ctx, tx, p, offlinePaymentSupersededCode,
+				fmt.Sprintf("The order is paid by %s instead.", strings.ReplaceAll(method, "_", " ")), orderActorCustomer, userID)
+			if err != nil {
+				return err
+			}
+		}
+		if err := reserveOrderStock(ctx, tx, order, dbModel); err != nil {
+			return err
+		}
+		if _, err := createDBPayment(ctx, tx, dbModel); err != nil {
+			return err
+		}
+		if method == models.PaymentMethodCashOnDelivery {
+			// the orders paid on delivery are fulfilled before they are paid
+			return transitionOrderStatus(ctx, tx, order, models.OrderStatusProcessing, orderActorSystem, 0,
+				fmt.Sprintf("Payment %d is cash on delivery", dbModel.ID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	Logger.Info("Order %d is paid by %s with payment %d", order.ID, method, dbModel.ID)
+	return dbModel.ToDTO(), nil
+}
+
+// newPaymentReference returns a bank transfer reference of the order which cannot be guessed
+// from the other references
+func newPaymentReference(orderID int64) (string, errors.Error) {
+	random := make([]byte, 3)
+	if _, err := rand.Read(random); err != nil {
+		Logger.Error("ERROR %v: Could not generate payment reference of order %d!\n", err, orderID)
+		return "", errors.New(500, "ERROR: Could not generate payment reference of order %d!", orderID)
+	}
+	return fmt.Sprintf("ORDER-%d-%s", orderID, strings.ToUpper(hex.EncodeToString(random))), nil
+}
+
+func offlinePaymentInstructions(dbModel *dbModels.Payment) string {
+	if dbModel.Method == models.PaymentMethodCashOnDelivery {
+		return fmt.Sprintf("Please pay %s in cash to the courier on delivery.", money.New(dbModel.Amount, dbModel.Currency))
+	}
+	bank := ApiConfiguration.Payments.BankTransfer
+	account := []string{bank.AccountHolder, "IBAN " + bank.IBAN}
+	if bank.BIC != "" {
+		account = append(account, "BIC "+bank.BIC)
+	}
+	if bank.BankName != "" {
+		account = append(account, bank.BankName)
+	}
+	return fmt.Sprintf("Please transfer %s to %s with the payment reference %s by %s. "+
+		"The order is cancelled if the payment is not received by then.", money.New(dbModel.Amount, dbModel.Currency),
+		strings.Join(account, ", "), dbModel.Reference, time.Unix(dbModel.DueAt, 0).UTC().Format("2006-01-02"))
+}
+
+// findOpenOfflinePayments finds the offline payments of the order which have not been received yet
+func findOpenOfflinePayments(ctx context.Context, idb bun.IDB, orderID int64) ([]*dbModels.Payment, errors.Error) {
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := idb.NewSelect().Model(&dbPayments).
+		Where("order_id = ?", orderID).
+		Where("method IN (?)", bun.In([]string{models.PaymentMethodBankTransfer, models.PaymentMethodCashOnDelivery})).
+		Where("status = ?", paymentStatusIntended).
+		Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d offline payments!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d payments!", orderID)
+	}
+	return dbPayments, nil
+}
+
+// cancelOfflinePayment cancels the offline payment which has not been received, releases the stock reserved
+// for it and records the change to the payment history
+func cancelOfflinePayment(ctx context.Context, idb bun.IDB, dbModel *dbModels.Payment, code string, message string,
+	actorRole string, actorID int64) errors.Error {
+	changed := *dbModel
+	changed.Status = paymentStatusCanceled
+	changes := paymentChanges(dbModel, &changed)
+
+	if err := releasePaymentStock(ctx, idb, dbModel); err != nil {
+		return err
+	}
+	dbModel.Status = paymentStatusCanceled
+	dbModel.FailureCode = code
+	dbModel.FailureMessage = message
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	query := idb.NewUpdate().Model(dbModel).
+		Column("status", "failure_code", "failure_message", "date_updated").
+		Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not cancel payment %d!\n", sqlErr, dbModel.ID)
+		return errors.New(500, "ERROR: Could not update payment %d!", dbModel.ID)
+	}
+	Logger.Info("Canceled %s payment %d of order %d (%s) by %s", dbModel.Method, dbModel.ID, dbModel.OrderID, code,
+		actorRole)
+	return addPaymentHistory(ctx, idb, dbModel.ID, paymentActionUpdated, changes, actorID)
+}
+
+// cancelOrderOfflinePayments cancels the offline payments of the order which have not been received
+func cancelOrderOfflinePayments(ctx context.Context, idb bun.IDB, orderID int64, code string, message string,
+	actorRole string, actorID int64) errors.Error {
+	dbPayments, err := findOpenOfflinePayments(ctx, idb, orderID)
+	if err != nil {
+		return err
+	}
+	for _, dbModel := range dbPayments {
+		if err = cancelOfflinePayment(ctx, idb, dbModel, code, message, actorRole, actorID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// confirmOfflinePayment completes the offline payment; the stock reserved for it has been sold, and its order
+// is paid or, if it has been fulfilled before the payment, invoiced
+func confirmOfflinePayment(ctx context.Context, idb bun.IDB, dbModel *dbModels.Payment, actorRole string,
+	actorID int64) errors.Error {
+	changed := *dbModel
+	changed.Status = paymentStatusComplete
+	changes := paymentChanges(dbModel, &changed)
+
+	dbModel.Status = paymentStatusComplete
+	dbModel.ReservedStock = nil
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	query := idb.NewUpdate().Model(dbModel).
+		Column("status", "reserved_stock", "date_updated").
+		Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not complete payment %d!\n", sqlErr, dbModel.ID)
+		return errors.New(500, "ERROR: Could not update payment %d!", dbModel.ID)
+	}
+	err := addPaymentHistory(ctx, idb, dbModel.ID, paymentActionUpdated, changes, actorID)
+	if err != nil {
+		return err
+	}
+	return syncOrderWithPayment(ctx, idb, &dbModels.Order{ID: dbModel.OrderID}, dbModel, actorRole, actorID,
+		fmt.Sprintf("Payment %d (%s) has been received", dbModel.ID, dbModel.Method))
+}
+
+// collectCashOnDelivery completes the cash on delivery payments of the delivered order
+func collectCashOnDelivery(ctx context.Context, idb bun.IDB, orderID int64, actorRole string, actorID int64) errors.Error {
+	dbPayments, err := findOpenOfflinePayments(ctx, idb, orderID)
+	if err != nil {
+		return err
+	}
+	for _, dbModel := range dbPayments {
+		if dbModel.Method != models.PaymentMethodCashOnDelivery {
+			continue
+		}
+		if err = confirmOfflinePayment(ctx, idb, dbModel, actorRole, actorID); err != nil {
+			return err
+		}
+		Logger.Info("Collected cash on delivery payment %d of order %d", dbModel.ID, orderID)
+	}
+	return nil
+}
+
+func receivePayment(params *payment.ReceivePaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	return confirmPayment(params.HTTPRequest.Context(), params.ID, models.PaymentMethodBankTransfer, principal)
+}
+
+func collectPayment(params *payment.CollectPaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	return confirmPayment(params.HTTPRequest.Context(), params.ID, models.PaymentMethodCashOnDelivery, principal)
+}
+
+// confirmPayment completes the open offline payment of the given method on behalf of the admin
+func confirmPayment(ctx context.Context, id int64, method string, principal *models.Principal) (*models.Payment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	var result *models.Payment
+	err = runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel, err := getDBPayment(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if dbModel.Method != method {
+			return errors.New(409, "Payment %d is not %s!", dbModel.ID, strings.ReplaceAll(method, "_", " "))
+		}
+		if dbModel.Status != paymentStatusIntended {
+			return errors.New(409, "Payment %d in status '%s' cannot be confirmed!", dbModel.ID, dbModel.Status)
+		}
+		err = confirmOfflinePayment(ctx, tx, dbModel, orderActorAdmin, principal.User.ID)
+		if err != nil {
+			return err
+		}
+		result = dbModel.ToDTO()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// startOfflinePaymentDeadlines cancels the bank transfers which have not been received by their deadlines
+// along with their orders
+func startOfflinePaymentDeadlines() {
+	go func() {
+		ticker := time.NewTicker(offlinePaymentDeadlinesInterval * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			cancelOverdueOfflinePayments(context.Background())
+		}
+	}()
+}
+
+func cancelOverdueOfflinePayments(ctx context.Context) {
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := db.NewSelect().Model(&dbPayments).
+		Where("method = ?", models.PaymentMethodBankTransfer).
+		Where("status = ?", paymentStatusIntended).
+		Where("due_at > 0").
+		Where("due_at < ?", time.Now().In(time.UTC).Unix()).
+		Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find overdue offline payments!\n", sqlErr)
+		return
+	}
+	for _, dbModel := range dbPayments {
+		if err := cancelOverdueOfflinePayment(ctx, dbModel); err != nil {
+			Logger.Error("Could not cancel overdue payment %d: %s", dbModel.ID, err.Error())
+		}
+	}
+}
+
+// cancelOverdueOfflinePayment cancels the overdue payment and its order unless the order has been paid otherwise
+func cancelOverdueOfflinePayment(ctx context.Context, dbModel *dbModels.Payment) errors.Error {
+	unlock, err := lockOrderCheckout(ctx, dbModel.OrderID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		current, err := getDBPayment(ctx, tx, dbModel.ID)
+		if err != nil {
+			return err
+		}
+		if current.Status != paymentStatusIntended {
+			return nil
+		}
+		deadline := time.Unix(current.DueAt, 0).UTC().Format("2006-01-02")
+		err = cancelOfflinePayment(ctx, tx, current, offlinePaymentOverdueCode,
+			fmt.Sprintf("The bank transfer has not been received by %s.", deadline), orderActorSystem, 0)
+		if err != nil {
+			return err
+		}
+		order := &dbModels.Order{ID: current.OrderID}
+		query := tx.NewSelect().Model(order).Column("status").Where("id = ?", order.ID)
+		Logger.Debug("Built the query %s\n", query)
+		if sqlErr := query.Scan(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, order.ID)
+			return errors.New(500, "ERROR: Could not find order %d!", order.ID)
+		}
+		open, err := countOpenOrderPayments(ctx, tx, order.ID)
+		if err != nil {
+			return err
+		}
+		if order.Status != models.OrderStatusPendingPayment || open > 0 {
+			return nil
+		}
+		return transitionOrderStatus(ctx, tx, order, models.OrderStatusCancelled, orderActorSystem, 0,
+			fmt.Sprintf("Payment %d (bank transfer) has not been received by %s", current.ID, deadline))
+	})
+}
@@ -0,0 +1,283 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/payment"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/errors"
+)
+
+// configureOfflinePayments accepts the bank transfers and the cash on delivery for the test
+func configureOfflinePayments(t *testing.T, bankTransfer bool, cashOnDelivery bool) {
+	configured := ApiConfiguration.Payments
+	t.Cleanup(func() { ApiConfiguration.Payments = configured })
+	ApiConfiguration.Payments.BankTransfer.IBAN = ""
+	if bankTransfer {
+		ApiConfiguration.Payments.BankTransfer.AccountHolder = "E-Store"
+		ApiConfiguration.Payments.BankTransfer.IBAN = "DE89370400440532013000"
+	}
+	ApiConfiguration.Payments.CashOnDelivery.Enabled = cashOnDelivery
+}
+
+func TestCreateOrderOfflinePayment(t *testing.T) {
+	tests := []struct {
+		name           string
+		bankTransfer   bool
+		cashOnDelivery bool
+		orderStatus    string
+		// methods the order is paid by one after another; the last one is checked
+		methods         []string
+		wantCode        int32
+		wantPayments    int
+		wantSuperseded  int
+		wantOrderStatus string
+		wantStock       int64
+	}{
+		{"bank transfer", true, true, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodBankTransfer}, 0, 1, 0, models.OrderStatusPendingPayment, 3},
+		{"repeated bank transfer", true, true, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodBankTransfer, models.PaymentMethodBankTransfer}, 0, 1, 0,
+			models.OrderStatusPendingPayment, 3},
+		{"cash on delivery", true, true, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodCashOnDelivery}, 0, 1, 0, models.OrderStatusProcessing, 3},
+		{"cash on delivery instead of a bank transfer", true, true, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodBankTransfer, models.PaymentMethodCashOnDelivery}, 0, 2, 1,
+			models.OrderStatusProcessing, 3},
+		{"bank transfers not accepted", false, true, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodBankTransfer}, 400, 0, 0, models.OrderStatusPendingPayment, 5},
+		{"cash on delivery not accepted", true, false, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodCashOnDelivery}, 400, 0, 0, models.OrderStatusPendingPayment, 5},
+		{"online", true, true, models.OrderStatusPendingPayment,
+			[]string{models.PaymentMethodOnline}, 400, 0, 0, models.OrderStatusPendingPayment, 5},
+		{"paid order", true, true, models.OrderStatusPaid,
+			[]string{models.PaymentMethodBankTransfer}, 409, 0, 0, models.OrderStatusPaid, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			configureOfflinePayments(t, tt.bankTransfer, tt.cashOnDelivery)
+			ctx := context.Background()
+			product := addTestProduct(t, 1000, 5)
+			added := addTestOrder(t, tt.orderStatus, 2, product)
+
+			var result *models.Payment
+			var err error
+			for _, method := range tt.methods {
+				order, dbErr := getOrderFromDB(added.ID, true, -1)
+				if dbErr != nil {
+					t.Fatal(dbErr)
+				}
+				created, createErr := createOrderOfflinePayment(ctx, order, order.UserID, method)
+				if createErr != nil {
+					err = createErr
+					if tt.wantCode == 0 || createErr.Code() != tt.wantCode {
+						t.Fatalf("createOrderOfflinePayment(%s) = %v, want %d", method, createErr, tt.wantCode)
+					}
+				}
+				if result != nil && created != nil && method == result.Method && created.ID != result.ID {
+					t.Errorf("repeated %s payment %d, want %d returned", method, created.ID, result.ID)
+				}
+				result = created
+			}
+			if tt.wantCode != 0 && err == nil {
+				t.Errorf("createOrderOfflinePayment() succeeded, want %d", tt.wantCode)
+			}
+
+			if n := countTestRows(t, "payments", "order_id = ?", added.ID); n != tt.wantPayments {
+				t.Errorf("payments = %d, want %d", n, tt.wantPayments)
+			}
+			if n := countTestRows(t, "payments", "order_id = ? AND failure_code = ?", added.ID,
+				offlinePaymentSupersededCode); n != tt.wantSuperseded {
+				t.Errorf("superseded payments = %d, want %d", n, tt.wantSuperseded)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", added.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+			if n := countTestRows(t, "products", "id = ? AND number_in_stock = ?", product.ID, tt.wantStock); n != 1 {
+				t.Errorf("stock is not %d", tt.wantStock)
+			}
+			if result != nil && result.Method == models.PaymentMethodBankTransfer {
+				if !regexp.MustCompile(`^ORDER-\d+-[0-9A-F]{6}$`).MatchString(result.Reference) {
+					t.Errorf("payment reference = %q", result.Reference)
+				}
+				due := time.Now().Unix() + defaultBankTransferDeadlineDays*24*60*60
+				if result.DueAt < due-60 || result.DueAt > due {
+					t.Errorf("payment due at %d, want %d", result.DueAt, due)
+				}
+			}
+		})
+	}
+}
+
+func TestConfirmPayment(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		status    string
+		principal *models.Principal
+		// bank transfers are received, cash on delivery collected
+		collect         bool
+		wantCode        int32
+		wantStatus      string
+		wantOrderStatus string
+	}{
+		{"received bank transfer", models.PaymentMethodBankTransfer, paymentStatusIntended, testAdmin(), false, 0,
+			paymentStatusComplete, models.OrderStatusPaid},
+		{"collected cash on delivery", models.PaymentMethodCashOnDelivery, paymentStatusIntended, testAdmin(), true,
+			0, paymentStatusComplete, models.OrderStatusPaid},
+		{"bank transfer collected", models.PaymentMethodBankTransfer, paymentStatusIntended, testAdmin(), true, 409,
+			paymentStatusIntended, models.OrderStatusPendingPayment},
+		{"received twice", models.PaymentMethodBankTransfer, paymentStatusComplete, testAdmin(), false, 409,
+			paymentStatusComplete, models.OrderStatusPendingPayment},
+		{"canceled", models.PaymentMethodBankTransfer, paymentStatusCanceled, testAdmin(), false, 409,
+			paymentStatusCanceled, models.OrderStatusPendingPayment},
+		{"by the customer", models.PaymentMethodBankTransfer, paymentStatusIntended, testCustomer(1), false, 403,
+			paymentStatusIntended, models.OrderStatusPendingPayment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			ctx := context.Background()
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 1, addTestProduct(t, 1000, 5))
+			dbModel := addTestOfflinePayment(t, order, tt.method, tt.status)
+
+			var err errors.Error
+			if tt.collect {
+				_, err = collectPayment(&payment.CollectPaymentParams{HTTPRequest: testRequest(), ID: dbModel.ID},
+					tt.principal)
+			} else {
+				_, err = receivePayment(&payment.ReceivePaymentParams{HTTPRequest: testRequest(), ID: dbModel.ID},
+					tt.principal)
+			}
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Errorf("confirming the payment = %v, want %d", err, tt.wantCode)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			updated, dbErr := getDBPayment(ctx, db, dbModel.ID)
+			if dbErr != nil {
+				t.Fatal(dbErr)
+			}
+			if updated.Status != tt.wantStatus {
+				t.Errorf("payment = %s, want %s", updated.Status, tt.wantStatus)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+		})
+	}
+}
+
+func TestCollectCashOnDelivery(t *testing.T) {
+	newTestStore(t)
+	configureOfflinePayments(t, false, true)
+	ctx := context.Background()
+	product := addTestProduct(t, 1000, 5)
+	added := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+	order, err := getOrderFromDB(added.ID, true, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := createOrderOfflinePayment(ctx, order, order.UserID, models.PaymentMethodCashOnDelivery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := countTestRows(t, "invoices", "order_id = ?", order.ID); n != 0 {
+		t.Errorf("invoices of the unpaid order = %d, want none", n)
+	}
+
+	for _, status := range []string{models.OrderStatusShipped, models.OrderStatusDelivered} {
+		order, err = getOrderFromDB(added.ID, true, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = transitionOrderStatus(ctx, db, order, status, orderActorAdmin, 1, "Test"); err != nil {
+			t.Fatalf("transitionOrderStatus(%s) = %v", status, err)
+		}
+	}
+
+	collected, err := getDBPayment(ctx, db, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if collected.Status != paymentStatusComplete || len(collected.ReservedStock) != 0 {
+		t.Errorf("payment = %s reserving %d products, want %s", collected.Status, len(collected.ReservedStock),
+			paymentStatusComplete)
+	}
+	if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, models.OrderStatusDelivered); n != 1 {
+		t.Error("order is not delivered")
+	}
+	if n := countTestRows(t, "invoices", "order_id = ? AND kind = ?", order.ID, models.InvoiceKindInvoice); n != 1 {
+		t.Errorf("invoices = %d, want 1", n)
+	}
+	if n := countTestRows(t, "products", "id = ? AND number_in_stock = 4", product.ID); n != 1 {
+		t.Error("stock of the collected payment is not sold")
+	}
+}
+
+func TestCancelOverdueOfflinePayments(t *testing.T) {
+	now := time.Now().Unix()
+	tests := []struct {
+		name   string
+		dueAt  int64
+		status string
+		// the order has an open online payment as well
+		paidOnline      bool
+		wantStatus      string
+		wantOrderStatus string
+		wantStock       int64
+	}{
+		{"overdue", now - 60, paymentStatusIntended, false, paymentStatusCanceled, models.OrderStatusCancelled, 5},
+		{"not due yet", now + 60, paymentStatusIntended, false, paymentStatusIntended,
+			models.OrderStatusPendingPayment, 4},
+		{"overdue with an online payment", now - 60, paymentStatusIntended, true, paymentStatusCanceled,
+			models.OrderStatusPendingPayment, 5},
+		{"received", now - 60, paymentStatusComplete, false, paymentStatusComplete,
+			models.OrderStatusPendingPayment, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			ctx := context.Background()
+			product := addTestProduct(t, 1000, 4)
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+			dbModel := addTestOfflinePayment(t, order, models.PaymentMethodBankTransfer, tt.status)
+			dbModel.DueAt = tt.dueAt
+			dbModel.ReservedStock = []*dbModels.StockReservation{{ProductID: product.ID, Quantity: 1}}
+			if _, err := db.NewUpdate().Model(dbModel).Column("due_at", "reserved_stock").
+				Where("id = ?", dbModel.ID).Exec(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if tt.paidOnline {
+				addTestPayment(t, order, paymentStatusIntended)
+			}
+
+			cancelOverdueOfflinePayments(ctx)
+
+			updated, err := getDBPayment(ctx, db, dbModel.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.Status != tt.wantStatus {
+				t.Errorf("payment = %s, want %s", updated.Status, tt.wantStatus)
+			}
+			if tt.wantStatus == paymentStatusCanceled && updated.FailureCode != offlinePaymentOverdueCode {
+				t.Errorf("payment failure code = %q, want %q", updated.FailureCode, offlinePaymentOverdueCode)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+			if n := countTestRows(t, "products", "id = ? AND number_in_stock = ?", product.ID, tt.wantStock); n != 1 {
+				t.Errorf("stock is not %d", tt.wantStock)
+			}
+		})
+	}
+}
@@ -34,7 +34,7 @@ func NewEditCategory(ctx *middleware.Context, handler EditCategoryHandler) *Edit
 /*
 	EditCategory swagger:route PUT /categories/{id} category editCategory
 
-Edit category by ID
+Replace category by ID
 */
 type EditCategory struct {
 	Context *middleware.Context
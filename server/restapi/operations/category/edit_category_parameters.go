@@ -6,6 +6,7 @@ package category
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -36,6 +37,8 @@ type EditCategoryParams struct {
 	HTTPRequest *http.Request `json:"-"`
 
 	/*
+	  Full representation of the resource; every writable property must be given
+	  Required: true
 	  In: body
 	*/
 	Body *models.Category
@@ -44,6 +47,12 @@ type EditCategoryParams struct {
 	  In: path
 	*/
 	ID int64
+	/*
+	  ETag of the version being replaced
+	  Required: true
+	  In: header
+	*/
+	IfMatch string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -59,7 +68,11 @@ func (o *EditCategoryParams) BindRequest(r *http.Request, route *middleware.Matc
 		defer r.Body.Close()
 		var body models.Category
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
-			res = append(res, errors.NewParseError("body", "body", "", err))
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
 		} else {
 			// validate body object
 			if err := body.Validate(route.Formats); err != nil {
@@ -75,12 +88,18 @@ func (o *EditCategoryParams) BindRequest(r *http.Request, route *middleware.Matc
 				o.Body = &body
 			}
 		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
 	}
 
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -105,3 +124,23 @@ func (o *EditCategoryParams) bindID(rawData []string, hasKey bool, formats strfm
 
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *EditCategoryParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("If-Match", "header", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("If-Match", "header", raw); err != nil {
+		return err
+	}
+	o.IfMatch = raw
+
+	return nil
+}
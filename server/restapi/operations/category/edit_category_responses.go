@@ -23,6 +23,11 @@ swagger:response editCategoryOK
 */
 type EditCategoryOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewEditCategoryOK() *EditCategoryOK {
 	return &EditCategoryOK{}
 }
 
+// WithETag adds the eTag to the edit category o k response
+func (o *EditCategoryOK) WithETag(eTag string) *EditCategoryOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the edit category o k response
+func (o *EditCategoryOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the edit category o k response
 func (o *EditCategoryOK) WithPayload(payload *models.Category) *EditCategoryOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *EditCategoryOK) SetPayload(payload *models.Category) {
 // WriteResponse to the client
 func (o *EditCategoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,51 @@ func (o *EditCategoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.
 	}
 }
 
+// EditCategoryPreconditionFailedCode is the HTTP code returned for type EditCategoryPreconditionFailed
+const EditCategoryPreconditionFailedCode int = 412
+
+/*
+EditCategoryPreconditionFailed The resource has been modified since the given ETag
+
+swagger:response editCategoryPreconditionFailed
+*/
+type EditCategoryPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEditCategoryPreconditionFailed creates EditCategoryPreconditionFailed with default headers values
+func NewEditCategoryPreconditionFailed() *EditCategoryPreconditionFailed {
+
+	return &EditCategoryPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the edit category precondition failed response
+func (o *EditCategoryPreconditionFailed) WithPayload(payload *models.Error) *EditCategoryPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the edit category precondition failed response
+func (o *EditCategoryPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EditCategoryPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*
 EditCategoryDefault Error
 
@@ -23,6 +23,11 @@ swagger:response getCategoryOK
 */
 type GetCategoryOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewGetCategoryOK() *GetCategoryOK {
 	return &GetCategoryOK{}
 }
 
+// WithETag adds the eTag to the get category o k response
+func (o *GetCategoryOK) WithETag(eTag string) *GetCategoryOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get category o k response
+func (o *GetCategoryOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get category o k response
 func (o *GetCategoryOK) WithPayload(payload *models.Category) *GetCategoryOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *GetCategoryOK) SetPayload(payload *models.Category) {
 // WriteResponse to the client
 func (o *GetCategoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,54 @@ func (o *GetCategoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// GetCategoryNotModifiedCode is the HTTP code returned for type GetCategoryNotModified
+const GetCategoryNotModifiedCode int = 304
+
+/*
+GetCategoryNotModified Not modified
+
+swagger:response getCategoryNotModified
+*/
+type GetCategoryNotModified struct {
+
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewGetCategoryNotModified creates GetCategoryNotModified with default headers values
+func NewGetCategoryNotModified() *GetCategoryNotModified {
+
+	return &GetCategoryNotModified{}
+}
+
+// WithETag adds the eTag to the get category not modified response
+func (o *GetCategoryNotModified) WithETag(eTag string) *GetCategoryNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get category not modified response
+func (o *GetCategoryNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *GetCategoryNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 /*
 GetCategoryDefault Error
 
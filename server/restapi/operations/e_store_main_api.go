@@ -20,16 +20,36 @@ import (
 	"github.com/go-openapi/swag"
 
 	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/address"
+	"estore-backend/server/restapi/operations/addresses"
 	"estore-backend/server/restapi/operations/auth"
+	"estore-backend/server/restapi/operations/cart"
 	"estore-backend/server/restapi/operations/categories"
 	"estore-backend/server/restapi/operations/category"
 	"estore-backend/server/restapi/operations/checkout"
+	"estore-backend/server/restapi/operations/currencies"
+	"estore-backend/server/restapi/operations/guest"
+	"estore-backend/server/restapi/operations/invoice"
+	"estore-backend/server/restapi/operations/invoices"
+	"estore-backend/server/restapi/operations/message"
+	"estore-backend/server/restapi/operations/messages"
 	"estore-backend/server/restapi/operations/order"
 	"estore-backend/server/restapi/operations/orders"
 	"estore-backend/server/restapi/operations/payment"
 	"estore-backend/server/restapi/operations/payments"
 	"estore-backend/server/restapi/operations/product"
 	"estore-backend/server/restapi/operations/products"
+	"estore-backend/server/restapi/operations/promotion"
+	"estore-backend/server/restapi/operations/promotions"
+	"estore-backend/server/restapi/operations/reconciliations"
+	"estore-backend/server/restapi/operations/refunds"
+	"estore-backend/server/restapi/operations/reports"
+	"estore-backend/server/restapi/operations/returns"
+	"estore-backend/server/restapi/operations/shipment"
+	"estore-backend/server/restapi/operations/shipments"
+	"estore-backend/server/restapi/operations/shipping"
+	"estore-backend/server/restapi/operations/tax"
+	"estore-backend/server/restapi/operations/taxes"
 	"estore-backend/server/restapi/operations/user"
 	"estore-backend/server/restapi/operations/users"
 	"estore-backend/server/restapi/operations/webhooks"
@@ -55,14 +75,35 @@ func NewEStoreMainAPI(spec *loads.Document) *EStoreMainAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer: runtime.ByteStreamProducer(),
+
+		CSVProducer: runtime.CSVProducer(),
+
+		HTMLProducer: runtime.TextProducer(),
+
 		JSONProducer: runtime.JSONProducer(),
 
+		AddressesAddAddressHandler: addresses.AddAddressHandlerFunc(func(params addresses.AddAddressParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation addresses.AddAddress has not yet been implemented")
+		}),
 		CategoriesAddCategoryHandler: categories.AddCategoryHandlerFunc(func(params categories.AddCategoryParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation categories.AddCategory has not yet been implemented")
 		}),
 		CheckoutAddCheckoutSessionHandler: checkout.AddCheckoutSessionHandlerFunc(func(params checkout.AddCheckoutSessionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation checkout.AddCheckoutSession has not yet been implemented")
 		}),
+		GuestAddGuestCheckoutSessionHandler: guest.AddGuestCheckoutSessionHandlerFunc(func(params guest.AddGuestCheckoutSessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation guest.AddGuestCheckoutSession has not yet been implemented")
+		}),
+		GuestAddGuestOfflinePaymentHandler: guest.AddGuestOfflinePaymentHandlerFunc(func(params guest.AddGuestOfflinePaymentParams) middleware.Responder {
+			return middleware.NotImplemented("operation guest.AddGuestOfflinePayment has not yet been implemented")
+		}),
+		GuestAddGuestOrderHandler: guest.AddGuestOrderHandlerFunc(func(params guest.AddGuestOrderParams) middleware.Responder {
+			return middleware.NotImplemented("operation guest.AddGuestOrder has not yet been implemented")
+		}),
+		CheckoutAddOfflinePaymentHandler: checkout.AddOfflinePaymentHandlerFunc(func(params checkout.AddOfflinePaymentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation checkout.AddOfflinePayment has not yet been implemented")
+		}),
 		OrdersAddOrderHandler: orders.AddOrderHandlerFunc(func(params orders.AddOrderParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation orders.AddOrder has not yet been implemented")
 		}),
@@ -72,12 +113,60 @@ func NewEStoreMainAPI(spec *loads.Document) *EStoreMainAPI {
 		ProductsAddProductHandler: products.AddProductHandlerFunc(func(params products.AddProductParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation products.AddProduct has not yet been implemented")
 		}),
+		PromotionsAddPromotionHandler: promotions.AddPromotionHandlerFunc(func(params promotions.AddPromotionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation promotions.AddPromotion has not yet been implemented")
+		}),
+		ShipmentsAddShipmentHandler: shipments.AddShipmentHandlerFunc(func(params shipments.AddShipmentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipments.AddShipment has not yet been implemented")
+		}),
+		ShippingAddShippingMethodHandler: shipping.AddShippingMethodHandlerFunc(func(params shipping.AddShippingMethodParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.AddShippingMethod has not yet been implemented")
+		}),
+		ShippingAddShippingZoneHandler: shipping.AddShippingZoneHandlerFunc(func(params shipping.AddShippingZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.AddShippingZone has not yet been implemented")
+		}),
+		TaxesAddTaxZoneHandler: taxes.AddTaxZoneHandlerFunc(func(params taxes.AddTaxZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation taxes.AddTaxZone has not yet been implemented")
+		}),
 		UsersAddUserHandler: users.AddUserHandlerFunc(func(params users.AddUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation users.AddUser has not yet been implemented")
 		}),
+		OrderChangeOrderStatusHandler: order.ChangeOrderStatusHandlerFunc(func(params order.ChangeOrderStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation order.ChangeOrderStatus has not yet been implemented")
+		}),
+		ShipmentChangeShipmentStatusHandler: shipment.ChangeShipmentStatusHandlerFunc(func(params shipment.ChangeShipmentStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipment.ChangeShipmentStatus has not yet been implemented")
+		}),
+		CartCheckoutCartHandler: cart.CheckoutCartHandlerFunc(func(params cart.CheckoutCartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cart.CheckoutCart has not yet been implemented")
+		}),
+		GuestClaimGuestOrderHandler: guest.ClaimGuestOrderHandlerFunc(func(params guest.ClaimGuestOrderParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation guest.ClaimGuestOrder has not yet been implemented")
+		}),
+		CartClearCartHandler: cart.ClearCartHandlerFunc(func(params cart.ClearCartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cart.ClearCart has not yet been implemented")
+		}),
+		PaymentCollectPaymentHandler: payment.CollectPaymentHandlerFunc(func(params payment.CollectPaymentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation payment.CollectPayment has not yet been implemented")
+		}),
+		PaymentsCompleteFakePaymentHandler: payments.CompleteFakePaymentHandlerFunc(func(params payments.CompleteFakePaymentParams) middleware.Responder {
+			return middleware.NotImplemented("operation payments.CompleteFakePayment has not yet been implemented")
+		}),
+		RefundsCreateRefundHandler: refunds.CreateRefundHandlerFunc(func(params refunds.CreateRefundParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation refunds.CreateRefund has not yet been implemented")
+		}),
+		ReturnsDecideReturnHandler: returns.DecideReturnHandlerFunc(func(params returns.DecideReturnParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.DecideReturn has not yet been implemented")
+		}),
+		AddressDeleteAddressHandler: address.DeleteAddressHandlerFunc(func(params address.DeleteAddressParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation address.DeleteAddress has not yet been implemented")
+		}),
 		CategoryDeleteCategoryHandler: category.DeleteCategoryHandlerFunc(func(params category.DeleteCategoryParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation category.DeleteCategory has not yet been implemented")
 		}),
+		CurrenciesDeleteExchangeRateHandler: currencies.DeleteExchangeRateHandlerFunc(func(params currencies.DeleteExchangeRateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation currencies.DeleteExchangeRate has not yet been implemented")
+		}),
 		OrderDeleteOrderHandler: order.DeleteOrderHandlerFunc(func(params order.DeleteOrderParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation order.DeleteOrder has not yet been implemented")
 		}),
@@ -87,9 +176,24 @@ func NewEStoreMainAPI(spec *loads.Document) *EStoreMainAPI {
 		ProductDeleteProductHandler: product.DeleteProductHandlerFunc(func(params product.DeleteProductParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation product.DeleteProduct has not yet been implemented")
 		}),
+		PromotionDeletePromotionHandler: promotion.DeletePromotionHandlerFunc(func(params promotion.DeletePromotionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation promotion.DeletePromotion has not yet been implemented")
+		}),
+		ShippingDeleteShippingMethodHandler: shipping.DeleteShippingMethodHandlerFunc(func(params shipping.DeleteShippingMethodParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.DeleteShippingMethod has not yet been implemented")
+		}),
+		ShippingDeleteShippingZoneHandler: shipping.DeleteShippingZoneHandlerFunc(func(params shipping.DeleteShippingZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.DeleteShippingZone has not yet been implemented")
+		}),
+		TaxDeleteTaxZoneHandler: tax.DeleteTaxZoneHandlerFunc(func(params tax.DeleteTaxZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tax.DeleteTaxZone has not yet been implemented")
+		}),
 		UserDeleteUserHandler: user.DeleteUserHandlerFunc(func(params user.DeleteUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.DeleteUser has not yet been implemented")
 		}),
+		AddressEditAddressHandler: address.EditAddressHandlerFunc(func(params address.EditAddressParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation address.EditAddress has not yet been implemented")
+		}),
 		CategoryEditCategoryHandler: category.EditCategoryHandlerFunc(func(params category.EditCategoryParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation category.EditCategory has not yet been implemented")
 		}),
@@ -102,54 +206,222 @@ func NewEStoreMainAPI(spec *loads.Document) *EStoreMainAPI {
 		ProductEditProductHandler: product.EditProductHandlerFunc(func(params product.EditProductParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation product.EditProduct has not yet been implemented")
 		}),
+		PromotionEditPromotionHandler: promotion.EditPromotionHandlerFunc(func(params promotion.EditPromotionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation promotion.EditPromotion has not yet been implemented")
+		}),
+		ShippingEditShippingMethodHandler: shipping.EditShippingMethodHandlerFunc(func(params shipping.EditShippingMethodParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.EditShippingMethod has not yet been implemented")
+		}),
+		ShippingEditShippingZoneHandler: shipping.EditShippingZoneHandlerFunc(func(params shipping.EditShippingZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.EditShippingZone has not yet been implemented")
+		}),
+		TaxEditTaxZoneHandler: tax.EditTaxZoneHandlerFunc(func(params tax.EditTaxZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tax.EditTaxZone has not yet been implemented")
+		}),
 		UserEditUserHandler: user.EditUserHandlerFunc(func(params user.EditUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.EditUser has not yet been implemented")
 		}),
+		OrdersExportOrdersHandler: orders.ExportOrdersHandlerFunc(func(params orders.ExportOrdersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation orders.ExportOrders has not yet been implemented")
+		}),
 		AuthGetAccessTokenHandler: auth.GetAccessTokenHandlerFunc(func(params auth.GetAccessTokenParams) middleware.Responder {
 			return middleware.NotImplemented("operation auth.GetAccessToken has not yet been implemented")
 		}),
+		AddressGetAddressHandler: address.GetAddressHandlerFunc(func(params address.GetAddressParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation address.GetAddress has not yet been implemented")
+		}),
+		CartGetCartHandler: cart.GetCartHandlerFunc(func(params cart.GetCartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cart.GetCart has not yet been implemented")
+		}),
 		CategoryGetCategoryHandler: category.GetCategoryHandlerFunc(func(params category.GetCategoryParams) middleware.Responder {
 			return middleware.NotImplemented("operation category.GetCategory has not yet been implemented")
 		}),
 		CheckoutGetCheckoutSessionHandler: checkout.GetCheckoutSessionHandlerFunc(func(params checkout.GetCheckoutSessionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation checkout.GetCheckoutSession has not yet been implemented")
 		}),
+		CurrenciesGetExchangeRateHandler: currencies.GetExchangeRateHandlerFunc(func(params currencies.GetExchangeRateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation currencies.GetExchangeRate has not yet been implemented")
+		}),
+		PaymentsGetFakePaymentPageHandler: payments.GetFakePaymentPageHandlerFunc(func(params payments.GetFakePaymentPageParams) middleware.Responder {
+			return middleware.NotImplemented("operation payments.GetFakePaymentPage has not yet been implemented")
+		}),
+		GuestGetGuestOrderHandler: guest.GetGuestOrderHandlerFunc(func(params guest.GetGuestOrderParams) middleware.Responder {
+			return middleware.NotImplemented("operation guest.GetGuestOrder has not yet been implemented")
+		}),
+		InvoiceGetInvoiceHandler: invoice.GetInvoiceHandlerFunc(func(params invoice.GetInvoiceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation invoice.GetInvoice has not yet been implemented")
+		}),
 		OrderGetOrderHandler: order.GetOrderHandlerFunc(func(params order.GetOrderParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation order.GetOrder has not yet been implemented")
 		}),
+		MessageGetOrderMessageAttachmentHandler: message.GetOrderMessageAttachmentHandlerFunc(func(params message.GetOrderMessageAttachmentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation message.GetOrderMessageAttachment has not yet been implemented")
+		}),
 		UserGetOwnUserHandler: user.GetOwnUserHandlerFunc(func(params user.GetOwnUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.GetOwnUser has not yet been implemented")
 		}),
 		PaymentGetPaymentHandler: payment.GetPaymentHandlerFunc(func(params payment.GetPaymentParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation payment.GetPayment has not yet been implemented")
 		}),
+		ReconciliationsGetPaymentReconciliationHandler: reconciliations.GetPaymentReconciliationHandlerFunc(func(params reconciliations.GetPaymentReconciliationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation reconciliations.GetPaymentReconciliation has not yet been implemented")
+		}),
 		ProductGetProductHandler: product.GetProductHandlerFunc(func(params product.GetProductParams) middleware.Responder {
 			return middleware.NotImplemented("operation product.GetProduct has not yet been implemented")
 		}),
 		ProductsGetProductsHandler: products.GetProductsHandlerFunc(func(params products.GetProductsParams) middleware.Responder {
 			return middleware.NotImplemented("operation products.GetProducts has not yet been implemented")
 		}),
+		PromotionGetPromotionHandler: promotion.GetPromotionHandlerFunc(func(params promotion.GetPromotionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation promotion.GetPromotion has not yet been implemented")
+		}),
+		ReturnsGetReturnHandler: returns.GetReturnHandlerFunc(func(params returns.GetReturnParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.GetReturn has not yet been implemented")
+		}),
+		ShipmentGetShipmentHandler: shipment.GetShipmentHandlerFunc(func(params shipment.GetShipmentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipment.GetShipment has not yet been implemented")
+		}),
+		ShippingGetShippingMethodHandler: shipping.GetShippingMethodHandlerFunc(func(params shipping.GetShippingMethodParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.GetShippingMethod has not yet been implemented")
+		}),
+		ShippingGetShippingRatesHandler: shipping.GetShippingRatesHandlerFunc(func(params shipping.GetShippingRatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.GetShippingRates has not yet been implemented")
+		}),
+		ShippingGetShippingZoneHandler: shipping.GetShippingZoneHandlerFunc(func(params shipping.GetShippingZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.GetShippingZone has not yet been implemented")
+		}),
+		ReportsGetTaxReportHandler: reports.GetTaxReportHandlerFunc(func(params reports.GetTaxReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation reports.GetTaxReport has not yet been implemented")
+		}),
+		TaxGetTaxZoneHandler: tax.GetTaxZoneHandlerFunc(func(params tax.GetTaxZoneParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tax.GetTaxZone has not yet been implemented")
+		}),
 		UserGetUserHandler: user.GetUserHandlerFunc(func(params user.GetUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.GetUser has not yet been implemented")
 		}),
+		WebhooksGetWebhookEventHandler: webhooks.GetWebhookEventHandlerFunc(func(params webhooks.GetWebhookEventParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.GetWebhookEvent has not yet been implemented")
+		}),
+		ReturnsInspectReturnHandler: returns.InspectReturnHandlerFunc(func(params returns.InspectReturnParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.InspectReturn has not yet been implemented")
+		}),
+		AddressesListAddressesHandler: addresses.ListAddressesHandlerFunc(func(params addresses.ListAddressesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation addresses.ListAddresses has not yet been implemented")
+		}),
+		ReturnsListAllReturnsHandler: returns.ListAllReturnsHandlerFunc(func(params returns.ListAllReturnsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.ListAllReturns has not yet been implemented")
+		}),
 		CategoriesListCategoriesHandler: categories.ListCategoriesHandlerFunc(func(params categories.ListCategoriesParams) middleware.Responder {
 			return middleware.NotImplemented("operation categories.ListCategories has not yet been implemented")
 		}),
+		CurrenciesListCurrenciesHandler: currencies.ListCurrenciesHandlerFunc(func(params currencies.ListCurrenciesParams) middleware.Responder {
+			return middleware.NotImplemented("operation currencies.ListCurrencies has not yet been implemented")
+		}),
+		CurrenciesListExchangeRatesHandler: currencies.ListExchangeRatesHandlerFunc(func(params currencies.ListExchangeRatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation currencies.ListExchangeRates has not yet been implemented")
+		}),
+		InvoicesListInvoicesHandler: invoices.ListInvoicesHandlerFunc(func(params invoices.ListInvoicesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation invoices.ListInvoices has not yet been implemented")
+		}),
+		MessagesListOrderMessagesHandler: messages.ListOrderMessagesHandlerFunc(func(params messages.ListOrderMessagesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation messages.ListOrderMessages has not yet been implemented")
+		}),
 		OrdersListOrdersHandler: orders.ListOrdersHandlerFunc(func(params orders.ListOrdersParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation orders.ListOrders has not yet been implemented")
 		}),
+		PaymentListPaymentHistoryHandler: payment.ListPaymentHistoryHandlerFunc(func(params payment.ListPaymentHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation payment.ListPaymentHistory has not yet been implemented")
+		}),
+		ReconciliationsListPaymentReconciliationsHandler: reconciliations.ListPaymentReconciliationsHandlerFunc(func(params reconciliations.ListPaymentReconciliationsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation reconciliations.ListPaymentReconciliations has not yet been implemented")
+		}),
 		PaymentsListPaymentsHandler: payments.ListPaymentsHandlerFunc(func(params payments.ListPaymentsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation payments.ListPayments has not yet been implemented")
 		}),
+		PromotionsListPromotionsHandler: promotions.ListPromotionsHandlerFunc(func(params promotions.ListPromotionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation promotions.ListPromotions has not yet been implemented")
+		}),
+		RefundsListRefundsHandler: refunds.ListRefundsHandlerFunc(func(params refunds.ListRefundsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation refunds.ListRefunds has not yet been implemented")
+		}),
+		ReturnsListReturnsHandler: returns.ListReturnsHandlerFunc(func(params returns.ListReturnsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.ListReturns has not yet been implemented")
+		}),
+		ShipmentsListShipmentsHandler: shipments.ListShipmentsHandlerFunc(func(params shipments.ListShipmentsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipments.ListShipments has not yet been implemented")
+		}),
+		ShippingListShippingMethodsHandler: shipping.ListShippingMethodsHandlerFunc(func(params shipping.ListShippingMethodsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.ListShippingMethods has not yet been implemented")
+		}),
+		ShippingListShippingZonesHandler: shipping.ListShippingZonesHandlerFunc(func(params shipping.ListShippingZonesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipping.ListShippingZones has not yet been implemented")
+		}),
+		TaxesListTaxZonesHandler: taxes.ListTaxZonesHandlerFunc(func(params taxes.ListTaxZonesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation taxes.ListTaxZones has not yet been implemented")
+		}),
 		UsersListUsersHandler: users.ListUsersHandlerFunc(func(params users.ListUsersParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation users.ListUsers has not yet been implemented")
 		}),
+		WebhooksListWebhookEventsHandler: webhooks.ListWebhookEventsHandlerFunc(func(params webhooks.ListWebhookEventsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListWebhookEvents has not yet been implemented")
+		}),
 		AuthLoginHandler: auth.LoginHandlerFunc(func(params auth.LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation auth.Login has not yet been implemented")
 		}),
+		MessagesMarkOrderMessagesReadHandler: messages.MarkOrderMessagesReadHandlerFunc(func(params messages.MarkOrderMessagesReadParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation messages.MarkOrderMessagesRead has not yet been implemented")
+		}),
+		CategoryPatchCategoryHandler: category.PatchCategoryHandlerFunc(func(params category.PatchCategoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation category.PatchCategory has not yet been implemented")
+		}),
+		OrderPatchOrderHandler: order.PatchOrderHandlerFunc(func(params order.PatchOrderParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation order.PatchOrder has not yet been implemented")
+		}),
+		PaymentPatchPaymentHandler: payment.PatchPaymentHandlerFunc(func(params payment.PatchPaymentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation payment.PatchPayment has not yet been implemented")
+		}),
+		ProductPatchProductHandler: product.PatchProductHandlerFunc(func(params product.PatchProductParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation product.PatchProduct has not yet been implemented")
+		}),
+		UserPatchUserHandler: user.PatchUserHandlerFunc(func(params user.PatchUserParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.PatchUser has not yet been implemented")
+		}),
+		MessagesPostOrderMessageHandler: messages.PostOrderMessageHandlerFunc(func(params messages.PostOrderMessageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation messages.PostOrderMessage has not yet been implemented")
+		}),
+		WebhooksProcessPaymentEventHandler: webhooks.ProcessPaymentEventHandlerFunc(func(params webhooks.ProcessPaymentEventParams) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ProcessPaymentEvent has not yet been implemented")
+		}),
 		WebhooksProcessStripePaymentHandler: webhooks.ProcessStripePaymentHandlerFunc(func(params webhooks.ProcessStripePaymentParams) middleware.Responder {
 			return middleware.NotImplemented("operation webhooks.ProcessStripePayment has not yet been implemented")
 		}),
+		WebhooksProcessTrackingEventHandler: webhooks.ProcessTrackingEventHandlerFunc(func(params webhooks.ProcessTrackingEventParams) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ProcessTrackingEvent has not yet been implemented")
+		}),
+		PaymentReceivePaymentHandler: payment.ReceivePaymentHandlerFunc(func(params payment.ReceivePaymentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation payment.ReceivePayment has not yet been implemented")
+		}),
+		ReconciliationsReconcilePaymentsHandler: reconciliations.ReconcilePaymentsHandlerFunc(func(params reconciliations.ReconcilePaymentsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation reconciliations.ReconcilePayments has not yet been implemented")
+		}),
+		ShipmentRefreshShipmentTrackingHandler: shipment.RefreshShipmentTrackingHandlerFunc(func(params shipment.RefreshShipmentTrackingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation shipment.RefreshShipmentTracking has not yet been implemented")
+		}),
+		WebhooksReplayWebhookEventHandler: webhooks.ReplayWebhookEventHandlerFunc(func(params webhooks.ReplayWebhookEventParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ReplayWebhookEvent has not yet been implemented")
+		}),
+		ReturnsRequestReturnHandler: returns.RequestReturnHandlerFunc(func(params returns.RequestReturnParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.RequestReturn has not yet been implemented")
+		}),
+		ReturnsRetryReturnRefundHandler: returns.RetryReturnRefundHandlerFunc(func(params returns.RetryReturnRefundParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation returns.RetryReturnRefund has not yet been implemented")
+		}),
+		CurrenciesSetExchangeRateHandler: currencies.SetExchangeRateHandlerFunc(func(params currencies.SetExchangeRateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation currencies.SetExchangeRate has not yet been implemented")
+		}),
+		CartUpdateCartHandler: cart.UpdateCartHandlerFunc(func(params cart.UpdateCartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cart.UpdateCart has not yet been implemented")
+		}),
 
 		OauthSecurityAuth: func(token string, scopes []string) (*models.Principal, error) {
 			return nil, errors.NotImplemented("oauth2 bearer auth (OauthSecurity) has not yet been implemented")
@@ -186,8 +458,22 @@ type EStoreMainAPI struct {
 
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
+	//   - application/merge-patch+json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	//   - application/pdf
+	BinProducer runtime.Producer
+
+	// CSVProducer registers a producer for the following mime types:
+	//   - text/csv
+	CSVProducer runtime.Producer
+
+	// HTMLProducer registers a producer for the following mime types:
+	//   - text/html
+	HTMLProducer runtime.Producer
+
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
@@ -199,28 +485,80 @@ type EStoreMainAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AddressesAddAddressHandler sets the operation handler for the add address operation
+	AddressesAddAddressHandler addresses.AddAddressHandler
 	// CategoriesAddCategoryHandler sets the operation handler for the add category operation
 	CategoriesAddCategoryHandler categories.AddCategoryHandler
 	// CheckoutAddCheckoutSessionHandler sets the operation handler for the add checkout session operation
 	CheckoutAddCheckoutSessionHandler checkout.AddCheckoutSessionHandler
+	// GuestAddGuestCheckoutSessionHandler sets the operation handler for the add guest checkout session operation
+	GuestAddGuestCheckoutSessionHandler guest.AddGuestCheckoutSessionHandler
+	// GuestAddGuestOfflinePaymentHandler sets the operation handler for the add guest offline payment operation
+	GuestAddGuestOfflinePaymentHandler guest.AddGuestOfflinePaymentHandler
+	// GuestAddGuestOrderHandler sets the operation handler for the add guest order operation
+	GuestAddGuestOrderHandler guest.AddGuestOrderHandler
+	// CheckoutAddOfflinePaymentHandler sets the operation handler for the add offline payment operation
+	CheckoutAddOfflinePaymentHandler checkout.AddOfflinePaymentHandler
 	// OrdersAddOrderHandler sets the operation handler for the add order operation
 	OrdersAddOrderHandler orders.AddOrderHandler
 	// PaymentsAddPaymentHandler sets the operation handler for the add payment operation
 	PaymentsAddPaymentHandler payments.AddPaymentHandler
 	// ProductsAddProductHandler sets the operation handler for the add product operation
 	ProductsAddProductHandler products.AddProductHandler
+	// PromotionsAddPromotionHandler sets the operation handler for the add promotion operation
+	PromotionsAddPromotionHandler promotions.AddPromotionHandler
+	// ShipmentsAddShipmentHandler sets the operation handler for the add shipment operation
+	ShipmentsAddShipmentHandler shipments.AddShipmentHandler
+	// ShippingAddShippingMethodHandler sets the operation handler for the add shipping method operation
+	ShippingAddShippingMethodHandler shipping.AddShippingMethodHandler
+	// ShippingAddShippingZoneHandler sets the operation handler for the add shipping zone operation
+	ShippingAddShippingZoneHandler shipping.AddShippingZoneHandler
+	// TaxesAddTaxZoneHandler sets the operation handler for the add tax zone operation
+	TaxesAddTaxZoneHandler taxes.AddTaxZoneHandler
 	// UsersAddUserHandler sets the operation handler for the add user operation
 	UsersAddUserHandler users.AddUserHandler
+	// OrderChangeOrderStatusHandler sets the operation handler for the change order status operation
+	OrderChangeOrderStatusHandler order.ChangeOrderStatusHandler
+	// ShipmentChangeShipmentStatusHandler sets the operation handler for the change shipment status operation
+	ShipmentChangeShipmentStatusHandler shipment.ChangeShipmentStatusHandler
+	// CartCheckoutCartHandler sets the operation handler for the checkout cart operation
+	CartCheckoutCartHandler cart.CheckoutCartHandler
+	// GuestClaimGuestOrderHandler sets the operation handler for the claim guest order operation
+	GuestClaimGuestOrderHandler guest.ClaimGuestOrderHandler
+	// CartClearCartHandler sets the operation handler for the clear cart operation
+	CartClearCartHandler cart.ClearCartHandler
+	// PaymentCollectPaymentHandler sets the operation handler for the collect payment operation
+	PaymentCollectPaymentHandler payment.CollectPaymentHandler
+	// PaymentsCompleteFakePaymentHandler sets the operation handler for the complete fake payment operation
+	PaymentsCompleteFakePaymentHandler payments.CompleteFakePaymentHandler
+	// RefundsCreateRefundHandler sets the operation handler for the create refund operation
+	RefundsCreateRefundHandler refunds.CreateRefundHandler
+	// ReturnsDecideReturnHandler sets the operation handler for the decide return operation
+	ReturnsDecideReturnHandler returns.DecideReturnHandler
+	// AddressDeleteAddressHandler sets the operation handler for the delete address operation
+	AddressDeleteAddressHandler address.DeleteAddressHandler
 	// CategoryDeleteCategoryHandler sets the operation handler for the delete category operation
 	CategoryDeleteCategoryHandler category.DeleteCategoryHandler
+	// CurrenciesDeleteExchangeRateHandler sets the operation handler for the delete exchange rate operation
+	CurrenciesDeleteExchangeRateHandler currencies.DeleteExchangeRateHandler
 	// OrderDeleteOrderHandler sets the operation handler for the delete order operation
 	OrderDeleteOrderHandler order.DeleteOrderHandler
 	// PaymentDeletePaymetHandler sets the operation handler for the delete paymet operation
 	PaymentDeletePaymetHandler payment.DeletePaymetHandler
 	// ProductDeleteProductHandler sets the operation handler for the delete product operation
 	ProductDeleteProductHandler product.DeleteProductHandler
+	// PromotionDeletePromotionHandler sets the operation handler for the delete promotion operation
+	PromotionDeletePromotionHandler promotion.DeletePromotionHandler
+	// ShippingDeleteShippingMethodHandler sets the operation handler for the delete shipping method operation
+	ShippingDeleteShippingMethodHandler shipping.DeleteShippingMethodHandler
+	// ShippingDeleteShippingZoneHandler sets the operation handler for the delete shipping zone operation
+	ShippingDeleteShippingZoneHandler shipping.DeleteShippingZoneHandler
+	// TaxDeleteTaxZoneHandler sets the operation handler for the delete tax zone operation
+	TaxDeleteTaxZoneHandler tax.DeleteTaxZoneHandler
 	// UserDeleteUserHandler sets the operation handler for the delete user operation
 	UserDeleteUserHandler user.DeleteUserHandler
+	// AddressEditAddressHandler sets the operation handler for the edit address operation
+	AddressEditAddressHandler address.EditAddressHandler
 	// CategoryEditCategoryHandler sets the operation handler for the edit category operation
 	CategoryEditCategoryHandler category.EditCategoryHandler
 	// OrderEditOrderHandler sets the operation handler for the edit order operation
@@ -229,38 +567,150 @@ type EStoreMainAPI struct {
 	PaymentEditPaymentHandler payment.EditPaymentHandler
 	// ProductEditProductHandler sets the operation handler for the edit product operation
 	ProductEditProductHandler product.EditProductHandler
+	// PromotionEditPromotionHandler sets the operation handler for the edit promotion operation
+	PromotionEditPromotionHandler promotion.EditPromotionHandler
+	// ShippingEditShippingMethodHandler sets the operation handler for the edit shipping method operation
+	ShippingEditShippingMethodHandler shipping.EditShippingMethodHandler
+	// ShippingEditShippingZoneHandler sets the operation handler for the edit shipping zone operation
+	ShippingEditShippingZoneHandler shipping.EditShippingZoneHandler
+	// TaxEditTaxZoneHandler sets the operation handler for the edit tax zone operation
+	TaxEditTaxZoneHandler tax.EditTaxZoneHandler
 	// UserEditUserHandler sets the operation handler for the edit user operation
 	UserEditUserHandler user.EditUserHandler
+	// OrdersExportOrdersHandler sets the operation handler for the export orders operation
+	OrdersExportOrdersHandler orders.ExportOrdersHandler
 	// AuthGetAccessTokenHandler sets the operation handler for the get access token operation
 	AuthGetAccessTokenHandler auth.GetAccessTokenHandler
+	// AddressGetAddressHandler sets the operation handler for the get address operation
+	AddressGetAddressHandler address.GetAddressHandler
+	// CartGetCartHandler sets the operation handler for the get cart operation
+	CartGetCartHandler cart.GetCartHandler
 	// CategoryGetCategoryHandler sets the operation handler for the get category operation
 	CategoryGetCategoryHandler category.GetCategoryHandler
 	// CheckoutGetCheckoutSessionHandler sets the operation handler for the get checkout session operation
 	CheckoutGetCheckoutSessionHandler checkout.GetCheckoutSessionHandler
+	// CurrenciesGetExchangeRateHandler sets the operation handler for the get exchange rate operation
+	CurrenciesGetExchangeRateHandler currencies.GetExchangeRateHandler
+	// PaymentsGetFakePaymentPageHandler sets the operation handler for the get fake payment page operation
+	PaymentsGetFakePaymentPageHandler payments.GetFakePaymentPageHandler
+	// GuestGetGuestOrderHandler sets the operation handler for the get guest order operation
+	GuestGetGuestOrderHandler guest.GetGuestOrderHandler
+	// InvoiceGetInvoiceHandler sets the operation handler for the get invoice operation
+	InvoiceGetInvoiceHandler invoice.GetInvoiceHandler
 	// OrderGetOrderHandler sets the operation handler for the get order operation
 	OrderGetOrderHandler order.GetOrderHandler
+	// MessageGetOrderMessageAttachmentHandler sets the operation handler for the get order message attachment operation
+	MessageGetOrderMessageAttachmentHandler message.GetOrderMessageAttachmentHandler
 	// UserGetOwnUserHandler sets the operation handler for the get own user operation
 	UserGetOwnUserHandler user.GetOwnUserHandler
 	// PaymentGetPaymentHandler sets the operation handler for the get payment operation
 	PaymentGetPaymentHandler payment.GetPaymentHandler
+	// ReconciliationsGetPaymentReconciliationHandler sets the operation handler for the get payment reconciliation operation
+	ReconciliationsGetPaymentReconciliationHandler reconciliations.GetPaymentReconciliationHandler
 	// ProductGetProductHandler sets the operation handler for the get product operation
 	ProductGetProductHandler product.GetProductHandler
 	// ProductsGetProductsHandler sets the operation handler for the get products operation
 	ProductsGetProductsHandler products.GetProductsHandler
+	// PromotionGetPromotionHandler sets the operation handler for the get promotion operation
+	PromotionGetPromotionHandler promotion.GetPromotionHandler
+	// ReturnsGetReturnHandler sets the operation handler for the get return operation
+	ReturnsGetReturnHandler returns.GetReturnHandler
+	// ShipmentGetShipmentHandler sets the operation handler for the get shipment operation
+	ShipmentGetShipmentHandler shipment.GetShipmentHandler
+	// ShippingGetShippingMethodHandler sets the operation handler for the get shipping method operation
+	ShippingGetShippingMethodHandler shipping.GetShippingMethodHandler
+	// ShippingGetShippingRatesHandler sets the operation handler for the get shipping rates operation
+	ShippingGetShippingRatesHandler shipping.GetShippingRatesHandler
+	// ShippingGetShippingZoneHandler sets the operation handler for the get shipping zone operation
+	ShippingGetShippingZoneHandler shipping.GetShippingZoneHandler
+	// ReportsGetTaxReportHandler sets the operation handler for the get tax report operation
+	ReportsGetTaxReportHandler reports.GetTaxReportHandler
+	// TaxGetTaxZoneHandler sets the operation handler for the get tax zone operation
+	TaxGetTaxZoneHandler tax.GetTaxZoneHandler
 	// UserGetUserHandler sets the operation handler for the get user operation
 	UserGetUserHandler user.GetUserHandler
+	// WebhooksGetWebhookEventHandler sets the operation handler for the get webhook event operation
+	WebhooksGetWebhookEventHandler webhooks.GetWebhookEventHandler
+	// ReturnsInspectReturnHandler sets the operation handler for the inspect return operation
+	ReturnsInspectReturnHandler returns.InspectReturnHandler
+	// AddressesListAddressesHandler sets the operation handler for the list addresses operation
+	AddressesListAddressesHandler addresses.ListAddressesHandler
+	// ReturnsListAllReturnsHandler sets the operation handler for the list all returns operation
+	ReturnsListAllReturnsHandler returns.ListAllReturnsHandler
 	// CategoriesListCategoriesHandler sets the operation handler for the list categories operation
 	CategoriesListCategoriesHandler categories.ListCategoriesHandler
+	// CurrenciesListCurrenciesHandler sets the operation handler for the list currencies operation
+	CurrenciesListCurrenciesHandler currencies.ListCurrenciesHandler
+	// CurrenciesListExchangeRatesHandler sets the operation handler for the list exchange rates operation
+	CurrenciesListExchangeRatesHandler currencies.ListExchangeRatesHandler
+	// InvoicesListInvoicesHandler sets the operation handler for the list invoices operation
+	InvoicesListInvoicesHandler invoices.ListInvoicesHandler
+	// MessagesListOrderMessagesHandler sets the operation handler for the list order messages operation
+	MessagesListOrderMessagesHandler messages.ListOrderMessagesHandler
 	// OrdersListOrdersHandler sets the operation handler for the list orders operation
 	OrdersListOrdersHandler orders.ListOrdersHandler
+	// PaymentListPaymentHistoryHandler sets the operation handler for the list payment history operation
+	PaymentListPaymentHistoryHandler payment.ListPaymentHistoryHandler
+	// ReconciliationsListPaymentReconciliationsHandler sets the operation handler for the list payment reconciliations operation
+	ReconciliationsListPaymentReconciliationsHandler reconciliations.ListPaymentReconciliationsHandler
 	// PaymentsListPaymentsHandler sets the operation handler for the list payments operation
 	PaymentsListPaymentsHandler payments.ListPaymentsHandler
+	// PromotionsListPromotionsHandler sets the operation handler for the list promotions operation
+	PromotionsListPromotionsHandler promotions.ListPromotionsHandler
+	// RefundsListRefundsHandler sets the operation handler for the list refunds operation
+	RefundsListRefundsHandler refunds.ListRefundsHandler
+	// ReturnsListReturnsHandler sets the operation handler for the list returns operation
+	ReturnsListReturnsHandler returns.ListReturnsHandler
+	// ShipmentsListShipmentsHandler sets the operation handler for the list shipments operation
+	ShipmentsListShipmentsHandler shipments.ListShipmentsHandler
+	// ShippingListShippingMethodsHandler sets the operation handler for the list shipping methods operation
+	ShippingListShippingMethodsHandler shipping.ListShippingMethodsHandler
+	// ShippingListShippingZonesHandler sets the operation handler for the list shipping zones operation
+	ShippingListShippingZonesHandler shipping.ListShippingZonesHandler
+	// TaxesListTaxZonesHandler sets the operation handler for the list tax zones operation
+	TaxesListTaxZonesHandler taxes.ListTaxZonesHandler
 	// UsersListUsersHandler sets the operation handler for the list users operation
 	UsersListUsersHandler users.ListUsersHandler
+	// WebhooksListWebhookEventsHandler sets the operation handler for the list webhook events operation
+	WebhooksListWebhookEventsHandler webhooks.ListWebhookEventsHandler
 	// AuthLoginHandler sets the operation handler for the login operation
 	AuthLoginHandler auth.LoginHandler
+	// MessagesMarkOrderMessagesReadHandler sets the operation handler for the mark order messages read operation
+	MessagesMarkOrderMessagesReadHandler messages.MarkOrderMessagesReadHandler
+	// CategoryPatchCategoryHandler sets the operation handler for the patch category operation
+	CategoryPatchCategoryHandler category.PatchCategoryHandler
+	// OrderPatchOrderHandler sets the operation handler for the patch order operation
+	OrderPatchOrderHandler order.PatchOrderHandler
+	// PaymentPatchPaymentHandler sets the operation handler for the patch payment operation
+	PaymentPatchPaymentHandler payment.PatchPaymentHandler
+	// ProductPatchProductHandler sets the operation handler for the patch product operation
+	ProductPatchProductHandler product.PatchProductHandler
+	// UserPatchUserHandler sets the operation handler for the patch user operation
+	UserPatchUserHandler user.PatchUserHandler
+	// MessagesPostOrderMessageHandler sets the operation handler for the post order message operation
+	MessagesPostOrderMessageHandler messages.PostOrderMessageHandler
+	// WebhooksProcessPaymentEventHandler sets the operation handler for the process payment event operation
+	WebhooksProcessPaymentEventHandler webhooks.ProcessPaymentEventHandler
 	// WebhooksProcessStripePaymentHandler sets the operation handler for the process stripe payment operation
 	WebhooksProcessStripePaymentHandler webhooks.ProcessStripePaymentHandler
+	// WebhooksProcessTrackingEventHandler sets the operation handler for the process tracking event operation
+	WebhooksProcessTrackingEventHandler webhooks.ProcessTrackingEventHandler
+	// PaymentReceivePaymentHandler sets the operation handler for the receive payment operation
+	PaymentReceivePaymentHandler payment.ReceivePaymentHandler
+	// ReconciliationsReconcilePaymentsHandler sets the operation handler for the reconcile payments operation
+	ReconciliationsReconcilePaymentsHandler reconciliations.ReconcilePaymentsHandler
+	// ShipmentRefreshShipmentTrackingHandler sets the operation handler for the refresh shipment tracking operation
+	ShipmentRefreshShipmentTrackingHandler shipment.RefreshShipmentTrackingHandler
+	// WebhooksReplayWebhookEventHandler sets the operation handler for the replay webhook event operation
+	WebhooksReplayWebhookEventHandler webhooks.ReplayWebhookEventHandler
+	// ReturnsRequestReturnHandler sets the operation handler for the request return operation
+	ReturnsRequestReturnHandler returns.RequestReturnHandler
+	// ReturnsRetryReturnRefundHandler sets the operation handler for the retry return refund operation
+	ReturnsRetryReturnRefundHandler returns.RetryReturnRefundHandler
+	// CurrenciesSetExchangeRateHandler sets the operation handler for the set exchange rate operation
+	CurrenciesSetExchangeRateHandler currencies.SetExchangeRateHandler
+	// CartUpdateCartHandler sets the operation handler for the update cart operation
+	CartUpdateCartHandler cart.UpdateCartHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -334,6 +784,18 @@ func (o *EStoreMainAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+
+	if o.CSVProducer == nil {
+		unregistered = append(unregistered, "CSVProducer")
+	}
+
+	if o.HTMLProducer == nil {
+		unregistered = append(unregistered, "HTMLProducer")
+	}
+
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -342,12 +804,27 @@ func (o *EStoreMainAPI) Validate() error {
 		unregistered = append(unregistered, "OauthSecurityAuth")
 	}
 
+	if o.AddressesAddAddressHandler == nil {
+		unregistered = append(unregistered, "addresses.AddAddressHandler")
+	}
 	if o.CategoriesAddCategoryHandler == nil {
 		unregistered = append(unregistered, "categories.AddCategoryHandler")
 	}
 	if o.CheckoutAddCheckoutSessionHandler == nil {
 		unregistered = append(unregistered, "checkout.AddCheckoutSessionHandler")
 	}
+	if o.GuestAddGuestCheckoutSessionHandler == nil {
+		unregistered = append(unregistered, "guest.AddGuestCheckoutSessionHandler")
+	}
+	if o.GuestAddGuestOfflinePaymentHandler == nil {
+		unregistered = append(unregistered, "guest.AddGuestOfflinePaymentHandler")
+	}
+	if o.GuestAddGuestOrderHandler == nil {
+		unregistered = append(unregistered, "guest.AddGuestOrderHandler")
+	}
+	if o.CheckoutAddOfflinePaymentHandler == nil {
+		unregistered = append(unregistered, "checkout.AddOfflinePaymentHandler")
+	}
 	if o.OrdersAddOrderHandler == nil {
 		unregistered = append(unregistered, "orders.AddOrderHandler")
 	}
@@ -357,12 +834,60 @@ func (o *EStoreMainAPI) Validate() error {
 	if o.ProductsAddProductHandler == nil {
 		unregistered = append(unregistered, "products.AddProductHandler")
 	}
+	if o.PromotionsAddPromotionHandler == nil {
+		unregistered = append(unregistered, "promotions.AddPromotionHandler")
+	}
+	if o.ShipmentsAddShipmentHandler == nil {
+		unregistered = append(unregistered, "shipments.AddShipmentHandler")
+	}
+	if o.ShippingAddShippingMethodHandler == nil {
+		unregistered = append(unregistered, "shipping.AddShippingMethodHandler")
+	}
+	if o.ShippingAddShippingZoneHandler == nil {
+		unregistered = append(unregistered, "shipping.AddShippingZoneHandler")
+	}
+	if o.TaxesAddTaxZoneHandler == nil {
+		unregistered = append(unregistered, "taxes.AddTaxZoneHandler")
+	}
 	if o.UsersAddUserHandler == nil {
 		unregistered = append(unregistered, "users.AddUserHandler")
 	}
+	if o.OrderChangeOrderStatusHandler == nil {
+		unregistered = append(unregistered, "order.ChangeOrderStatusHandler")
+	}
+	if o.ShipmentChangeShipmentStatusHandler == nil {
+		unregistered = append(unregistered, "shipment.ChangeShipmentStatusHandler")
+	}
+	if o.CartCheckoutCartHandler == nil {
+		unregistered = append(unregistered, "cart.CheckoutCartHandler")
+	}
+	if o.GuestClaimGuestOrderHandler == nil {
+		unregistered = append(unregistered, "guest.ClaimGuestOrderHandler")
+	}
+	if o.CartClearCartHandler == nil {
+		unregistered = append(unregistered, "cart.ClearCartHandler")
+	}
+	if o.PaymentCollectPaymentHandler == nil {
+		unregistered = append(unregistered, "payment.CollectPaymentHandler")
+	}
+	if o.PaymentsCompleteFakePaymentHandler == nil {
+		unregistered = append(unregistered, "payments.CompleteFakePaymentHandler")
+	}
+	if o.RefundsCreateRefundHandler == nil {
+		unregistered = append(unregistered, "refunds.CreateRefundHandler")
+	}
+	if o.ReturnsDecideReturnHandler == nil {
+		unregistered = append(unregistered, "returns.DecideReturnHandler")
+	}
+	if o.AddressDeleteAddressHandler == nil {
+		unregistered = append(unregistered, "address.DeleteAddressHandler")
+	}
 	if o.CategoryDeleteCategoryHandler == nil {
 		unregistered = append(unregistered, "category.DeleteCategoryHandler")
 	}
+	if o.CurrenciesDeleteExchangeRateHandler == nil {
+		unregistered = append(unregistered, "currencies.DeleteExchangeRateHandler")
+	}
 	if o.OrderDeleteOrderHandler == nil {
 		unregistered = append(unregistered, "order.DeleteOrderHandler")
 	}
@@ -372,9 +897,24 @@ func (o *EStoreMainAPI) Validate() error {
 	if o.ProductDeleteProductHandler == nil {
 		unregistered = append(unregistered, "product.DeleteProductHandler")
 	}
+	if o.PromotionDeletePromotionHandler == nil {
+		unregistered = append(unregistered, "promotion.DeletePromotionHandler")
+	}
+	if o.ShippingDeleteShippingMethodHandler == nil {
+		unregistered = append(unregistered, "shipping.DeleteShippingMethodHandler")
+	}
+	if o.ShippingDeleteShippingZoneHandler == nil {
+		unregistered = append(unregistered, "shipping.DeleteShippingZoneHandler")
+	}
+	if o.TaxDeleteTaxZoneHandler == nil {
+		unregistered = append(unregistered, "tax.DeleteTaxZoneHandler")
+	}
 	if o.UserDeleteUserHandler == nil {
 		unregistered = append(unregistered, "user.DeleteUserHandler")
 	}
+	if o.AddressEditAddressHandler == nil {
+		unregistered = append(unregistered, "address.EditAddressHandler")
+	}
 	if o.CategoryEditCategoryHandler == nil {
 		unregistered = append(unregistered, "category.EditCategoryHandler")
 	}
@@ -387,54 +927,222 @@ func (o *EStoreMainAPI) Validate() error {
 	if o.ProductEditProductHandler == nil {
 		unregistered = append(unregistered, "product.EditProductHandler")
 	}
+	if o.PromotionEditPromotionHandler == nil {
+		unregistered = append(unregistered, "promotion.EditPromotionHandler")
+	}
+	if o.ShippingEditShippingMethodHandler == nil {
+		unregistered = append(unregistered, "shipping.EditShippingMethodHandler")
+	}
+	if o.ShippingEditShippingZoneHandler == nil {
+		unregistered = append(unregistered, "shipping.EditShippingZoneHandler")
+	}
+	if o.TaxEditTaxZoneHandler == nil {
+		unregistered = append(unregistered, "tax.EditTaxZoneHandler")
+	}
 	if o.UserEditUserHandler == nil {
 		unregistered = append(unregistered, "user.EditUserHandler")
 	}
+	if o.OrdersExportOrdersHandler == nil {
+		unregistered = append(unregistered, "orders.ExportOrdersHandler")
+	}
 	if o.AuthGetAccessTokenHandler == nil {
 		unregistered = append(unregistered, "auth.GetAccessTokenHandler")
 	}
+	if o.AddressGetAddressHandler == nil {
+		unregistered = append(unregistered, "address.GetAddressHandler")
+	}
+	if o.CartGetCartHandler == nil {
+		unregistered = append(unregistered, "cart.GetCartHandler")
+	}
 	if o.CategoryGetCategoryHandler == nil {
 		unregistered = append(unregistered, "category.GetCategoryHandler")
 	}
 	if o.CheckoutGetCheckoutSessionHandler == nil {
 		unregistered = append(unregistered, "checkout.GetCheckoutSessionHandler")
 	}
+	if o.CurrenciesGetExchangeRateHandler == nil {
+		unregistered = append(unregistered, "currencies.GetExchangeRateHandler")
+	}
+	if o.PaymentsGetFakePaymentPageHandler == nil {
+		unregistered = append(unregistered, "payments.GetFakePaymentPageHandler")
+	}
+	if o.GuestGetGuestOrderHandler == nil {
+		unregistered = append(unregistered, "guest.GetGuestOrderHandler")
+	}
+	if o.InvoiceGetInvoiceHandler == nil {
+		unregistered = append(unregistered, "invoice.GetInvoiceHandler")
+	}
 	if o.OrderGetOrderHandler == nil {
 		unregistered = append(unregistered, "order.GetOrderHandler")
 	}
+	if o.MessageGetOrderMessageAttachmentHandler == nil {
+		unregistered = append(unregistered, "message.GetOrderMessageAttachmentHandler")
+	}
 	if o.UserGetOwnUserHandler == nil {
 		unregistered = append(unregistered, "user.GetOwnUserHandler")
 	}
 	if o.PaymentGetPaymentHandler == nil {
 		unregistered = append(unregistered, "payment.GetPaymentHandler")
 	}
+	if o.ReconciliationsGetPaymentReconciliationHandler == nil {
+		unregistered = append(unregistered, "reconciliations.GetPaymentReconciliationHandler")
+	}
 	if o.ProductGetProductHandler == nil {
 		unregistered = append(unregistered, "product.GetProductHandler")
 	}
 	if o.ProductsGetProductsHandler == nil {
 		unregistered = append(unregistered, "products.GetProductsHandler")
 	}
+	if o.PromotionGetPromotionHandler == nil {
+		unregistered = append(unregistered, "promotion.GetPromotionHandler")
+	}
+	if o.ReturnsGetReturnHandler == nil {
+		unregistered = append(unregistered, "returns.GetReturnHandler")
+	}
+	if o.ShipmentGetShipmentHandler == nil {
+		unregistered = append(unregistered, "shipment.GetShipmentHandler")
+	}
+	if o.ShippingGetShippingMethodHandler == nil {
+		unregistered = append(unregistered, "shipping.GetShippingMethodHandler")
+	}
+	if o.ShippingGetShippingRatesHandler == nil {
+		unregistered = append(unregistered, "shipping.GetShippingRatesHandler")
+	}
+	if o.ShippingGetShippingZoneHandler == nil {
+		unregistered = append(unregistered, "shipping.GetShippingZoneHandler")
+	}
+	if o.ReportsGetTaxReportHandler == nil {
+		unregistered = append(unregistered, "reports.GetTaxReportHandler")
+	}
+	if o.TaxGetTaxZoneHandler == nil {
+		unregistered = append(unregistered, "tax.GetTaxZoneHandler")
+	}
 	if o.UserGetUserHandler == nil {
 		unregistered = append(unregistered, "user.GetUserHandler")
 	}
+	if o.WebhooksGetWebhookEventHandler == nil {
+		unregistered = append(unregistered, "webhooks.GetWebhookEventHandler")
+	}
+	if o.ReturnsInspectReturnHandler == nil {
+		unregistered = append(unregistered, "returns.InspectReturnHandler")
+	}
+	if o.AddressesListAddressesHandler == nil {
+		unregistered = append(unregistered, "addresses.ListAddressesHandler")
+	}
+	if o.ReturnsListAllReturnsHandler == nil {
+		unregistered = append(unregistered, "returns.ListAllReturnsHandler")
+	}
 	if o.CategoriesListCategoriesHandler == nil {
 		unregistered = append(unregistered, "categories.ListCategoriesHandler")
 	}
+	if o.CurrenciesListCurrenciesHandler == nil {
+		unregistered = append(unregistered, "currencies.ListCurrenciesHandler")
+	}
+	if o.CurrenciesListExchangeRatesHandler == nil {
+		unregistered = append(unregistered, "currencies.ListExchangeRatesHandler")
+	}
+	if o.InvoicesListInvoicesHandler == nil {
+		unregistered = append(unregistered, "invoices.ListInvoicesHandler")
+	}
+	if o.MessagesListOrderMessagesHandler == nil {
+		unregistered = append(unregistered, "messages.ListOrderMessagesHandler")
+	}
 	if o.OrdersListOrdersHandler == nil {
 		unregistered = append(unregistered, "orders.ListOrdersHandler")
 	}
+	if o.PaymentListPaymentHistoryHandler == nil {
+		unregistered = append(unregistered, "payment.ListPaymentHistoryHandler")
+	}
+	if o.ReconciliationsListPaymentReconciliationsHandler == nil {
+		unregistered = append(unregistered, "reconciliations.ListPaymentReconciliationsHandler")
+	}
 	if o.PaymentsListPaymentsHandler == nil {
 		unregistered = append(unregistered, "payments.ListPaymentsHandler")
 	}
+	if o.PromotionsListPromotionsHandler == nil {
+		unregistered = append(unregistered, "promotions.ListPromotionsHandler")
+	}
+	if o.RefundsListRefundsHandler == nil {
+		unregistered = append(unregistered, "refunds.ListRefundsHandler")
+	}
+	if o.ReturnsListReturnsHandler == nil {
+		unregistered = append(unregistered, "returns.ListReturnsHandler")
+	}
+	if o.ShipmentsListShipmentsHandler == nil {
+		unregistered = append(unregistered, "shipments.ListShipmentsHandler")
+	}
+	if o.ShippingListShippingMethodsHandler == nil {
+		unregistered = append(unregistered, "shipping.ListShippingMethodsHandler")
+	}
+	if o.ShippingListShippingZonesHandler == nil {
+		unregistered = append(unregistered, "shipping.ListShippingZonesHandler")
+	}
+	if o.TaxesListTaxZonesHandler == nil {
+		unregistered = append(unregistered, "taxes.ListTaxZonesHandler")
+	}
 	if o.UsersListUsersHandler == nil {
 		unregistered = append(unregistered, "users.ListUsersHandler")
 	}
+	if o.WebhooksListWebhookEventsHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListWebhookEventsHandler")
+	}
 	if o.AuthLoginHandler == nil {
 		unregistered = append(unregistered, "auth.LoginHandler")
 	}
+	if o.MessagesMarkOrderMessagesReadHandler == nil {
+		unregistered = append(unregistered, "messages.MarkOrderMessagesReadHandler")
+	}
+	if o.CategoryPatchCategoryHandler == nil {
+		unregistered = append(unregistered, "category.PatchCategoryHandler")
+	}
+	if o.OrderPatchOrderHandler == nil {
+		unregistered = append(unregistered, "order.PatchOrderHandler")
+	}
+	if o.PaymentPatchPaymentHandler == nil {
+		unregistered = append(unregistered, "payment.PatchPaymentHandler")
+	}
+	if o.ProductPatchProductHandler == nil {
+		unregistered = append(unregistered, "product.PatchProductHandler")
+	}
+	if o.UserPatchUserHandler == nil {
+		unregistered = append(unregistered, "user.PatchUserHandler")
+	}
+	if o.MessagesPostOrderMessageHandler == nil {
+		unregistered = append(unregistered, "messages.PostOrderMessageHandler")
+	}
+	if o.WebhooksProcessPaymentEventHandler == nil {
+		unregistered = append(unregistered, "webhooks.ProcessPaymentEventHandler")
+	}
 	if o.WebhooksProcessStripePaymentHandler == nil {
 		unregistered = append(unregistered, "webhooks.ProcessStripePaymentHandler")
 	}
+	if o.WebhooksProcessTrackingEventHandler == nil {
+		unregistered = append(unregistered, "webhooks.ProcessTrackingEventHandler")
+	}
+	if o.PaymentReceivePaymentHandler == nil {
+		unregistered = append(unregistered, "payment.ReceivePaymentHandler")
+	}
+	if o.ReconciliationsReconcilePaymentsHandler == nil {
+		unregistered = append(unregistered, "reconciliations.ReconcilePaymentsHandler")
+	}
+	if o.ShipmentRefreshShipmentTrackingHandler == nil {
+		unregistered = append(unregistered, "shipment.RefreshShipmentTrackingHandler")
+	}
+	if o.WebhooksReplayWebhookEventHandler == nil {
+		unregistered = append(unregistered, "webhooks.ReplayWebhookEventHandler")
+	}
+	if o.ReturnsRequestReturnHandler == nil {
+		unregistered = append(unregistered, "returns.RequestReturnHandler")
+	}
+	if o.ReturnsRetryReturnRefundHandler == nil {
+		unregistered = append(unregistered, "returns.RetryReturnRefundHandler")
+	}
+	if o.CurrenciesSetExchangeRateHandler == nil {
+		unregistered = append(unregistered, "currencies.SetExchangeRateHandler")
+	}
+	if o.CartUpdateCartHandler == nil {
+		unregistered = append(unregistered, "cart.UpdateCartHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -476,6 +1184,8 @@ func (o *EStoreMainAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Con
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/merge-patch+json":
+			result["application/merge-patch+json"] = o.JSONConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -491,6 +1201,14 @@ func (o *EStoreMainAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pro
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "application/pdf":
+			result["application/pdf"] = o.BinProducer
+		case "text/csv":
+			result["text/csv"] = o.CSVProducer
+		case "text/html":
+			result["text/html"] = o.HTMLProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
 		}
@@ -533,6 +1251,10 @@ func (o *EStoreMainAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{id}/addresses"] = addresses.NewAddAddress(o.context, o.AddressesAddAddressHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -544,6 +1266,22 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/guest/orders/{id}/checkout/session"] = guest.NewAddGuestCheckoutSession(o.context, o.GuestAddGuestCheckoutSessionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/guest/orders/{id}/checkout/offline"] = guest.NewAddGuestOfflinePayment(o.context, o.GuestAddGuestOfflinePaymentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/guest/orders"] = guest.NewAddGuestOrder(o.context, o.GuestAddGuestOrderHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/checkout/offline"] = checkout.NewAddOfflinePayment(o.context, o.CheckoutAddOfflinePaymentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/orders"] = orders.NewAddOrder(o.context, o.OrdersAddOrderHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -556,7 +1294,67 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/promotions"] = promotions.NewAddPromotion(o.context, o.PromotionsAddPromotionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/shipments"] = shipments.NewAddShipment(o.context, o.ShipmentsAddShipmentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/shipping/methods"] = shipping.NewAddShippingMethod(o.context, o.ShippingAddShippingMethodHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/shipping/zones"] = shipping.NewAddShippingZone(o.context, o.ShippingAddShippingZoneHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/tax/zones"] = taxes.NewAddTaxZone(o.context, o.TaxesAddTaxZoneHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/users"] = users.NewAddUser(o.context, o.UsersAddUserHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/status"] = order.NewChangeOrderStatus(o.context, o.OrderChangeOrderStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/shipments/{shipmentId}/status"] = shipment.NewChangeShipmentStatus(o.context, o.ShipmentChangeShipmentStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cart/checkout"] = cart.NewCheckoutCart(o.context, o.CartCheckoutCartHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/guest/orders/{id}/claim"] = guest.NewClaimGuestOrder(o.context, o.GuestClaimGuestOrderHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/cart"] = cart.NewClearCart(o.context, o.CartClearCartHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payments/{id}/collect"] = payment.NewCollectPayment(o.context, o.PaymentCollectPaymentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payments/fake/sessions/{id}"] = payments.NewCompleteFakePayment(o.context, o.PaymentsCompleteFakePaymentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payments/{id}/refunds"] = refunds.NewCreateRefund(o.context, o.RefundsCreateRefundHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/returns/{returnId}/decision"] = returns.NewDecideReturn(o.context, o.ReturnsDecideReturnHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/{id}/addresses/{addressId}"] = address.NewDeleteAddress(o.context, o.AddressDeleteAddressHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -564,6 +1362,10 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/currencies/rates/{currency}"] = currencies.NewDeleteExchangeRate(o.context, o.CurrenciesDeleteExchangeRateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/orders/{id}"] = order.NewDeleteOrder(o.context, o.OrderDeleteOrderHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -576,10 +1378,30 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/promotions/{id}"] = promotion.NewDeletePromotion(o.context, o.PromotionDeletePromotionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/shipping/methods/{id}"] = shipping.NewDeleteShippingMethod(o.context, o.ShippingDeleteShippingMethodHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/shipping/zones/{id}"] = shipping.NewDeleteShippingZone(o.context, o.ShippingDeleteShippingZoneHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/tax/zones/{id}"] = tax.NewDeleteTaxZone(o.context, o.TaxDeleteTaxZoneHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/users/{id}"] = user.NewDeleteUser(o.context, o.UserDeleteUserHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/users/{id}/addresses/{addressId}"] = address.NewEditAddress(o.context, o.AddressEditAddressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/categories/{id}"] = category.NewEditCategory(o.context, o.CategoryEditCategoryHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -596,14 +1418,42 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/promotions/{id}"] = promotion.NewEditPromotion(o.context, o.PromotionEditPromotionHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/shipping/methods/{id}"] = shipping.NewEditShippingMethod(o.context, o.ShippingEditShippingMethodHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/shipping/zones/{id}"] = shipping.NewEditShippingZone(o.context, o.ShippingEditShippingZoneHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/tax/zones/{id}"] = tax.NewEditTaxZone(o.context, o.TaxEditTaxZoneHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/users/{id}"] = user.NewEditUser(o.context, o.UserEditUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/orders/export"] = orders.NewExportOrders(o.context, o.OrdersExportOrdersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/auth/cb"] = auth.NewGetAccessToken(o.context, o.AuthGetAccessTokenHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/{id}/addresses/{addressId}"] = address.NewGetAddress(o.context, o.AddressGetAddressHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cart"] = cart.NewGetCart(o.context, o.CartGetCartHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/categories/{id}"] = category.NewGetCategory(o.context, o.CategoryGetCategoryHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -612,10 +1462,30 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/currencies/rates/{currency}"] = currencies.NewGetExchangeRate(o.context, o.CurrenciesGetExchangeRateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/payments/fake/sessions/{id}"] = payments.NewGetFakePaymentPage(o.context, o.PaymentsGetFakePaymentPageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/guest/orders/{id}"] = guest.NewGetGuestOrder(o.context, o.GuestGetGuestOrderHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/invoice"] = invoice.NewGetInvoice(o.context, o.InvoiceGetInvoiceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/orders/{id}"] = order.NewGetOrder(o.context, o.OrderGetOrderHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/orders/{id}/messages/{messageId}/attachments/{attachmentId}"] = message.NewGetOrderMessageAttachment(o.context, o.MessageGetOrderMessageAttachmentHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user"] = user.NewGetOwnUser(o.context, o.UserGetOwnUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -624,6 +1494,10 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/payments/reconciliations/{id}"] = reconciliations.NewGetPaymentReconciliation(o.context, o.ReconciliationsGetPaymentReconciliationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/products/{id}"] = product.NewGetProduct(o.context, o.ProductGetProductHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -632,31 +1506,203 @@ func (o *EStoreMainAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/promotions/{id}"] = promotion.NewGetPromotion(o.context, o.PromotionGetPromotionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/returns/{returnId}"] = returns.NewGetReturn(o.context, o.ReturnsGetReturnHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/shipments/{shipmentId}"] = shipment.NewGetShipment(o.context, o.ShipmentGetShipmentHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/shipping/methods/{id}"] = shipping.NewGetShippingMethod(o.context, o.ShippingGetShippingMethodHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/shipping/rates"] = shipping.NewGetShippingRates(o.context, o.ShippingGetShippingRatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/shipping/zones/{id}"] = shipping.NewGetShippingZone(o.context, o.ShippingGetShippingZoneHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/reports/taxes"] = reports.NewGetTaxReport(o.context, o.ReportsGetTaxReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/tax/zones/{id}"] = tax.NewGetTaxZone(o.context, o.TaxGetTaxZoneHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users/{id}"] = user.NewGetUser(o.context, o.UserGetUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/webhooks/events/{id}"] = webhooks.NewGetWebhookEvent(o.context, o.WebhooksGetWebhookEventHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/returns/{returnId}/inspection"] = returns.NewInspectReturn(o.context, o.ReturnsInspectReturnHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{id}/addresses"] = addresses.NewListAddresses(o.context, o.AddressesListAddressesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/returns"] = returns.NewListAllReturns(o.context, o.ReturnsListAllReturnsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/categories"] = categories.NewListCategories(o.context, o.CategoriesListCategoriesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/currencies"] = currencies.NewListCurrencies(o.context, o.CurrenciesListCurrenciesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/currencies/rates"] = currencies.NewListExchangeRates(o.context, o.CurrenciesListExchangeRatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/invoices"] = invoices.NewListInvoices(o.context, o.InvoicesListInvoicesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/messages"] = messages.NewListOrderMessages(o.context, o.MessagesListOrderMessagesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/orders"] = orders.NewListOrders(o.context, o.OrdersListOrdersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/payments/{id}/history"] = payment.NewListPaymentHistory(o.context, o.PaymentListPaymentHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/payments/reconciliations"] = reconciliations.NewListPaymentReconciliations(o.context, o.ReconciliationsListPaymentReconciliationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/payments"] = payments.NewListPayments(o.context, o.PaymentsListPaymentsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/promotions"] = promotions.NewListPromotions(o.context, o.PromotionsListPromotionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/payments/{id}/refunds"] = refunds.NewListRefunds(o.context, o.RefundsListRefundsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/returns"] = returns.NewListReturns(o.context, o.ReturnsListReturnsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/shipments"] = shipments.NewListShipments(o.context, o.ShipmentsListShipmentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/shipping/methods"] = shipping.NewListShippingMethods(o.context, o.ShippingListShippingMethodsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/shipping/zones"] = shipping.NewListShippingZones(o.context, o.ShippingListShippingZonesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/tax/zones"] = taxes.NewListTaxZones(o.context, o.TaxesListTaxZonesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users"] = users.NewListUsers(o.context, o.UsersListUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/webhooks/events"] = webhooks.NewListWebhookEvents(o.context, o.WebhooksListWebhookEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/login"] = auth.NewLogin(o.context, o.AuthLoginHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/messages/read"] = messages.NewMarkOrderMessagesRead(o.context, o.MessagesMarkOrderMessagesReadHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/categories/{id}"] = category.NewPatchCategory(o.context, o.CategoryPatchCategoryHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/orders/{id}"] = order.NewPatchOrder(o.context, o.OrderPatchOrderHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/payments/{id}"] = payment.NewPatchPayment(o.context, o.PaymentPatchPaymentHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/products/{id}"] = product.NewPatchProduct(o.context, o.ProductPatchProductHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/users/{id}"] = user.NewPatchUser(o.context, o.UserPatchUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/messages"] = messages.NewPostOrderMessage(o.context, o.MessagesPostOrderMessageHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/payments/{gateway}"] = webhooks.NewProcessPaymentEvent(o.context, o.WebhooksProcessPaymentEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/webhooks/stripe/payments"] = webhooks.NewProcessStripePayment(o.context, o.WebhooksProcessStripePaymentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/tracking/{carrier}"] = webhooks.NewProcessTrackingEvent(o.context, o.WebhooksProcessTrackingEventHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payments/{id}/receive"] = payment.NewReceivePayment(o.context, o.PaymentReceivePaymentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payments/reconciliations"] = reconciliations.NewReconcilePayments(o.context, o.ReconciliationsReconcilePaymentsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/shipments/{shipmentId}/tracking"] = shipment.NewRefreshShipmentTracking(o.context, o.ShipmentRefreshShipmentTrackingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/events/{id}/replay"] = webhooks.NewReplayWebhookEvent(o.context, o.WebhooksReplayWebhookEventHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/returns"] = returns.NewRequestReturn(o.context, o.ReturnsRequestReturnHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/returns/{returnId}/refund"] = returns.NewRetryReturnRefund(o.context, o.ReturnsRetryReturnRefundHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/currencies/rates/{currency}"] = currencies.NewSetExchangeRate(o.context, o.CurrenciesSetExchangeRateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cart"] = cart.NewUpdateCart(o.context, o.CartUpdateCartHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
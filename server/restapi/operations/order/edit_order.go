@@ -34,7 +34,7 @@ func NewEditOrder(ctx *middleware.Context, handler EditOrderHandler) *EditOrder
 /*
 	EditOrder swagger:route PUT /orders/{id} order editOrder
 
-Edit order by ID
+Replace order by ID
 */
 type EditOrder struct {
 	Context *middleware.Context
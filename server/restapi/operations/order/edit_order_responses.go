@@ -23,6 +23,11 @@ swagger:response editOrderOK
 */
 type EditOrderOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewEditOrderOK() *EditOrderOK {
 	return &EditOrderOK{}
 }
 
+// WithETag adds the eTag to the edit order o k response
+func (o *EditOrderOK) WithETag(eTag string) *EditOrderOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the edit order o k response
+func (o *EditOrderOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the edit order o k response
 func (o *EditOrderOK) WithPayload(payload *models.Order) *EditOrderOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *EditOrderOK) SetPayload(payload *models.Order) {
 // WriteResponse to the client
 func (o *EditOrderOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,51 @@ func (o *EditOrderOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 	}
 }
 
+// EditOrderPreconditionFailedCode is the HTTP code returned for type EditOrderPreconditionFailed
+const EditOrderPreconditionFailedCode int = 412
+
+/*
+EditOrderPreconditionFailed The resource has been modified since the given ETag
+
+swagger:response editOrderPreconditionFailed
+*/
+type EditOrderPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEditOrderPreconditionFailed creates EditOrderPreconditionFailed with default headers values
+func NewEditOrderPreconditionFailed() *EditOrderPreconditionFailed {
+
+	return &EditOrderPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the edit order precondition failed response
+func (o *EditOrderPreconditionFailed) WithPayload(payload *models.Error) *EditOrderPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the edit order precondition failed response
+func (o *EditOrderPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EditOrderPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*
 EditOrderDefault Error
 
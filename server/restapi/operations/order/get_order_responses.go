@@ -23,6 +23,11 @@ swagger:response getOrderOK
 */
 type GetOrderOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewGetOrderOK() *GetOrderOK {
 	return &GetOrderOK{}
 }
 
+// WithETag adds the eTag to the get order o k response
+func (o *GetOrderOK) WithETag(eTag string) *GetOrderOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get order o k response
+func (o *GetOrderOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get order o k response
 func (o *GetOrderOK) WithPayload(payload *models.Order) *GetOrderOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *GetOrderOK) SetPayload(payload *models.Order) {
 // WriteResponse to the client
 func (o *GetOrderOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,54 @@ func (o *GetOrderOK) WriteResponse(rw http.ResponseWriter, producer runtime.Prod
 	}
 }
 
+// GetOrderNotModifiedCode is the HTTP code returned for type GetOrderNotModified
+const GetOrderNotModifiedCode int = 304
+
+/*
+GetOrderNotModified Not modified
+
+swagger:response getOrderNotModified
+*/
+type GetOrderNotModified struct {
+
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewGetOrderNotModified creates GetOrderNotModified with default headers values
+func NewGetOrderNotModified() *GetOrderNotModified {
+
+	return &GetOrderNotModified{}
+}
+
+// WithETag adds the eTag to the get order not modified response
+func (o *GetOrderNotModified) WithETag(eTag string) *GetOrderNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get order not modified response
+func (o *GetOrderNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *GetOrderNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 /*
 GetOrderDefault Error
 
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 
 	"estore-backend/server/models"
@@ -37,6 +38,11 @@ type AddOrderParams struct {
 	  In: body
 	*/
 	Body *models.Order
+	/*
+	  ISO 4217 code of the store currency to price in; the base currency by default
+	  In: header
+	*/
+	XCurrency *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -69,8 +75,29 @@ func (o *AddOrderParams) BindRequest(r *http.Request, route *middleware.MatchedR
 			}
 		}
 	}
+
+	if err := o.bindXCurrency(r.Header[http.CanonicalHeaderKey("X-Currency")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindXCurrency binds and validates parameter XCurrency from header.
+func (o *AddOrderParams) bindXCurrency(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XCurrency = &raw
+
+	return nil
+}
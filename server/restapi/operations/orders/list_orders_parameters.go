@@ -6,6 +6,7 @@ package orders
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -40,11 +41,41 @@ type ListOrdersParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*
+	  Orders priced in this ISO 4217 currency
+	  In: query
+	*/
+	Currency *string
+	/*
+	  Part of the customer email or name
+	  In: query
+	*/
+	Customer *string
+	/*
+	  Orders created at or after this Unix time
+	  In: query
+	*/
+	DateFrom *int64
+	/*
+	  Orders created at or before this Unix time
+	  In: query
+	*/
+	DateTo *int64
 	/*
 	  In: query
 	  Default: 24
 	*/
 	Limit *int32
+	/*
+	  Order total in the minor units of its currency
+	  In: query
+	*/
+	MaxTotal *int64
+	/*
+	  Order total in the minor units of its currency
+	  In: query
+	*/
+	MinTotal *int64
 	/*
 	  In: query
 	*/
@@ -57,6 +88,27 @@ type ListOrdersParams struct {
 	  In: query
 	*/
 	OrderBy *string
+	/*
+	  Orders having a payment in this status
+	  In: query
+	*/
+	PaymentStatus *string
+	/*
+	  Orders containing the product
+	  In: query
+	*/
+	ProductID *int64
+	/*
+	  Text to search in the delivery info
+	  In: query
+	*/
+	Search *string
+	/*
+	  Order statuses to match
+	  In: query
+	  Collection Format: csv
+	*/
+	Status []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -70,11 +122,41 @@ func (o *ListOrdersParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qCurrency, qhkCurrency, _ := qs.GetOK("currency")
+	if err := o.bindCurrency(qCurrency, qhkCurrency, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCustomer, qhkCustomer, _ := qs.GetOK("customer")
+	if err := o.bindCustomer(qCustomer, qhkCustomer, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDateFrom, qhkDateFrom, _ := qs.GetOK("dateFrom")
+	if err := o.bindDateFrom(qDateFrom, qhkDateFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDateTo, qhkDateTo, _ := qs.GetOK("dateTo")
+	if err := o.bindDateTo(qDateTo, qhkDateTo, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qMaxTotal, qhkMaxTotal, _ := qs.GetOK("maxTotal")
+	if err := o.bindMaxTotal(qMaxTotal, qhkMaxTotal, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinTotal, qhkMinTotal, _ := qs.GetOK("minTotal")
+	if err := o.bindMinTotal(qMinTotal, qhkMinTotal, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOffset, qhkOffset, _ := qs.GetOK("offset")
 	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
 		res = append(res, err)
@@ -89,12 +171,114 @@ func (o *ListOrdersParams) BindRequest(r *http.Request, route *middleware.Matche
 	if err := o.bindOrderBy(qOrderBy, qhkOrderBy, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qPaymentStatus, qhkPaymentStatus, _ := qs.GetOK("paymentStatus")
+	if err := o.bindPaymentStatus(qPaymentStatus, qhkPaymentStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qProductID, qhkProductID, _ := qs.GetOK("productId")
+	if err := o.bindProductID(qProductID, qhkProductID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearch, qhkSearch, _ := qs.GetOK("search")
+	if err := o.bindSearch(qSearch, qhkSearch, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindCurrency binds and validates parameter Currency from query.
+func (o *ListOrdersParams) bindCurrency(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Currency = &raw
+
+	return nil
+}
+
+// bindCustomer binds and validates parameter Customer from query.
+func (o *ListOrdersParams) bindCustomer(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Customer = &raw
+
+	return nil
+}
+
+// bindDateFrom binds and validates parameter DateFrom from query.
+func (o *ListOrdersParams) bindDateFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("dateFrom", "query", "int64", raw)
+	}
+	o.DateFrom = &value
+
+	return nil
+}
+
+// bindDateTo binds and validates parameter DateTo from query.
+func (o *ListOrdersParams) bindDateTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("dateTo", "query", "int64", raw)
+	}
+	o.DateTo = &value
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ListOrdersParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -119,6 +303,52 @@ func (o *ListOrdersParams) bindLimit(rawData []string, hasKey bool, formats strf
 	return nil
 }
 
+// bindMaxTotal binds and validates parameter MaxTotal from query.
+func (o *ListOrdersParams) bindMaxTotal(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("maxTotal", "query", "int64", raw)
+	}
+	o.MaxTotal = &value
+
+	return nil
+}
+
+// bindMinTotal binds and validates parameter MinTotal from query.
+func (o *ListOrdersParams) bindMinTotal(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("minTotal", "query", "int64", raw)
+	}
+	o.MinTotal = &value
+
+	return nil
+}
+
 // bindOffset binds and validates parameter Offset from query.
 func (o *ListOrdersParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -205,3 +435,93 @@ func (o *ListOrdersParams) validateOrderBy(formats strfmt.Registry) error {
 
 	return nil
 }
+
+// bindPaymentStatus binds and validates parameter PaymentStatus from query.
+func (o *ListOrdersParams) bindPaymentStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PaymentStatus = &raw
+
+	return nil
+}
+
+// bindProductID binds and validates parameter ProductID from query.
+func (o *ListOrdersParams) bindProductID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("productId", "query", "int64", raw)
+	}
+	o.ProductID = &value
+
+	return nil
+}
+
+// bindSearch binds and validates parameter Search from query.
+func (o *ListOrdersParams) bindSearch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Search = &raw
+
+	return nil
+}
+
+// bindStatus binds and validates array parameter Status from query.
+//
+// Arrays are parsed according to CollectionFormat: "csv" (defaults to "csv" when empty).
+func (o *ListOrdersParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvStatus string
+	if len(rawData) > 0 {
+		qvStatus = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat: csv
+	statusIC := swag.SplitByFormat(qvStatus, "csv")
+	if len(statusIC) == 0 {
+		return nil
+	}
+
+	var statusIR []string
+	for i, statusIV := range statusIC {
+		statusI := statusIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "status", i), "query", statusI, []interface{}{"pending_payment", "paid", "processing", "shipped", "delivered", "cancelled", "partially_refunded", "refunded"}, true); err != nil {
+			return err
+		}
+
+		statusIR = append(statusIR, statusI)
+	}
+
+	o.Status = statusIR
+
+	return nil
+}
@@ -15,10 +15,20 @@ import (
 
 // ListOrdersURL generates an URL for the list orders operation
 type ListOrdersURL struct {
-	Limit   *int32
-	Offset  *int64
-	Order   *string
-	OrderBy *string
+	Currency      *string
+	Customer      *string
+	DateFrom      *int64
+	DateTo        *int64
+	Limit         *int32
+	MaxTotal      *int64
+	MinTotal      *int64
+	Offset        *int64
+	Order         *string
+	OrderBy       *string
+	PaymentStatus *string
+	ProductID     *int64
+	Search        *string
+	Status        []string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -51,6 +61,38 @@ func (o *ListOrdersURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var currencyQ string
+	if o.Currency != nil {
+		currencyQ = *o.Currency
+	}
+	if currencyQ != "" {
+		qs.Set("currency", currencyQ)
+	}
+
+	var customerQ string
+	if o.Customer != nil {
+		customerQ = *o.Customer
+	}
+	if customerQ != "" {
+		qs.Set("customer", customerQ)
+	}
+
+	var dateFromQ string
+	if o.DateFrom != nil {
+		dateFromQ = swag.FormatInt64(*o.DateFrom)
+	}
+	if dateFromQ != "" {
+		qs.Set("dateFrom", dateFromQ)
+	}
+
+	var dateToQ string
+	if o.DateTo != nil {
+		dateToQ = swag.FormatInt64(*o.DateTo)
+	}
+	if dateToQ != "" {
+		qs.Set("dateTo", dateToQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt32(*o.Limit)
@@ -59,6 +101,22 @@ func (o *ListOrdersURL) Build() (*url.URL, error) {
 		qs.Set("limit", limitQ)
 	}
 
+	var maxTotalQ string
+	if o.MaxTotal != nil {
+		maxTotalQ = swag.FormatInt64(*o.MaxTotal)
+	}
+	if maxTotalQ != "" {
+		qs.Set("maxTotal", maxTotalQ)
+	}
+
+	var minTotalQ string
+	if o.MinTotal != nil {
+		minTotalQ = swag.FormatInt64(*o.MinTotal)
+	}
+	if minTotalQ != "" {
+		qs.Set("minTotal", minTotalQ)
+	}
+
 	var offsetQ string
 	if o.Offset != nil {
 		offsetQ = swag.FormatInt64(*o.Offset)
@@ -83,6 +141,47 @@ func (o *ListOrdersURL) Build() (*url.URL, error) {
 		qs.Set("orderBy", orderByQ)
 	}
 
+	var paymentStatusQ string
+	if o.PaymentStatus != nil {
+		paymentStatusQ = *o.PaymentStatus
+	}
+	if paymentStatusQ != "" {
+		qs.Set("paymentStatus", paymentStatusQ)
+	}
+
+	var productIDQ string
+	if o.ProductID != nil {
+		productIDQ = swag.FormatInt64(*o.ProductID)
+	}
+	if productIDQ != "" {
+		qs.Set("productId", productIDQ)
+	}
+
+	var searchQ string
+	if o.Search != nil {
+		searchQ = *o.Search
+	}
+	if searchQ != "" {
+		qs.Set("search", searchQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "csv")
+
+	if len(status) > 0 {
+		qsv := status[0]
+		if qsv != "" {
+			qs.Set("status", qsv)
+		}
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
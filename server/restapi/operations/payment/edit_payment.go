@@ -34,7 +34,7 @@ func NewEditPayment(ctx *middleware.Context, handler EditPaymentHandler) *EditPa
 /*
 	EditPayment swagger:route PUT /payments/{id} payment editPayment
 
-Edit payment by ID
+Replace payment by ID
 */
 type EditPayment struct {
 	Context *middleware.Context
@@ -6,6 +6,7 @@ package payment
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -36,6 +37,8 @@ type EditPaymentParams struct {
 	HTTPRequest *http.Request `json:"-"`
 
 	/*
+	  Full representation of the resource; every writable property must be given
+	  Required: true
 	  In: body
 	*/
 	Body *models.Payment
@@ -59,7 +62,11 @@ func (o *EditPaymentParams) BindRequest(r *http.Request, route *middleware.Match
 		defer r.Body.Close()
 		var body models.Payment
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
-			res = append(res, errors.NewParseError("body", "body", "", err))
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
 		} else {
 			// validate body object
 			if err := body.Validate(route.Formats); err != nil {
@@ -75,6 +82,8 @@ func (o *EditPaymentParams) BindRequest(r *http.Request, route *middleware.Match
 				o.Body = &body
 			}
 		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
 	}
 
 	rID, rhkID, _ := route.Params.GetOK("id")
@@ -6,6 +6,7 @@ package payments
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -34,6 +35,7 @@ type AddPaymentParams struct {
 	HTTPRequest *http.Request `json:"-"`
 
 	/*
+	  Required: true
 	  In: body
 	*/
 	Body *models.Payment
@@ -52,7 +54,11 @@ func (o *AddPaymentParams) BindRequest(r *http.Request, route *middleware.Matche
 		defer r.Body.Close()
 		var body models.Payment
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
-			res = append(res, errors.NewParseError("body", "body", "", err))
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
 		} else {
 			// validate body object
 			if err := body.Validate(route.Formats); err != nil {
@@ -68,6 +74,8 @@ func (o *AddPaymentParams) BindRequest(r *http.Request, route *middleware.Matche
 				o.Body = &body
 			}
 		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
 	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
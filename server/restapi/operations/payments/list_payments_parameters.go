@@ -13,6 +13,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewListPaymentsParams creates a new ListPaymentsParams object
@@ -48,6 +49,14 @@ type ListPaymentsParams struct {
 	  In: query
 	*/
 	Offset *int64
+	/*
+	  In: query
+	*/
+	OrderID *int64
+	/*
+	  In: query
+	*/
+	Status *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -70,6 +79,16 @@ func (o *ListPaymentsParams) BindRequest(r *http.Request, route *middleware.Matc
 	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qOrderID, qhkOrderID, _ := qs.GetOK("orderId")
+	if err := o.bindOrderID(qOrderID, qhkOrderID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -122,3 +141,58 @@ func (o *ListPaymentsParams) bindOffset(rawData []string, hasKey bool, formats s
 
 	return nil
 }
+
+// bindOrderID binds and validates parameter OrderID from query.
+func (o *ListPaymentsParams) bindOrderID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("orderId", "query", "int64", raw)
+	}
+	o.OrderID = &value
+
+	return nil
+}
+
+// bindStatus binds and validates parameter Status from query.
+func (o *ListPaymentsParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Status = &raw
+
+	if err := o.validateStatus(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStatus carries on validations for parameter Status
+func (o *ListPaymentsParams) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("status", "query", *o.Status, []interface{}{"intended", "requires_action", "processing", "authorized", "failed", "complete", "canceled", "partially_refunded", "refunded"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -15,8 +15,10 @@ import (
 
 // ListPaymentsURL generates an URL for the list payments operation
 type ListPaymentsURL struct {
-	Limit  *int32
-	Offset *int64
+	Limit   *int32
+	Offset  *int64
+	OrderID *int64
+	Status  *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -65,6 +67,22 @@ func (o *ListPaymentsURL) Build() (*url.URL, error) {
 		qs.Set("offset", offsetQ)
 	}
 
+	var orderIDQ string
+	if o.OrderID != nil {
+		orderIDQ = swag.FormatInt64(*o.OrderID)
+	}
+	if orderIDQ != "" {
+		qs.Set("orderId", orderIDQ)
+	}
+
+	var statusQ string
+	if o.Status != nil {
+		statusQ = *o.Status
+	}
+	if statusQ != "" {
+		qs.Set("status", statusQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -34,7 +34,7 @@ func NewEditProduct(ctx *middleware.Context, handler EditProductHandler) *EditPr
 /*
 	EditProduct swagger:route PUT /products/{id} product editProduct
 
-Edit product by ID
+Replace product by ID
 */
 type EditProduct struct {
 	Context *middleware.Context
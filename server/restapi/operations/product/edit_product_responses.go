@@ -23,6 +23,11 @@ swagger:response editProductOK
 */
 type EditProductOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewEditProductOK() *EditProductOK {
 	return &EditProductOK{}
 }
 
+// WithETag adds the eTag to the edit product o k response
+func (o *EditProductOK) WithETag(eTag string) *EditProductOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the edit product o k response
+func (o *EditProductOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the edit product o k response
 func (o *EditProductOK) WithPayload(payload *models.Product) *EditProductOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *EditProductOK) SetPayload(payload *models.Product) {
 // WriteResponse to the client
 func (o *EditProductOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,51 @@ func (o *EditProductOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// EditProductPreconditionFailedCode is the HTTP code returned for type EditProductPreconditionFailed
+const EditProductPreconditionFailedCode int = 412
+
+/*
+EditProductPreconditionFailed The resource has been modified since the given ETag
+
+swagger:response editProductPreconditionFailed
+*/
+type EditProductPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEditProductPreconditionFailed creates EditProductPreconditionFailed with default headers values
+func NewEditProductPreconditionFailed() *EditProductPreconditionFailed {
+
+	return &EditProductPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the edit product precondition failed response
+func (o *EditProductPreconditionFailed) WithPayload(payload *models.Error) *EditProductPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the edit product precondition failed response
+func (o *EditProductPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EditProductPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*
 EditProductDefault Error
 
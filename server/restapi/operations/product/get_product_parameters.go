@@ -36,6 +36,16 @@ type GetProductParams struct {
 	  In: path
 	*/
 	ID int64
+	/*
+	  ETags of the cached versions
+	  In: header
+	*/
+	IfNoneMatch *string
+	/*
+	  ISO 4217 code of the store currency to price in; the base currency by default
+	  In: header
+	*/
+	XCurrency *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -51,6 +61,14 @@ func (o *GetProductParams) BindRequest(r *http.Request, route *middleware.Matche
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXCurrency(r.Header[http.CanonicalHeaderKey("X-Currency")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,3 +93,37 @@ func (o *GetProductParams) bindID(rawData []string, hasKey bool, formats strfmt.
 
 	return nil
 }
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetProductParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
+// bindXCurrency binds and validates parameter XCurrency from header.
+func (o *GetProductParams) bindXCurrency(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XCurrency = &raw
+
+	return nil
+}
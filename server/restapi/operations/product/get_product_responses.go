@@ -23,6 +23,11 @@ swagger:response getProductOK
 */
 type GetProductOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewGetProductOK() *GetProductOK {
 	return &GetProductOK{}
 }
 
+// WithETag adds the eTag to the get product o k response
+func (o *GetProductOK) WithETag(eTag string) *GetProductOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get product o k response
+func (o *GetProductOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get product o k response
 func (o *GetProductOK) WithPayload(payload *models.Product) *GetProductOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *GetProductOK) SetPayload(payload *models.Product) {
 // WriteResponse to the client
 func (o *GetProductOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,54 @@ func (o *GetProductOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	}
 }
 
+// GetProductNotModifiedCode is the HTTP code returned for type GetProductNotModified
+const GetProductNotModifiedCode int = 304
+
+/*
+GetProductNotModified Not modified
+
+swagger:response getProductNotModified
+*/
+type GetProductNotModified struct {
+
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewGetProductNotModified creates GetProductNotModified with default headers values
+func NewGetProductNotModified() *GetProductNotModified {
+
+	return &GetProductNotModified{}
+}
+
+// WithETag adds the eTag to the get product not modified response
+func (o *GetProductNotModified) WithETag(eTag string) *GetProductNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get product not modified response
+func (o *GetProductNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *GetProductNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 /*
 GetProductDefault Error
 
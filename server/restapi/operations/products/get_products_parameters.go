@@ -58,6 +58,11 @@ type GetProductsParams struct {
 	  In: query
 	*/
 	Search *string
+	/*
+	  ISO 4217 code of the store currency to price in; the base currency by default
+	  In: header
+	*/
+	XCurrency *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -90,6 +95,10 @@ func (o *GetProductsParams) BindRequest(r *http.Request, route *middleware.Match
 	if err := o.bindSearch(qSearch, qhkSearch, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindXCurrency(r.Header[http.CanonicalHeaderKey("X-Currency")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -191,3 +200,20 @@ func (o *GetProductsParams) bindSearch(rawData []string, hasKey bool, formats st
 
 	return nil
 }
+
+// bindXCurrency binds and validates parameter XCurrency from header.
+func (o *GetProductsParams) bindXCurrency(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XCurrency = &raw
+
+	return nil
+}
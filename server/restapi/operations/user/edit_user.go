@@ -34,7 +34,7 @@ func NewEditUser(ctx *middleware.Context, handler EditUserHandler) *EditUser {
 /*
 	EditUser swagger:route PUT /users/{id} user editUser
 
-Edit user by ID
+Replace user by ID
 */
 type EditUser struct {
 	Context *middleware.Context
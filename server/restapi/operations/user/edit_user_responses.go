@@ -23,6 +23,11 @@ swagger:response editUserOK
 */
 type EditUserOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewEditUserOK() *EditUserOK {
 	return &EditUserOK{}
 }
 
+// WithETag adds the eTag to the edit user o k response
+func (o *EditUserOK) WithETag(eTag string) *EditUserOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the edit user o k response
+func (o *EditUserOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the edit user o k response
 func (o *EditUserOK) WithPayload(payload *models.User) *EditUserOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *EditUserOK) SetPayload(payload *models.User) {
 // WriteResponse to the client
 func (o *EditUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,51 @@ func (o *EditUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Prod
 	}
 }
 
+// EditUserPreconditionFailedCode is the HTTP code returned for type EditUserPreconditionFailed
+const EditUserPreconditionFailedCode int = 412
+
+/*
+EditUserPreconditionFailed The resource has been modified since the given ETag
+
+swagger:response editUserPreconditionFailed
+*/
+type EditUserPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEditUserPreconditionFailed creates EditUserPreconditionFailed with default headers values
+func NewEditUserPreconditionFailed() *EditUserPreconditionFailed {
+
+	return &EditUserPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the edit user precondition failed response
+func (o *EditUserPreconditionFailed) WithPayload(payload *models.Error) *EditUserPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the edit user precondition failed response
+func (o *EditUserPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EditUserPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*
 EditUserDefault Error
 
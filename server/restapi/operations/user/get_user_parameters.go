@@ -36,6 +36,11 @@ type GetUserParams struct {
 	  In: path
 	*/
 	ID int64
+	/*
+	  ETags of the cached versions
+	  In: header
+	*/
+	IfNoneMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -51,6 +56,10 @@ func (o *GetUserParams) BindRequest(r *http.Request, route *middleware.MatchedRo
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,3 +84,20 @@ func (o *GetUserParams) bindID(rawData []string, hasKey bool, formats strfmt.Reg
 
 	return nil
 }
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetUserParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
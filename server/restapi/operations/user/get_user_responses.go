@@ -23,6 +23,11 @@ swagger:response getUserOK
 */
 type GetUserOK struct {
 
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +40,17 @@ func NewGetUserOK() *GetUserOK {
 	return &GetUserOK{}
 }
 
+// WithETag adds the eTag to the get user o k response
+func (o *GetUserOK) WithETag(eTag string) *GetUserOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get user o k response
+func (o *GetUserOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get user o k response
 func (o *GetUserOK) WithPayload(payload *models.User) *GetUserOK {
 	o.Payload = payload
@@ -49,6 +65,13 @@ func (o *GetUserOK) SetPayload(payload *models.User) {
 // WriteResponse to the client
 func (o *GetUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +81,54 @@ func (o *GetUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produ
 	}
 }
 
+// GetUserNotModifiedCode is the HTTP code returned for type GetUserNotModified
+const GetUserNotModifiedCode int = 304
+
+/*
+GetUserNotModified Not modified
+
+swagger:response getUserNotModified
+*/
+type GetUserNotModified struct {
+
+	/*Version of the resource
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewGetUserNotModified creates GetUserNotModified with default headers values
+func NewGetUserNotModified() *GetUserNotModified {
+
+	return &GetUserNotModified{}
+}
+
+// WithETag adds the eTag to the get user not modified response
+func (o *GetUserNotModified) WithETag(eTag string) *GetUserNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get user not modified response
+func (o *GetUserNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *GetUserNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 /*
 GetUserDefault Error
 
@@ -0,0 +1,261 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/orders"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"io"
+	"strings"
+	"time"
+)
+
+// orderFilter is the admin order search shared by the order list and the order export
+type orderFilter struct {
+	Statuses  []string
+	DateFrom  *int64
+	DateTo    *int64
+	Customer  *string
+	ProductID *int64
+	// ISO 4217 code of the order currency
+	Currency *string
+	// bounds of the order total in the minor units of the order currency
+	MinTotal      *int64
+	MaxTotal      *int64
+	PaymentStatus *string
+	Search        *string
+}
+
+func orderFilterFromListParams(params *orders.ListOrdersParams) *orderFilter {
+	return &orderFilter{
+		Statuses:      params.Status,
+		DateFrom:      params.DateFrom,
+		DateTo:        params.DateTo,
+		Customer:      params.Customer,
+		ProductID:     params.ProductID,
+		Currency:      params.Currency,
+		MinTotal:      params.MinTotal,
+		MaxTotal:      params.MaxTotal,
+		PaymentStatus: params.PaymentStatus,
+		Search:        params.Search,
+	}
+}
+
+func orderFilterFromExportParams(params *orders.ExportOrdersParams) *orderFilter {
+	return &orderFilter{
+		Statuses:      params.Status,
+		DateFrom:      params.DateFrom,
+		DateTo:        params.DateTo,
+		Customer:      params.Customer,
+		ProductID:     params.ProductID,
+		Currency:      params.Currency,
+		MinTotal:      params.MinTotal,
+		MaxTotal:      params.MaxTotal,
+		PaymentStatus: params.PaymentStatus,
+		Search:        params.Search,
+	}
+}
+
+func (f *orderFilter) validate() errors.Error {
+	if f.DateFrom != nil && f.DateTo != nil && *f.DateFrom > *f.DateTo {
+		return errors.New(400, "Date from %d is after date to %d!", *f.DateFrom, *f.DateTo)
+	}
+	if f.MinTotal != nil && f.MaxTotal != nil && *f.MinTotal > *f.MaxTotal {
+		return errors.New(400, "Minimal total %d exceeds maximal total %d!", *f.MinTotal, *f.MaxTotal)
+	}
+	return nil
+}
+
+// apply adds the conditions of the filter to the query on the orders; the text is matched case-insensitively
+func (f *orderFilter) apply(query *bun.SelectQuery) *bun.SelectQuery {
+	if len(f.Statuses) > 0 {
+		query.Where("?TableAlias.status IN (?)", bun.In(f.Statuses))
+	}
+	if f.DateFrom != nil {
+		query.Where("?TableAlias.date_created >= ?", *f.DateFrom)
+	}
+	if f.DateTo != nil {
+		query.Where("?TableAlias.date_created <= ?", *f.DateTo)
+	}
+	if f.Customer != nil && strings.TrimSpace(*f.Customer) != "" {
+		pattern := likePattern(*f.Customer)
+		query.Where("(LOWER(?TableAlias.guest_email) LIKE ? ESCAPE '\\' OR ?TableAlias.user_id IN "+
+			"(SELECT cu.id FROM users AS cu WHERE LOWER(cu.email) LIKE ? ESCAPE '\\' OR LOWER(cu.name) LIKE ? ESCAPE '\\'))",
+			pattern, pattern, pattern)
+	}
+	if f.ProductID != nil {
+		query.Where("EXISTS (SELECT 1 FROM ordered_products AS fp WHERE fp.order_id = ?TableAlias.id AND fp.product_id = ?)",
+			*f.ProductID)
+	}
+	if f.Currency != nil && *f.Currency != "" {
+		query.Where("?TableAlias.currency = ?", *f.Currency)
+	}
+	if f.MinTotal != nil {
+		query.Where("?TableAlias.total_price_minor >= ?", *f.MinTotal)
+	}
+	if f.MaxTotal != nil {
+		query.Where("?TableAlias.total_price_minor <= ?", *f.MaxTotal)
+	}
+	if f.PaymentStatus != nil && *f.PaymentStatus != "" {
+		query.Where("EXISTS (SELECT 1 FROM payments AS fpm WHERE fpm.order_id = ?TableAlias.id AND fpm.status = ?)",
+			*f.PaymentStatus)
+	}
+	if f.Search != nil && strings.TrimSpace(*f.Search) != "" {
+		query.Where("LOWER(?TableAlias.delivery_info) LIKE ? ESCAPE '\\'", likePattern(*f.Search))
+	}
+	return query
+}
+
+// likePattern matches the text anywhere in lower case; its wildcards are escaped, so they match themselves
+func likePattern(text string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return "%" + escaper.Replace(strings.ToLower(strings.TrimSpace(text))) + "%"
+}
+
+const (
+	orderExportFormatCSV  = "csv"
+	orderExportFormatJSON = "json"
+)
+
+// orderExport streams the matching orders row by row, so the export is never loaded into memory
+type orderExport struct {
+	Format   string
+	FileName string
+	rows     *sql.Rows
+}
+
+func (e *orderExport) ContentType() string {
+	if e.Format == orderExportFormatJSON {
+		return "application/json"
+	}
+	return "text/csv"
+}
+
+func exportOrders(params *orders.ExportOrdersParams, principal *models.Principal) (*orderExport, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	filter := orderFilterFromExportParams(params)
+	err = filter.validate()
+	if err != nil {
+		return nil, err
+	}
+	format := orderExportFormatCSV
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	// the ordered products are joined, so an order spans the consecutive rows
+	query := db.NewSelect().Model((*dbModels.Order)(nil)).
+		ColumnExpr("?TableAlias.id, ?TableAlias.date_created, ?TableAlias.status").
+		ColumnExpr("COALESCE(u.email, ?TableAlias.guest_email, '') AS customer_email").
+		ColumnExpr("COALESCE(u.name, '') AS customer_name").
+		ColumnExpr("?TableAlias.currency, ?TableAlias.discount_total_minor, ?TableAlias.shipping_price_minor").
+		ColumnExpr("?TableAlias.tax_total_minor, ?TableAlias.total_price_minor, ?TableAlias.refunded_total_minor").
+		ColumnExpr("COALESCE((SELECT ps.status FROM payments AS ps WHERE ps.order_id = ?TableAlias.id " +
+			"ORDER BY ps.id DESC LIMIT 1), '') AS payment_status").
+		ColumnExpr("?TableAlias.shipping_method_name, ?TableAlias.shipping_name, ?TableAlias.shipping_line1").
+		ColumnExpr("?TableAlias.shipping_line2, ?TableAlias.shipping_city, ?TableAlias.shipping_postal_code").
+		ColumnExpr("?TableAlias.shipping_region, ?TableAlias.shipping_country, ?TableAlias.shipping_phone").
+		ColumnExpr("?TableAlias.delivery_info").
+		ColumnExpr("COALESCE(p.title, '') AS product_title, COALESCE(op.quantity, 0) AS product_quantity").
+		Join("LEFT JOIN users AS u ON u.id = ?TableAlias.user_id").
+		Join("LEFT JOIN ordered_products AS op ON op.order_id = ?TableAlias.id").
+		Join("LEFT JOIN products AS p ON p.id = op.product_id").
+		OrderExpr("?TableAlias.id ASC, op.id ASC")
+	filter.apply(query)
+	Logger.Debug("Built the query %s\n", query)
+
+	rows, sqlErr := query.Rows(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not export orders matching %v!\n", sqlErr, params)
+		return nil, errors.New(500, "ERROR: Could not export orders!")
+	}
+	return &orderExport{
+		Format:   format,
+		FileName: fmt.Sprintf("orders-%s.%s", time.Now().In(time.UTC).Format("20060102-150405"), format),
+		rows:     rows,
+	}, nil
+}
+
+// Stream writes the export and closes the underlying rows
+func (e *orderExport) Stream(ctx context.Context, w io.Writer) error {
+	defer e.rows.Close()
+
+	var csvWriter *csv.Writer
+	if e.Format == orderExportFormatJSON {
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+	} else {
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(dbModels.OrderExportHeader); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	write := func(row *dbModels.OrderExportRow) error {
+		count++
+		if csvWriter != nil {
+			return csvWriter.Write(row.CSVRecord())
+		}
+		bytes, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		if count > 1 {
+			if _, err = io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		_, err = w.Write(bytes)
+		return err
+	}
+
+	var current *dbModels.OrderExportRow
+	for e.rows.Next() {
+		row := new(dbModels.OrderExportRow)
+		if err := db.ScanRow(ctx, e.rows, row); err != nil {
+			return err
+		}
+		if current != nil && current.ID != row.ID {
+			if err := write(current); err != nil {
+				return err
+			}
+			current = nil
+		}
+		if current == nil {
+			current = row
+		}
+		if row.ProductTitle != "" {
+			if current.Products != "" {
+				current.Products += "; "
+			}
+			current.Products += fmt.Sprintf("%s x %d", row.ProductTitle, row.ProductQuantity)
+			current.ItemCount += row.ProductQuantity
+		}
+	}
+	if err := e.rows.Err(); err != nil {
+		return err
+	}
+	if current != nil {
+		if err := write(current); err != nil {
+			return err
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		return csvWriter.Error()
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
@@ -0,0 +1,105 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/orders"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestOrderFilterLikePattern(t *testing.T) {
+	newTestStore(t)
+	ctx := context.Background()
+	product := addTestProduct(t, 1000, 5)
+	for _, info := range []string{"50% off", "5000 off", "leave_at_door", "leaveXatXdoor", `back\slash`} {
+		order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+		if _, err := db.NewUpdate().TableExpr("orders").Set("delivery_info = ?", info).Set("guest_email = ?", info).
+			Where("id = ?", order.ID).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"50%", 1},
+		{"%", 1},
+		{"leave_at", 1},
+		{"_", 1},
+		{`\`, 1},
+		{"OFF", 2},
+		{"door", 2},
+	}
+	for _, tt := range tests {
+		for _, filter := range []*orderFilter{{Search: swag.String(tt.text)}, {Customer: swag.String(tt.text)}} {
+			count, err := filter.apply(db.NewSelect().Model((*dbModels.Order)(nil))).Count(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tt.want {
+				t.Errorf("orders matching %q = %d, want %d", tt.text, count, tt.want)
+			}
+		}
+	}
+}
+
+func TestExportOrdersCSV(t *testing.T) {
+	newTestStore(t)
+	ctx := context.Background()
+	formula := addTestProduct(t, 1000, 5)
+	if _, err := db.NewUpdate().TableExpr("products").Set("title = '=HYPERLINK(\"x\")'").
+		Where("id = ?", formula.ID).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	removed := addTestProduct(t, 2000, 5)
+	plain := addTestProduct(t, 3000, 5)
+	order := addTestOrder(t, models.OrderStatusPaid, 1, removed, formula, plain)
+	if _, err := db.NewUpdate().TableExpr("orders").Set("guest_email = '+1@example.com'").
+		Set("shipping_name = '@name'").Set("shipping_line1 = '-1 Street'").Set("delivery_info = 'Ring twice'").
+		Where("id = ?", order.ID).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.NewDelete().TableExpr("products").Where("id = ?", removed.ID).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := exportOrders(&orders.ExportOrdersParams{HTTPRequest: testRequest()}, testAdmin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if streamErr := export.Stream(ctx, &out); streamErr != nil {
+		t.Fatal(streamErr)
+	}
+	records, csvErr := csv.NewReader(&out).ReadAll()
+	if csvErr != nil {
+		t.Fatal(csvErr)
+	}
+	if len(records) != 2 {
+		t.Fatalf("CSV records = %d, want the header and one order", len(records))
+	}
+	row := make(map[string]string)
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+	want := map[string]string{
+		"customer_email": "'+1@example.com",
+		"products":       `'=HYPERLINK("x") x 1; Product 3000 x 1`,
+		"item_count":     "2",
+		"shipping_name":  "'@name",
+		"shipping_line1": "'-1 Street",
+		"delivery_info":  "Ring twice",
+		"total_price":    "60.00",
+	}
+	for column, value := range want {
+		if row[column] != value {
+			t.Errorf("%s = %q, want %q", column, row[column], value)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/order"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Roles of the actors allowed to move orders between statuses
+const (
+	orderActorCustomer = "customer"
+	orderActorAdmin    = "admin"
+	// payment and tracking webhooks and other background processes
+	orderActorSystem = "system"
+)
+
+// orderStatusTransitions maps the current order status to the statuses the order can be moved to
+// and the actor roles allowed to perform every such a transition.
+// Statuses absent from the map (cancelled, refunded) are final.
+var orderStatusTransitions = map[string]map[string][]string{
+	models.OrderStatusPendingPayment: {
+		models.OrderStatusPaid:      {orderActorAdmin, orderActorSystem},
+		models.OrderStatusCancelled: {orderActorCustomer, orderActorAdmin, orderActorSystem},
+		// the orders paid cash on delivery are fulfilled before they are paid
+		models.OrderStatusProcessing: {orderActorSystem},
+	},
+	models.OrderStatusPaid: {
+		models.OrderStatusProcessing:        {orderActorAdmin, orderActorSystem},
+		models.OrderStatusCancelled:         {orderActorAdmin},
+		models.OrderStatusPartiallyRefunded: {orderActorAdmin, orderActorSystem},
+		models.OrderStatusRefunded:          {orderActorAdmin, orderActorSystem},
+	},
+	models.OrderStatusProcessing: {
+		models.OrderStatusShipped:           {orderActorAdmin, orderActorSystem},
+		models.OrderStatusCancelled:         {orderActorAdmin},
+		models.OrderStatusPartiallyRefunded: {orderActorAdmin, orderActorSystem},
+		models.OrderStatusRefunded:          {orderActorAdmin, orderActorSystem},
+	},
+	models.OrderStatusShipped: {
+		models.OrderStatusDelivered:         {orderActorAdmin, orderActorSystem},
+		models.OrderStatusPartiallyRefunded: {orderActorAdmin, orderActorSystem},
+	},
+	models.OrderStatusDelivered: {
+		models.OrderStatusPartiallyRefunded: {orderActorAdmin, orderActorSystem},
+		models.OrderStatusRefunded:          {orderActorAdmin, orderActorSystem},
+	},
+	// the partially refunded orders may still be fulfilled
+	models.OrderStatusPartiallyRefunded: {
+		models.OrderStatusProcessing: {orderActorAdmin},
+		models.OrderStatusShipped:    {orderActorAdmin},
+		models.OrderStatusDelivered:  {orderActorAdmin},
+		models.OrderStatusCancelled:  {orderActorAdmin},
+		models.OrderStatusRefunded:   {orderActorAdmin, orderActorSystem},
+	},
+}
+
+func orderActorRoleOf(principal *models.Principal) (string, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return "", err
+	}
+	if isAdmin {
+		return orderActorAdmin, nil
+	}
+	return orderActorCustomer, nil
+}
+
+func checkOrderStatusTransition(fromStatus string, toStatus string, actorRole string) errors.Error {
+	targets, ok := orderStatusTransitions[fromStatus]
+	if !ok {
+		return errors.New(409, "Order in status '%s' cannot change its status!", fromStatus)
+	}
+	roles, ok := targets[toStatus]
+	if !ok {
+		return errors.New(409, "Order cannot be moved from status '%s' to status '%s'!", fromStatus, toStatus)
+	}
+	for _, role := range roles {
+		if role == actorRole {
+			return nil
+		}
+	}
+	return errors.New(403, "Role '%s' is not allowed to move order from status '%s' to status '%s'!",
+		actorRole, fromStatus, toStatus)
+}
+
+func changeOrderStatus(params *order.ChangeOrderStatusParams, principal *models.Principal) (*models.Order, errors.Error) {
+	actorRole, err := orderActorRoleOf(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	dbModel, err := getOrderFromDB(params.ID, actorRole == orderActorAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		return transitionOrderStatus(ctx, tx, dbModel, *params.Body.Status, actorRole, principal.User.ID, params.Body.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dbModel.Status == models.OrderStatusCancelled {
+		releaseOrderPayments(params.HTTPRequest.Context(), dbModel, actorRole, principal.User.ID)
+	}
+
+	dbModel.StatusHistory, err = getOrderStatusHistory(dbModel.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// transitionOrderStatus moves the order to the given status if the transition is allowed for the actor
+// and records the transition to the order status history
+func transitionOrderStatus(ctx context.Context, idb bun.IDB, dbModel *dbModels.Order, toStatus string, actorRole string, actorID int64, reason string) errors.Error {
+	fromStatus := dbModel.Status
+	err := checkOrderStatusTransition(fromStatus, toStatus, actorRole)
+	if err != nil {
+		Logger.Info("Rejected order %d status transition: %s", dbModel.ID, err.Error())
+		return err
+	}
+
+	dbModel.Status = toStatus
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	// the status is updated only if it has not been changed since it has been read, so the concurrent
+	// transitions cannot both succeed
+	query := idb.NewUpdate().Model(dbModel).Column("status", "date_updated").
+		Where("id = ?", dbModel.ID).
+		Where("status = ?", fromStatus)
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		dbModel.Status = fromStatus
+		Logger.Error("ERROR %v: Could not update order %d status to %s!\n", sqlErr, dbModel.ID, toStatus)
+		return errors.New(500, "ERROR: Could not update order %d status!", dbModel.ID)
+	}
+	if affected, sqlErr := res.RowsAffected(); sqlErr != nil || affected != 1 {
+		dbModel.Status = fromStatus
+		Logger.Info("Order %d status has been changed from %s concurrently; %s is not applied", dbModel.ID,
+			fromStatus, toStatus)
+		return errors.New(409, "Order %d status has been changed since it was %s; retry the request!",
+			dbModel.ID, fromStatus)
+	}
+	err = touchVersion(ctx, idb, "orders", dbModel.ID)
+	if err != nil {
+		return err
+	}
+
+	err = addOrderStatusHistory(ctx, idb, dbModel.ID, fromStatus, toStatus, actorRole, actorID, reason)
+	if err != nil {
+		return err
+	}
+
+	// the paid orders are invoiced and the refunded ones get the rest of their invoices credited;
+	// the cash of the delivered orders has been collected by the courier
+	switch toStatus {
+	case models.OrderStatusPaid:
+		return issueInvoice(ctx, idb, dbModel.ID)
+	case models.OrderStatusDelivered:
+		return collectCashOnDelivery(ctx, idb, dbModel.ID, actorRole, actorID)
+	case models.OrderStatusRefunded:
+		return issueCreditNote(ctx, idb, dbModel.ID, nil)
+	}
+	return nil
+}
+
+func addOrderStatusHistory(ctx context.Context, idb bun.IDB, orderID int64, fromStatus string, toStatus string, actorRole string, actorID int64, reason string) errors.Error {
+	entry := &dbModels.OrderStatusHistory{
+		ActorID:     actorID,
+		ActorRole:   actorRole,
+		DateCreated: time.Now().In(time.UTC).Unix(),
+		FromStatus:  fromStatus,
+		OrderID:     orderID,
+		Reason:      reason,
+		ToStatus:    toStatus,
+	}
+	query := idb.NewInsert().Model(entry).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add order %d status history entry %s -> %s!\n",
+			sqlErr, orderID, fromStatus, toStatus)
+		return errors.New(500, "ERROR: Could not add order %d status history!", orderID)
+	}
+	return nil
+}
+
+func getOrderStatusHistory(orderID int64) ([]*dbModels.OrderStatusHistory, errors.Error) {
+	history := make([]*dbModels.OrderStatusHistory, 0)
+	query := db.NewSelect().Model(&history).Where("order_id = ?", orderID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(context.Background())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d status history!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d status history!", orderID)
+	}
+	return history, nil
+}
@@ -0,0 +1,116 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/order"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestCheckOrderStatusTransition(t *testing.T) {
+	tests := []struct {
+		from     string
+		to       string
+		actor    string
+		wantCode int32
+	}{
+		{models.OrderStatusPendingPayment, models.OrderStatusCancelled, orderActorCustomer, 0},
+		{models.OrderStatusPendingPayment, models.OrderStatusPaid, orderActorSystem, 0},
+		{models.OrderStatusPendingPayment, models.OrderStatusPaid, orderActorCustomer, 403},
+		{models.OrderStatusPendingPayment, models.OrderStatusProcessing, orderActorAdmin, 403},
+		{models.OrderStatusPendingPayment, models.OrderStatusShipped, orderActorAdmin, 409},
+		{models.OrderStatusPaid, models.OrderStatusCancelled, orderActorCustomer, 403},
+		{models.OrderStatusPaid, models.OrderStatusCancelled, orderActorAdmin, 0},
+		{models.OrderStatusShipped, models.OrderStatusDelivered, orderActorSystem, 0},
+		{models.OrderStatusShipped, models.OrderStatusCancelled, orderActorAdmin, 409},
+		{models.OrderStatusPartiallyRefunded, models.OrderStatusShipped, orderActorAdmin, 0},
+		{models.OrderStatusPartiallyRefunded, models.OrderStatusShipped, orderActorSystem, 403},
+		{models.OrderStatusCancelled, models.OrderStatusPendingPayment, orderActorAdmin, 409},
+		{models.OrderStatusRefunded, models.OrderStatusPaid, orderActorSystem, 409},
+	}
+	for _, tt := range tests {
+		err := checkOrderStatusTransition(tt.from, tt.to, tt.actor)
+		if tt.wantCode == 0 && err != nil || tt.wantCode != 0 && (err == nil || err.Code() != tt.wantCode) {
+			t.Errorf("checkOrderStatusTransition(%s, %s, %s) = %v, want %d", tt.from, tt.to, tt.actor, err,
+				tt.wantCode)
+		}
+	}
+}
+
+func TestChangeOrderStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    string
+		principal *models.Principal
+		to        string
+		wantCode  int32
+	}{
+		{"customer cancels", models.OrderStatusPendingPayment, testCustomer(1), models.OrderStatusCancelled, 0},
+		{"customer pays", models.OrderStatusPendingPayment, testCustomer(1), models.OrderStatusPaid, 403},
+		{"other customer cancels", models.OrderStatusPendingPayment, testCustomer(2), models.OrderStatusCancelled, 404},
+		{"admin ships", models.OrderStatusProcessing, testAdmin(), models.OrderStatusShipped, 0},
+		{"admin skips shipping", models.OrderStatusPaid, testAdmin(), models.OrderStatusDelivered, 409},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			product := addTestProduct(t, 1000, 5)
+			dbOrder := addTestOrder(t, tt.status, 1, product)
+
+			result, err := changeOrderStatus(&order.ChangeOrderStatusParams{HTTPRequest: testRequest(), ID: dbOrder.ID,
+				Body: &models.OrderStatusChange{Status: swag.String(tt.to), Reason: tt.name}}, tt.principal)
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Errorf("changeOrderStatus() = %v, want %d", err, tt.wantCode)
+				}
+				if n := countTestRows(t, "orders", "id = ? AND status = ? AND version = 1", dbOrder.ID, tt.status); n != 1 {
+					t.Error("rejected transition changed the order")
+				}
+				if n := countTestRows(t, "order_status_history", "order_id = ?", dbOrder.ID); n != 0 {
+					t.Errorf("history entries of the rejected transition = %d, want none", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Status != tt.to || len(result.StatusHistory) != 1 {
+				t.Fatalf("order = %s with %d history entries, want %s with 1", result.Status,
+					len(result.StatusHistory), tt.to)
+			}
+			entry := result.StatusHistory[0]
+			if entry.FromStatus != tt.status || entry.ToStatus != tt.to || entry.ActorID != 1 || entry.Reason != tt.name {
+				t.Errorf("history entry = %+v, want %s -> %s by 1 for %q", entry, tt.status, tt.to, tt.name)
+			}
+		})
+	}
+}
+
+func TestTransitionOrderStatusOfStaleOrder(t *testing.T) {
+	newTestStore(t)
+	ctx := context.Background()
+	product := addTestProduct(t, 1000, 5)
+	dbOrder := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+	stale := &dbModels.Order{ID: dbOrder.ID, Status: dbOrder.Status}
+
+	if err := transitionOrderStatus(ctx, db, dbOrder, models.OrderStatusCancelled, orderActorCustomer, 1, "first"); err != nil {
+		t.Fatal(err)
+	}
+	// the concurrent transition read the order before it was cancelled
+	err := transitionOrderStatus(ctx, db, stale, models.OrderStatusPaid, orderActorSystem, 0, "second")
+	if err == nil || err.Code() != 409 {
+		t.Errorf("transitionOrderStatus() of the stale order = %v, want 409", err)
+	}
+	if stale.Status != models.OrderStatusPendingPayment {
+		t.Errorf("stale order status = %s, want it unchanged", stale.Status)
+	}
+	if n := countTestRows(t, "orders", "id = ? AND status = ? AND version = 2", dbOrder.ID, models.OrderStatusCancelled); n != 1 {
+		t.Error("order is not cancelled once")
+	}
+	if n := countTestRows(t, "order_status_history", "order_id = ?", dbOrder.ID); n != 1 {
+		t.Errorf("history entries = %d, want 1", n)
+	}
+}
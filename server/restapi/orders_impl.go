@@ -2,6 +2,7 @@ package restapi
 
 import (
 	"context"
+	"database/sql"
 	dbModels "estore-backend/server/database/models"
 	"estore-backend/server/models"
 	"estore-backend/server/restapi/operations/order"
@@ -9,7 +10,6 @@ import (
 	"fmt"
 	"github.com/go-openapi/errors"
 	"github.com/uptrace/bun"
-	"log"
 	"time"
 )
 
@@ -40,69 +40,111 @@ func addOrder(params *orders.AddOrderParams, principal *models.Principal) (*mode
 		Logger.Debug("Setting user ID %d for order %s", principal.User.ID, dbModel)
 		dbModel.UserID = principal.User.ID
 	}
+	// the order is priced in the requested currency or, if none is requested, in the one of its total price
+	currencyCode := params.XCurrency
+	if currencyCode == nil && item.TotalPrice != nil {
+		currencyCode = item.TotalPrice.Currency
+	}
+	dbModel.Currency, err = resolveCurrency(currencyCode, "")
+	if err != nil {
+		return nil, err
+	}
 	nowUnixEpoch := time.Now().In(time.UTC).Unix()
 	dbModel.DateCreated = nowUnixEpoch
 	dbModel.DateUpdated = nowUnixEpoch
-	dbModel.Status = "created"
+	dbModel.Status = models.OrderStatusPendingPayment
 
-	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
-	log.Printf("Built the query %s\n", query)
+	actorRole := orderActorCustomer
+	if isAdmin {
+		actorRole = orderActorAdmin
+	}
 
-	res, sqlErr := query.Exec(context.Background())
-	if sqlErr != nil {
-		return nil, errors.New(500, "ERROR %v: Could not add order %s!\n", sqlErr, item)
+	err = insertOrder(params.HTTPRequest.Context(), dbModel, item, actorRole, principal.User.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	id, sqlErr := res.LastInsertId()
-	if sqlErr != nil {
-		log.Printf("ERROR %v: Could not find last insert ID for order %s!", sqlErr, item)
-		return nil, errors.New(500, "ERROR: Could not add order %s!\n", item)
+	return dbModel.ToDTO(), nil
+}
+
+// insertOrder adds the new order with its products and prices them; the actor is recorded as the order creator
+func insertOrder(ctx context.Context, dbModel *dbModels.Order, item *models.Order, actorRole string, actorID int64) errors.Error {
+	if err := checkOrderedQuantities(item.Products); err != nil {
+		return err
 	}
+	dbModel.Version = 1
+	return runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		err := resolveOrderAddresses(ctx, tx, dbModel, item, nil)
+		if err != nil {
+			return err
+		}
 
-	dbModel.ID = id
+		// the null user ID of a guest order would be returned otherwise, hiding the last insert ID
+		query := tx.NewInsert().Model(dbModel).ExcludeColumn("id").Returning("NULL")
+		Logger.Debug("Built the query %s\n", query)
 
-	totalPrice, sqlErr := updateOrderedProductsIfNeeded(dbModel)
-	// it looks like bun does not support DB transactions in general :(
-	if sqlErr != nil {
-		Logger.Error("Could not add order %d products %s!\nERROR: %s (%v)\n",
-			id, dbModel.Products, err.Error(), err)
-		delQuery := db.NewDelete().Model(dbModel).Where("id = ?", id)
-		res, sqlDelErr := delQuery.Exec(context.Background())
-		if sqlDelErr != nil {
-			Logger.Error("ERROR %v: Could not add order %d products %s!\n"+
-				"ERROR: %v: Could not delete order %s (result: %s)\n",
-				sqlErr, id, dbModel.Products, sqlDelErr, dbModel, res)
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %v!\n", sqlErr, item)
+			return errors.New(500, "ERROR: Could not add order!")
 		}
-		return nil, errors.New(500, "ERROR: Could not add order %d products %s!",
-			id, dbModel.Products)
-	}
-	if *totalPrice != *dbModel.TotalPrice {
-		*dbModel.TotalPrice = *totalPrice
-		updQuery := db.NewUpdate().Model(dbModel).ExcludeColumn("id").ExcludeColumn("date_created").
-			ExcludeColumn("status").Column("total_price").Where("id = ?", dbModel.ID)
-		_, sqlErr = updQuery.Exec(context.Background())
+
+		id, sqlErr := res.LastInsertId()
 		if sqlErr != nil {
-			Logger.Error("ERROR %v:\nCould not update order %d total price to %f\n", sqlErr, id, totalPrice)
-			return nil, errors.New(500, "ERROR: Could not update order %d total price to %f", id, totalPrice)
+			Logger.Error("ERROR %v: Could not find last insert ID for order %v!", sqlErr, item)
+			return errors.New(500, "ERROR: Could not add order!")
 		}
-	}
+		dbModel.ID = id
 
-	return dbModel.ToDTO(), nil
+		err = addOrderStatusHistory(ctx, tx, id, "", dbModel.Status, actorRole, actorID, "Order created")
+		if err != nil {
+			return err
+		}
+
+		totalPrice, err := updateOrderedProductsIfNeeded(ctx, tx, dbModel)
+		if err != nil {
+			return err
+		}
+		dbModel.TotalPrice = totalPrice
+		updQuery := tx.NewUpdate().Model(dbModel).Column("currency", "total_price_minor", "discount_total_minor",
+			"coupon_code", "tax_total_minor", "prices_include_tax", "shipping_method_id", "shipping_method_name",
+			"shipping_price_minor").
+			Where("id = ?", dbModel.ID)
+		Logger.Debug("Built the query %s\n", updQuery)
+		_, sqlErr = updQuery.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v:\nCould not update order %d total price to %d\n", sqlErr, id, totalPrice)
+			return errors.New(500, "ERROR: Could not update order %d total price to %d", id, totalPrice)
+		}
+		return nil
+	})
 }
 
-func updateOrder(params *order.EditOrderParams, principal *models.Principal) errors.Error {
-	err := isPrincipalOwnerOrAdmin(principal, params.ID)
-	if err != nil {
-		return err
+// checkOrderedQuantities rejects the ordered products of no quantity, which would be priced at nothing
+// and would raise the stock when reserved
+func checkOrderedQuantities(products []*models.OrderedProduct) errors.Error {
+	for _, product := range products {
+		if product == nil || product.Quantity == nil || *product.Quantity < 1 {
+			return errors.New(400, "Ordered product quantity must be at least 1!")
+		}
 	}
+	return nil
+}
 
+// updateOrder replaces the order if it has not been modified since the version the If-Match header refers to;
+// the new version is returned. The customers may change their orders pending payment only, and the admins may
+// change the other ones without changing their products and prices.
+func updateOrder(params *order.EditOrderParams, principal *models.Principal) (int64, errors.Error) {
 	var item = params.Body
 	Logger.Debug("Updating item %s\n", item)
 	if item == nil {
-		return errors.New(400, "DB item cannot be nil!")
+		return 0, errors.New(400, "DB item cannot be nil!")
 	}
 	if item.Products == nil || len(item.Products) <= 0 {
-		return errors.New(400, "Order product list cannot be empty!")
+		return 0, errors.New(400, "Order product list cannot be empty!")
+	}
+	if err := checkOrderedQuantities(item.Products); err != nil {
+		return 0, err
 	}
 
 	dbModel := dbModels.NewOrderFrom(item)
@@ -112,110 +154,253 @@ func updateOrder(params *order.EditOrderParams, principal *models.Principal) err
 
 	isAdmin, err := isPrincipalAdmin(principal)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if !isAdmin && item.UserID > 0 && item.UserID != principal.User.ID {
-		return errors.New(403, "Attempt to update order of non-own user!")
-	}
-	if item.UserID < 1 {
-		Logger.Debug("Setting user ID %d for order %s", principal.User.ID, dbModel)
-		dbModel.User = dbModels.NewUserFrom(principal.User)
-		dbModel.UserID = principal.User.ID
+		return 0, errors.New(403, "Attempt to update order of non-own user!")
 	}
 
-	totalPrice, sqlErr := updateOrderedProductsIfNeeded(dbModel)
-	if sqlErr != nil {
-		Logger.Error("ERROR %v: Could not update order %d products!\n", sqlErr, params.ID)
-		return errors.New(500, "ERROR: Could not update order %d products!", params.ID)
-	}
-	Logger.Debug("Calculated total price: %f", *totalPrice)
-	*dbModel.TotalPrice = *totalPrice
-	Logger.Debug("Assigned new order total price: %f", *dbModel.TotalPrice)
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		err := checkOrderOwnerOrAdmin(ctx, tx, principal, params.ID)
+		if err != nil {
+			return err
+		}
+		version, err := checkIfMatch(ctx, tx, "orders", params.ID, params.IfMatch)
+		if err != nil {
+			return err
+		}
+		existing := new(dbModels.Order)
+		selQuery := tx.NewSelect().Model(existing).Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", selQuery)
+		sqlErr := selQuery.Scan(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, params.ID)
+			return errors.New(404, "Could not find order %d!", params.ID)
+		}
+		// the order keeps its user, or stays a guest order, unless the body names another one
+		if item.UserID < 1 {
+			Logger.Debug("Keeping user ID %d for order %s", existing.UserID, dbModel)
+			dbModel.User = &dbModels.User{ID: existing.UserID}
+			dbModel.UserID = existing.UserID
+		}
+		// the orders being paid for, paid or invoiced must keep the prices they are charged
+		isPriced := existing.Status != models.OrderStatusPendingPayment
+		if isPriced && !isAdmin {
+			return errors.New(409, "Order %d is %s and cannot be changed anymore!", params.ID, existing.Status)
+		}
+		err = resolveOrderAddresses(ctx, tx, dbModel, item, existing)
+		if err != nil {
+			return err
+		}
+		if dbModel.ShippingMethodID == 0 {
+			dbModel.ShippingMethodID = existing.ShippingMethodID
+		}
+		// the currency of an order is chosen when it is placed
+		dbModel.Currency = existing.Currency
 
-	nowUnixEpoch := time.Now().In(time.UTC).Unix()
-	dbModel.DateUpdated = nowUnixEpoch
-	Logger.Debug("Will update order record %s", *dbModel)
+		if isPriced {
+			err = checkOrderPricingUnchanged(ctx, tx, existing, dbModel)
+			if err != nil {
+				return err
+			}
+		} else {
+			totalPrice, err := updateOrderedProductsIfNeeded(ctx, tx, dbModel)
+			if err != nil {
+				Logger.Error("ERROR %v: Could not update order %d products!\n", err, params.ID)
+				return err
+			}
+			Logger.Debug("Calculated total price: %d", totalPrice)
+			dbModel.TotalPrice = totalPrice
+			Logger.Debug("Assigned new order total price: %d", dbModel.TotalPrice)
+		}
 
-	query := db.NewUpdate().Where("id = ?", params.ID).
-		Model(dbModel).ExcludeColumn("id").
-		ExcludeColumn("date_created").
-		ExcludeColumn("status")
-	log.Printf("Built the query %s\n", query)
+		nowUnixEpoch := time.Now().In(time.UTC).Unix()
+		dbModel.DateUpdated = nowUnixEpoch
+		dbModel.Version = version + 1
+		Logger.Debug("Will update order record %v", *dbModel)
+
+		query := tx.NewUpdate().Where("id = ?", params.ID).Where("COALESCE(version, 0) = ?", version).
+			Model(dbModel).ExcludeColumn("id").
+			ExcludeColumn("date_created").
+			ExcludeColumn("status").
+			ExcludeColumn("refunded_total_minor").
+			ExcludeColumn("guest_email")
+		if isPriced {
+			query.ExcludeColumn("currency", "total_price_minor", "discount_total_minor", "coupon_code",
+				"tax_total_minor", "prices_include_tax", "shipping_method_id", "shipping_method_name",
+				"shipping_price_minor")
+		}
+		Logger.Debug("Built the query %s\n", query)
 
-	_, sqlErr = query.Exec(context.Background())
-	if sqlErr != nil {
-		Logger.Error("ERROR %v: Could not update order %d!\n", sqlErr, params.ID)
-		return errors.New(500, "ERROR: Could not update order %d!", params.ID)
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not update order %d!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not update order %d!", params.ID)
+		}
+		return checkVersionedUpdate(res, "orders", params.ID)
+	})
+	if err != nil {
+		return 0, err
 	}
+	return dbModel.Version, nil
+}
 
+// checkOrderOwnerOrAdmin rejects the users other than the admins and the one the order belongs to
+func checkOrderOwnerOrAdmin(ctx context.Context, idb bun.IDB, principal *models.Principal, orderID int64) errors.Error {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil || isAdmin {
+		return err
+	}
+	var userID sql.NullInt64
+	query := idb.NewSelect().TableExpr("orders").Column("user_id").Where("id = ?", orderID)
+	Logger.Debug("Built the query %s\n", query)
+	sqlErr := query.Scan(ctx, &userID)
+	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return errors.New(404, "Could not find order %d!", orderID)
+		}
+		Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, orderID)
+		return errors.New(500, "ERROR: Could not find order %d!", orderID)
+	}
+	// the orders of the other users are not disclosed, like in the order list
+	if !userID.Valid || userID.Int64 != principal.User.ID {
+		return errors.New(404, "Could not find order %d!", orderID)
+	}
 	return nil
 }
 
-func updateDBOrder(dbModel *dbModels.Order) (*dbModels.Order, errors.Error) {
-	if dbModel == nil {
-		return nil, errors.New(500, "Empty order!")
+// checkOrderPricingUnchanged rejects the changes of the products, the coupon and the shipping method of an order
+// which is not pending payment anymore
+func checkOrderPricingUnchanged(ctx context.Context, idb bun.IDB, existing *dbModels.Order, dbModel *dbModels.Order) errors.Error {
+	if dbModel.CouponCode != existing.CouponCode || dbModel.ShippingMethodID != existing.ShippingMethodID {
+		return errors.New(409, "The coupon and the shipping method of %s order %d cannot be changed!",
+			existing.Status, existing.ID)
 	}
-	nowUnixEpoch := time.Now().In(time.UTC).Unix()
-	dbModel.DateUpdated = nowUnixEpoch
-	query := db.NewUpdate().Where("id = ?", dbModel.ID).
-		Model(dbModel).ExcludeColumn("id").ExcludeColumn("date_created")
+	existingProducts := make([]*dbModels.OrderedProduct, 0)
+	query := idb.NewSelect().Model(&existingProducts).Where("order_id = ?", existing.ID)
 	Logger.Debug("Built the query %s\n", query)
-
-	_, err := query.Exec(context.Background())
-	if err != nil {
-		Logger.Error("ERROR %v (%s): Could not update order %d!\n", err, err, dbModel.ID)
-		return nil, errors.New(500, "Could not update order %d!", dbModel.ID)
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d products!\n", sqlErr, existing.ID)
+		return errors.New(500, "ERROR: Could not find order %d products!", existing.ID)
 	}
-
-	return dbModel, nil
+	quantities := make(map[int64]int64)
+	for _, product := range existingProducts {
+		quantities[*product.ProductID] += *product.Quantity
+	}
+	for _, product := range dbModel.Products {
+		quantities[*product.ProductID] -= *product.Quantity
+	}
+	for _, quantity := range quantities {
+		if quantity != 0 {
+			return errors.New(409, "The products of %s order %d cannot be changed!", existing.Status, existing.ID)
+		}
+	}
+	return nil
 }
 
 func deleteOrder(params *order.DeleteOrderParams, principal *models.Principal) errors.Error {
-	err := isPrincipalOwnerOrAdmin(principal, params.ID)
-	if err != nil {
-		return err
-	}
-
 	// either SQLite DB driver do not actually support cascade deletion by foreign keys
 	// despite of declared PRAGMA foreign_keys,
 	// or bun ORM forms foreign key expression wrong, but the FK constraint with ON DELETE CASCADE
 	// presents in the DB table create expression but do not work...
-	query := db.NewDelete().TableExpr("ordered_products").Where("order_id = ?", params.ID)
-	Logger.Debug("Built the query %s\n", query)
-	_, sqlErr := query.Exec(context.Background())
-	if sqlErr != nil {
-		Logger.Error("ERROR %v: Could not delete order %d products!\n", sqlErr, params.ID)
-	}
-
-	query = db.NewDelete().TableExpr("orders").Where("id = ?", params.ID)
-	Logger.Debug("Built the query %s\n", query)
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		err := checkOrderOwnerOrAdmin(ctx, tx, principal, params.ID)
+		if err != nil {
+			return err
+		}
+		// the issued invoices and credit notes must be kept, so are their orders
+		issued, err := findInvoices(ctx, tx, params.ID, "")
+		if err != nil {
+			return err
+		}
+		if len(issued) > 0 {
+			return errors.New(409, "Order %d has been invoiced and cannot be deleted!", params.ID)
+		}
+		for _, table := range []string{"ordered_products", "order_status_history", "order_discounts",
+			"promotion_redemptions", "order_tax_lines", "shipments", "order_returns",
+			"order_message_attachments", "order_messages"} {
+			query := tx.NewDelete().TableExpr(table).Where("order_id = ?", params.ID)
+			Logger.Debug("Built the query %s\n", query)
+			_, sqlErr := query.Exec(ctx)
+			if sqlErr != nil {
+				Logger.Error("ERROR %v: Could not delete order %d %s!\n", sqlErr, params.ID, table)
+				return errors.New(500, "ERROR: Could not delete order %d!", params.ID)
+			}
+		}
 
-	_, sqlErr = query.Exec(context.Background())
-	if sqlErr != nil {
-		Logger.Error("ERROR %v: Could not delete order %d!\n", sqlErr, params.ID)
-		return errors.New(500, "ERROR: Could not delete order %d!", params.ID)
-	}
+		query := tx.NewDelete().TableExpr("orders").Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
 
-	return nil
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete order %d!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete order %d!", params.ID)
+		}
+		return nil
+	})
 }
 
-func getOrder(params *order.GetOrderParams, principal *models.Principal) (result *models.Order, err errors.Error) {
-	err = isPrincipalOwnerOrAdmin(principal, params.ID)
+// patchOrder applies the merge patch to the order, whose prices are recalculated then; the new version is returned
+func patchOrder(params *order.PatchOrderParams, principal *models.Principal) (*models.Order, int64, errors.Error) {
+	getParams := &order.GetOrderParams{HTTPRequest: params.HTTPRequest, ID: params.ID}
+	current, version, err := getOrder(getParams, principal)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
+	err = checkPatchPrecondition("orders", params.ID, params.IfMatch, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	patch, err := patchObject(params.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	// the address snapshots take precedence over the address book entries, so an entry given alone replaces them
+	if _, ok := patch["shippingAddressId"]; ok && patch["shippingAddress"] == nil {
+		current.ShippingAddress = nil
+	}
+	if _, ok := patch["billingAddressId"]; ok && patch["billingAddress"] == nil {
+		current.BillingAddress = nil
+	}
+	item := new(models.Order)
+	err = applyMergePatch("order", current, patch, item)
+	if err != nil {
+		return nil, 0, err
+	}
+	item.ID = params.ID
+
+	_, err = updateOrder(&order.EditOrderParams{
+		HTTPRequest: params.HTTPRequest,
+		ID:          params.ID,
+		IfMatch:     entityETag(version),
+		Body:        item,
+	}, principal)
+	if err != nil {
+		return nil, 0, err
+	}
+	return getOrder(getParams, principal)
+}
+
+// getOrder finds the order; the customers find their own orders only
+func getOrder(params *order.GetOrderParams, principal *models.Principal) (result *models.Order, version int64, err errors.Error) {
 	isAdmin, err := isPrincipalAdmin(principal)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	dbModel, err := getOrderFromDB(params.ID, isAdmin, principal.User.ID)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	dbModel.StatusHistory, err = getOrderStatusHistory(dbModel.ID)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	result = dbModel.ToDTO()
-	return result, nil
+	return result, dbModel.Version, nil
 }
 
 func getOrderFromDB(orderId int64, isAdmin bool, userId int64) (*dbModels.Order, errors.Error) {
@@ -228,6 +413,9 @@ func getOrderFromDB(orderId int64, isAdmin bool, userId int64) (*dbModels.Order,
 
 	sqlErr := query.Scan(context.Background())
 	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return nil, errors.New(404, "Could not find order %d!", orderId)
+		}
 		return nil, errors.New(500, "ERROR %v: Could not find order %d!\n", sqlErr, orderId)
 	}
 
@@ -240,10 +428,16 @@ func allOrders(params *orders.ListOrdersParams, principal *models.Principal) (re
 	if err != nil {
 		return nil, err
 	}
+	filter := orderFilterFromListParams(params)
+	err = filter.validate()
+	if err != nil {
+		return nil, err
+	}
 	dbModel := make([]*dbModels.Order, 0)
 
 	query := db.NewSelect().Model(&dbModel)
 	query = queryOrder(query, isAdmin, principal.User.ID)
+	filter.apply(query)
 	if params.Limit != nil {
 		query.Limit(int(*params.Limit))
 	}
@@ -262,7 +456,7 @@ func allOrders(params *orders.ListOrdersParams, principal *models.Principal) (re
 	sqlErr := query.Scan(context.Background())
 	if sqlErr != nil {
 		Logger.Error("ERROR %v: Could not find orders matching %s!\n", sqlErr, params)
-		return nil, errors.New(500, "ERROR: Could not find orders matching %s!", params)
+		return nil, errors.New(500, "ERROR: Could not find orders matching %v!", params)
 	}
 
 	Logger.Debug("Fetched orders %s\n", dbModel)
@@ -283,18 +477,25 @@ func queryOrder(query *bun.SelectQuery, isAdmin bool, userID int64) *bun.SelectQ
 			Logger.Debug("Built the query %s\n", bunQuery)
 			return bunQuery
 		})
+	query.Relation("Discounts")
+	query.Relation("Taxes")
+	query.Relation("Returns")
 	if !isAdmin {
 		query.Where("user_id = ?", userID)
 	}
 	return query
 }
 
-func updateOrderedProductsIfNeeded(order *dbModels.Order) (*float64, error) {
+// updateOrderedProductsIfNeeded replaces the order products and prices the order in its currency, the base one
+// if it has none; the total price is returned in its minor units
+func updateOrderedProductsIfNeeded(ctx context.Context, idb bun.IDB, order *dbModels.Order) (int64, errors.Error) {
 	Logger.Debug("Updating ordered products for order %s\n%s\n", order, *order)
-	delQuery := db.NewDelete().Table("ordered_products").Where("order_id = ?", order.ID)
-	_, err := delQuery.Exec(context.Background())
+	delQuery := idb.NewDelete().Table("ordered_products").Where("order_id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", delQuery)
+	_, err := delQuery.Exec(ctx)
 	if err != nil {
-		Logger.Error("Could not delete order %d products!\n", order.ID)
+		Logger.Error("ERROR %v: Could not delete order %d products!\n", err, order.ID)
+		return 0, errors.New(500, "Could not delete order %d products!", order.ID)
 	}
 	productIDs := make([]int64, len(order.Products))
 	for i, product := range order.Products {
@@ -304,52 +505,78 @@ func updateOrderedProductsIfNeeded(order *dbModels.Order) (*float64, error) {
 		productIDs[i] = *product.ProductID
 	}
 	var actualProducts []*dbModels.Product = make([]*dbModels.Product, 0)
-	selQuery := db.NewSelect().Model(&actualProducts).Where("id in (?)", bun.In(productIDs))
+	selQuery := idb.NewSelect().Model(&actualProducts).Where("id in (?)", bun.In(productIDs))
 	Logger.Debug("Built the query %s\n", selQuery)
-	err = selQuery.Scan(context.Background())
+	err = selQuery.Scan(ctx)
 	if err != nil {
 		Logger.Error("ERROR %v:\nCould not find products for order %s!", err, order)
-		return nil, errors.New(500, "Could not find products for order %s!", order)
+		return 0, errors.New(500, "Could not find products for order %v!", order)
 	}
 	Logger.Debug("Actual products: %s\n", actualProducts)
+	if order.Currency == "" {
+		order.Currency = baseCurrency()
+	}
+	converter, calcErr := newCurrencyConverter(ctx, idb, order.Currency)
+	if calcErr != nil {
+		return 0, calcErr
+	}
 	var isFound bool
-	var totalPrice float64 = 0
-	var productTotalPrice *float64 = nil
+	var totalPrice int64 = 0
 	for _, product := range order.Products {
 		isFound = false
 		for _, actualProduct := range actualProducts {
 			if *product.ProductID == actualProduct.ID {
-				productTotalPrice = CalculateProductTotalPrice(actualProduct, product.Quantity)
-				product.TotalPrice = productTotalPrice
-				Logger.Debug("Found product %d (%s); total price is %f, assigned total price is %f\n",
-					actualProduct.ID, *productTotalPrice, actualProduct.Price)
+				unitPrice, calcErr := converter.productPrice(actualProduct)
+				if calcErr != nil {
+					return 0, calcErr
+				}
+				product.TotalPrice = CalculateProductTotalPrice(unitPrice, product.Quantity)
+				Logger.Debug("Found product %d; total price is %d, unit price is %d %s\n",
+					actualProduct.ID, product.TotalPrice, unitPrice, order.Currency)
 				isFound = true
-				totalPrice += *productTotalPrice
+				totalPrice += product.TotalPrice
 				break
 			}
 		}
 		if !isFound {
 			Logger.Debug("Not found product for %d", product.ProductID)
-			product.TotalPrice = nil
+			product.TotalPrice = 0
 		}
 	}
 
-	query := db.NewInsert().Model(&order.Products).ExcludeColumn("id")
+	calcErr = applyShipping(ctx, idb, order, converter, actualProducts, totalPrice)
+	if calcErr != nil {
+		return 0, calcErr
+	}
+	discountTotal, calcErr := applyPromotions(ctx, idb, order, converter, totalPrice)
+	if calcErr != nil {
+		return 0, calcErr
+	}
+	totalPrice += order.ShippingPrice - discountTotal
+
+	taxTotal, calcErr := applyTaxes(ctx, idb, order, actualProducts)
+	if calcErr != nil {
+		return 0, calcErr
+	}
+	if !order.PricesIncludeTax {
+		totalPrice += taxTotal
+	}
+
+	query := idb.NewInsert().Model(&order.Products).ExcludeColumn("id")
 	Logger.Debug("Built the query %s\n", query)
 
-	_, err = query.Exec(context.Background())
+	_, err = query.Exec(ctx)
 	if err != nil {
 		Logger.Error("ERROR %v: Could not update order %d products %s!", err, order.ID, order.Products)
-		return nil, errors.New(500,
-			"ERROR %s: Could not update order %d products %s!", err.Error(), order.ID, order.Products)
+		return 0, errors.New(500,
+			"ERROR %v: Could not update order %d products %v!", err.Error(), order.ID, order.Products)
 	} else {
 		Logger.Debug("Updated order %d products %s", order.ID, order.Products)
 	}
-	Logger.Debug("Returning order total price: %f", totalPrice)
-	return &totalPrice, nil
+	Logger.Debug("Returning order total price: %d", totalPrice)
+	return totalPrice, nil
 }
 
-func CalculateProductTotalPrice(actualProduct *dbModels.Product, quantity *int64) *float64 {
-	res := actualProduct.Price * float64(*quantity)
-	return &res
+func CalculateProductTotalPrice(unitPrice int64, quantity *int64) int64 {
+	return unitPrice * *quantity
 }
@@ -0,0 +1,107 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/order"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestCheckOrderedQuantities(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity *int64
+		wantErr  bool
+	}{
+		{"one", swag.Int64(1), false},
+		{"many", swag.Int64(5), false},
+		{"zero", swag.Int64(0), true},
+		{"negative", swag.Int64(-2), true},
+		{"missing", nil, true},
+	}
+	for _, tt := range tests {
+		products := []*models.OrderedProduct{{ProductID: swag.Int64(1), Quantity: swag.Int64(1)},
+			{ProductID: swag.Int64(2), Quantity: tt.quantity}}
+		err := checkOrderedQuantities(products)
+		if (err != nil) != tt.wantErr || (err != nil && err.Code() != 400) {
+			t.Errorf("%s: checkOrderedQuantities() = %v, want an error %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestInsertOrderOfNoQuantity(t *testing.T) {
+	newTestStore(t)
+	product := addTestProduct(t, 1000, 5)
+	item := &models.Order{Products: []*models.OrderedProduct{{ProductID: swag.Int64(product.ID),
+		Quantity: swag.Int64(0)}}}
+	dbModel := dbModels.NewOrderFrom(item)
+	dbModel.Status = models.OrderStatusPendingPayment
+	err := insertOrder(context.Background(), dbModel, item, orderActorCustomer, 1)
+	if err == nil || err.Code() != 400 {
+		t.Errorf("insertOrder() of no quantity = %v, want 400", err)
+	}
+	if n := countTestRows(t, "orders", "1 = 1"); n != 0 {
+		t.Errorf("orders = %d, want none", n)
+	}
+}
+
+func TestCreateOrderCheckoutSessionOfNoQuantity(t *testing.T) {
+	newTestStore(t)
+	registerTestGateway(t)
+	product := addTestProduct(t, 1000, 5)
+	order := addTestOrder(t, models.OrderStatusPendingPayment, 0, product)
+
+	_, err := createOrderCheckoutSession(context.Background(), order, order.UserID)
+	if err == nil || err.Code() != 409 {
+		t.Errorf("createOrderCheckoutSession() of no quantity = %v, want 409", err)
+	}
+	if n := countTestRows(t, "payments", "order_id = ?", order.ID); n != 0 {
+		t.Errorf("payments = %d, want none", n)
+	}
+}
+
+func TestOrderWritesRollback(t *testing.T) {
+	newTestStore(t)
+	ctx := context.Background()
+	product := addTestProduct(t, 1000, 5)
+	newItem := func(quantity int64, couponCode string) *models.Order {
+		return &models.Order{CouponCode: couponCode, ShippingAddress: testAddress(), Products: []*models.OrderedProduct{
+			{ProductID: swag.Int64(product.ID), Quantity: swag.Int64(quantity)}}}
+	}
+
+	// the coupon is checked after the order and its products have been inserted
+	item := newItem(1, "UNKNOWN")
+	dbModel := dbModels.NewOrderFrom(item)
+	dbModel.Status = models.OrderStatusPendingPayment
+	dbModel.UserID = 1
+	if err := insertOrder(ctx, dbModel, item, orderActorCustomer, 1); err == nil || err.Code() != 400 {
+		t.Fatalf("insertOrder() with an unknown coupon = %v, want 400", err)
+	}
+	for _, table := range []string{"orders", "ordered_products", "order_status_history", "promotion_redemptions"} {
+		if n := countTestRows(t, table, "1 = 1"); n != 0 {
+			t.Errorf("%s of the failed order = %d, want none", table, n)
+		}
+	}
+
+	item = newItem(1, "")
+	dbModel = dbModels.NewOrderFrom(item)
+	dbModel.Status = models.OrderStatusPendingPayment
+	dbModel.UserID = 1
+	if err := insertOrder(ctx, dbModel, item, orderActorCustomer, 1); err != nil {
+		t.Fatal(err)
+	}
+	_, err := updateOrder(&order.EditOrderParams{HTTPRequest: testRequest(), ID: dbModel.ID, IfMatch: entityETag(1),
+		Body: newItem(3, "UNKNOWN")}, testCustomer(1))
+	if err == nil || err.Code() != 400 {
+		t.Fatalf("updateOrder() with an unknown coupon = %v, want 400", err)
+	}
+	if n := countTestRows(t, "ordered_products", "order_id = ? AND quantity = 1", dbModel.ID); n != 1 {
+		t.Error("failed update replaced the ordered products")
+	}
+	if n := countTestRows(t, "orders", "id = ? AND version = 1 AND total_price_minor = 1000", dbModel.ID); n != 1 {
+		t.Error("failed update changed the order")
+	}
+}
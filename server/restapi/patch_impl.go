@@ -0,0 +1,204 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"estore-backend/server/models"
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fullReplacementOperations maps the PUT operations replacing the whole resource to the definitions of their bodies
+var fullReplacementOperations = map[string]string{
+	"editProduct":  "product",
+	"editCategory": "category",
+	"editOrder":    "order",
+	"editUser":     "user",
+	"editPayment":  "payment",
+}
+
+var (
+	apiDefinitions     spec.Definitions
+	apiDefinitionsOnce sync.Once
+)
+
+// specDefinition finds the definition of the embedded API specification by name
+func specDefinition(name string) (*spec.Schema, errors.Error) {
+	apiDefinitionsOnce.Do(func() {
+		document, err := loads.Analyzed(SwaggerJSON, "")
+		if err != nil {
+			Logger.Error("ERROR %v: Could not load the API specification!\n", err)
+			return
+		}
+		apiDefinitions = document.Spec().Definitions
+	})
+	definition, ok := apiDefinitions[name]
+	if !ok {
+		return nil, errors.New(500, "ERROR: Could not find definition %s!", name)
+	}
+	return &definition, nil
+}
+
+// writableProperties lists the properties of the definition the clients may set, sorted by name
+func writableProperties(definition *spec.Schema) []string {
+	result := make([]string, 0, len(definition.Properties))
+	for name, property := range definition.Properties {
+		if !property.ReadOnly {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// mergePatch applies the JSON merge patch (RFC 7396) to the target document: the null values remove the members,
+// the objects are merged recursively and any other value replaces the target one
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+		} else {
+			targetObject[name] = mergePatch(targetObject[name], value)
+		}
+	}
+	return targetObject
+}
+
+// patchObject checks that the merge patch is a JSON object
+func patchObject(patch interface{}) (map[string]interface{}, errors.Error) {
+	object, ok := patch.(map[string]interface{})
+	if !ok {
+		return nil, errors.New(400, "The merge patch must be a JSON object!")
+	}
+	return object, nil
+}
+
+// applyMergePatch applies the merge patch to the current representation of the resource and decodes the result
+// into the given model, which is validated then; only the writable properties of the definition may be patched
+func applyMergePatch(definitionName string, current interface{}, patch interface{},
+	result interface{ Validate(strfmt.Registry) error }) errors.Error {
+	object, err := patchObject(patch)
+	if err != nil {
+		return err
+	}
+	definition, err := specDefinition(definitionName)
+	if err != nil {
+		return err
+	}
+	for name := range object {
+		property, ok := definition.Properties[name]
+		if !ok {
+			return errors.New(422, "Unknown property %s of %s!", name, definitionName)
+		}
+		if property.ReadOnly {
+			return errors.New(422, "Property %s of %s is read-only!", name, definitionName)
+		}
+	}
+
+	currentJSON, jsonErr := json.Marshal(current)
+	if jsonErr != nil {
+		Logger.Error("ERROR %v: Could not encode %s %v!\n", jsonErr, definitionName, current)
+		return errors.New(500, "ERROR: Could not patch %s!", definitionName)
+	}
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(currentJSON))
+	decoder.UseNumber()
+	if jsonErr = decoder.Decode(&document); jsonErr != nil {
+		Logger.Error("ERROR %v: Could not decode %s %s!\n", jsonErr, definitionName, currentJSON)
+		return errors.New(500, "ERROR: Could not patch %s!", definitionName)
+	}
+
+	patchedJSON, jsonErr := json.Marshal(mergePatch(document, object))
+	if jsonErr != nil {
+		Logger.Error("ERROR %v: Could not encode patched %s!\n", jsonErr, definitionName)
+		return errors.New(500, "ERROR: Could not patch %s!", definitionName)
+	}
+	if jsonErr = json.Unmarshal(patchedJSON, result); jsonErr != nil {
+		return errors.New(422, "Patched %s is invalid: %v", definitionName, jsonErr)
+	}
+	if jsonErr = result.Validate(strfmt.Default); jsonErr != nil {
+		return errors.New(422, "Patched %s is invalid: %v", definitionName, jsonErr)
+	}
+	return nil
+}
+
+// checkPatchPrecondition checks the optional If-Match header of a patch against the version the patch is applied to
+func checkPatchPrecondition(table string, id int64, ifMatch *string, version int64) errors.Error {
+	if ifMatch != nil && !etagMatches(*ifMatch, version, false) {
+		return errors.New(412, "Record %d of %s has been modified; its current ETag is %s!", id, table,
+			entityETag(version))
+	}
+	return nil
+}
+
+// requireFullRepresentation rejects the bodies of the PUT operations replacing the whole resource that miss any of
+// the writable properties, so a partial body never clears the omitted properties; the null values are accepted
+func requireFullRepresentation(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if r.Method != http.MethodPut || route == nil || route.Operation == nil || r.Body == nil {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		definitionName, ok := fullReplacementOperations[route.Operation.ID]
+		if !ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		body, readErr := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		var object map[string]json.RawMessage
+		if readErr != nil || json.Unmarshal(body, &object) != nil || object == nil {
+			// the binding reports the malformed bodies
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		definition, err := specDefinition(definitionName)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		missing := make([]string, 0)
+		for _, name := range writableProperties(definition) {
+			if _, ok := object[name]; !ok {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			writeError(w, errors.New(400, "Properties %s of %s are missing; PUT replaces the whole resource, "+
+				"use PATCH to update some of the properties!", strings.Join(missing, ", "), definitionName))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// writeError responds with the error outside the operation handlers
+func writeError(w http.ResponseWriter, err errors.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(err.Code()))
+	jsonErr := json.NewEncoder(w).Encode(&models.Error{Httpcode: int64(err.Code()), Message: swag.String(err.Error())})
+	if jsonErr != nil {
+		Logger.Error("ERROR %v: Could not write error %v!\n", jsonErr, err)
+	}
+}
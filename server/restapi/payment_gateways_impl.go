@@ -0,0 +1,175 @@
+package restapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PaymentLineItem is a line of the charged order as shown by the payment gateway;
+// the amounts of the gateway types are in the minor units of the currency
+type PaymentLineItem struct {
+	Name       string
+	UnitAmount int64
+	Quantity   int64
+}
+
+// CheckoutSessionRequest describes the order a checkout session charges
+type CheckoutSessionRequest struct {
+	OrderID int64
+	// ISO 4217 code of the currency of the order
+	Currency  string
+	LineItems []*PaymentLineItem
+	// discounts of the order; the line items carry the prices before them
+	DiscountAmount int64
+	// total amount to charge, the line items minus the discounts
+	Amount int64
+	// URL the customer returns to after the payment; {CHECKOUT_SESSION_ID} is replaced with the session ID
+	ReturnURL string
+}
+
+// GatewayCheckoutSession is a checkout session of a payment gateway
+type GatewayCheckoutSession struct {
+	ID string
+	// secret of the embedded checkout
+	ClientSecret string
+	// hosted payment page to redirect the customer to; empty for the embedded checkout
+	URL             string
+	Status          string
+	PaymentStatus   string
+	PaymentIntentID string
+	CustomerEmail   string
+	// total amount charged by the session and its currency
+	AmountTotal int64
+	Currency    string
+}
+
+// GatewayRefund is a refund issued by a payment gateway
+type GatewayRefund struct {
+	ID     string `json:"id"`
+	Amount int64  `json:"amount"`
+	// status of the refund as reported by the gateway (e. g., "succeeded")
+	Status string `json:"status"`
+}
+
+// the gateway independent types of the payment events
+const (
+	paymentEventCheckoutCompleted = "checkout.completed"
+	paymentEventCheckoutExpired   = "checkout.expired"
+	paymentEventCheckoutFailed    = "checkout.failed"
+	paymentEventPaymentCreated    = "payment.created"
+	paymentEventPaymentSucceeded  = "payment.succeeded"
+	paymentEventPaymentFailed     = "payment.failed"
+	paymentEventPaymentCanceled   = "payment.canceled"
+	paymentEventPaymentUpdated    = "payment.updated"
+	paymentEventPaymentRefunded   = "payment.refunded"
+	paymentEventRefundUpdated     = "refund.updated"
+)
+
+// PaymentEvent is a webhook event of a payment gateway
+type PaymentEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// time the event has occurred at in Unix seconds; the events may be delivered out of order
+	Created           int64  `json:"created,omitempty"`
+	CheckoutSessionID string `json:"checkoutSessionId,omitempty"`
+	PaymentIntentID   string `json:"paymentIntentId,omitempty"`
+	// order the payment intent has been created for, as tagged by the checkout session
+	OrderID int64 `json:"orderId,omitempty"`
+	// status of the checkout session (e. g., "complete")
+	SessionStatus string `json:"sessionStatus,omitempty"`
+	// status of the payment as reported by the gateway (e. g., "paid")
+	PaymentStatus string `json:"paymentStatus,omitempty"`
+	// reason of the failed or canceled payment (e. g., a card decline code) and its message
+	FailureCode    string `json:"failureCode,omitempty"`
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// total refunded amount of the payment
+	RefundedAmount int64 `json:"refundedAmount,omitempty"`
+	// refunds of the payment; the updated refund only for the refund events
+	Refunds []*GatewayRefund `json:"refunds,omitempty"`
+}
+
+// PaymentGateway is an integration with a payment provider
+type PaymentGateway interface {
+	// Name is the name the payments are recorded with
+	Name() string
+
+	// CreateCheckoutSession starts the checkout of the order
+	CreateCheckoutSession(ctx context.Context, request *CheckoutSessionRequest) (*GatewayCheckoutSession, error)
+
+	// GetCheckoutSession retrieves the current state of the checkout session
+	GetCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error)
+
+	// ExpireCheckoutSession expires the open checkout session, so it can no longer be paid
+	ExpireCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error)
+
+	// CapturePayment captures the amount of the authorized payment
+	CapturePayment(ctx context.Context, paymentIntentID string, amount int64) error
+
+	// RefundPayment refunds the amount of the payment; the reference (e. g., an RMA number) is attached to the refund
+	// and identifies it, so refunding the payment again with the same reference returns the refund issued already
+	RefundPayment(ctx context.Context, paymentIntentID string, amount int64, reference string) (*GatewayRefund, error)
+
+	// ParseWebhookEvent verifies the signature of the webhook payload and parses its event
+	ParseWebhookEvent(payload []byte, header http.Header) (*PaymentEvent, error)
+}
+
+const (
+	stripeGatewayName = "stripe"
+	fakeGatewayName   = "fake"
+)
+
+var paymentGateways = make(map[string]PaymentGateway)
+
+func normalizePaymentGatewayName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func registerPaymentGateway(gateway PaymentGateway) {
+	paymentGateways[normalizePaymentGatewayName(gateway.Name())] = gateway
+}
+
+// getPaymentGateway returns the gateway by name or nil if it is not enabled;
+// the payments recorded before the gateways were named have been made through Stripe
+func getPaymentGateway(name string) PaymentGateway {
+	if normalizePaymentGatewayName(name) == "" {
+		name = stripeGatewayName
+	}
+	return paymentGateways[normalizePaymentGatewayName(name)]
+}
+
+// activePaymentGateway returns the gateway the new checkout sessions are created with
+func activePaymentGateway() PaymentGateway {
+	return getPaymentGateway(ApiConfiguration.Payments.Gateway)
+}
+
+// registerPaymentGateways enables Stripe and, if it is the active gateway, the fake one; the fake payments
+// can be completed by anyone, so the fake gateway is never enabled next to a real one
+func registerPaymentGateways() {
+	registerPaymentGateway(newStripeGateway(ApiConfiguration.Payments.Stripe.Secret,
+		ApiConfiguration.Payments.Stripe.PaymentWebhookSecret))
+	if normalizePaymentGatewayName(ApiConfiguration.Payments.Gateway) != fakeGatewayName &&
+		ApiConfiguration.Payments.Fake.WebhookSecret != "" {
+		Logger.Warn("Payments.Fake.webhookSecret is ignored; the fake payment gateway is enabled only as the active one")
+	}
+	if normalizePaymentGatewayName(ApiConfiguration.Payments.Gateway) == fakeGatewayName {
+		webhookSecret := ApiConfiguration.Payments.Fake.WebhookSecret
+		if webhookSecret == "" {
+			// the events are delivered in process, so a random secret suffices unless they are sent from outside
+			secret := make([]byte, 32)
+			if _, err := rand.Read(secret); err != nil {
+				panic(fmt.Sprintf("Error: %s\nCould not generate fake payment gateway webhook secret", err.Error()))
+			}
+			webhookSecret = hex.EncodeToString(secret)
+		}
+		registerPaymentGateway(newFakeGateway(webhookSecret, ApiConfiguration.AppHost, deliverFakePaymentEvent))
+		Logger.Info("Registered the fake payment gateway; no real payments are made through it")
+	}
+	if activePaymentGateway() == nil {
+		Logger.Error("Payment gateway %s is not supported; checkout is not available",
+			ApiConfiguration.Payments.Gateway)
+	}
+}
@@ -0,0 +1,288 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/reconciliations"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultReconciliationInterval = 60 * 60
+	// the open checkout sessions are expired after a day, as Stripe does by default
+	defaultCheckoutSessionTimeout = 24 * 60 * 60
+)
+
+// paymentEventReconciled is the type of the events the reconciliation applies the gateway statuses with
+const paymentEventReconciled = "payment.reconciled"
+
+// reconciliationMutex keeps the scheduled and the manual reconciliations from running at once
+var reconciliationMutex sync.Mutex
+
+func reconciliationInterval() int64 {
+	if ApiConfiguration.Payments.Reconciliation.Interval == 0 {
+		return defaultReconciliationInterval
+	}
+	return ApiConfiguration.Payments.Reconciliation.Interval
+}
+
+func checkoutSessionTimeout() int64 {
+	if ApiConfiguration.Payments.Reconciliation.SessionTimeout > 0 {
+		return ApiConfiguration.Payments.Reconciliation.SessionTimeout
+	}
+	return defaultCheckoutSessionTimeout
+}
+
+// startPaymentReconciliation reconciles the payments with their gateways periodically, so the payments
+// whose webhook events have been missed do not stay open forever
+func startPaymentReconciliation() {
+	interval := reconciliationInterval()
+	if interval < 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			report, err := reconcilePayments(context.Background(), models.PaymentReconciliationTriggerScheduled, 0)
+			if err != nil {
+				Logger.Error("Could not reconcile the payments: %s", err.Error())
+				continue
+			}
+			Logger.Info("Reconciled %d payments: %d fixed, %d sessions expired, %d errors, %d discrepancies",
+				report.Checked, report.Fixed, report.Expired, report.Errors, len(report.Discrepancies))
+		}
+	}()
+}
+
+// reconcilePayments checks the online payments which are not final against the checkout sessions of their
+// gateways and stores the report of the discrepancies found
+func reconcilePayments(ctx context.Context, trigger string, actorID int64) (*dbModels.PaymentReconciliation, errors.Error) {
+	if !reconciliationMutex.TryLock() {
+		return nil, errors.New(409, "A payment reconciliation is already running!")
+	}
+	defer reconciliationMutex.Unlock()
+
+	report := &dbModels.PaymentReconciliation{
+		ActorID:       actorID,
+		DateCreated:   time.Now().In(time.UTC).Unix(),
+		Discrepancies: make([]*dbModels.PaymentDiscrepancy, 0),
+		Trigger:       trigger,
+	}
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := db.NewSelect().Model(&dbPayments).
+		Where("status IN (?)", bun.In(paymentStatusesOpen)).
+		Where("checkout_session_id != ''").
+		Where("method IN (?)", bun.In([]string{"", models.PaymentMethodOnline})).
+		Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find the open payments!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find the open payments!")
+	}
+	for _, payment := range dbPayments {
+		report.Checked++
+		if discrepancy := reconcilePayment(ctx, report, payment); discrepancy != nil {
+			report.Discrepancies = append(report.Discrepancies, discrepancy)
+		}
+	}
+
+	report.DateFinished = time.Now().In(time.UTC).Unix()
+	insertQuery := db.NewInsert().Model(report).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", insertQuery)
+	res, sqlErr := insertQuery.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not store the payment reconciliation report!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not store the payment reconciliation report!")
+	}
+	report.ID, sqlErr = res.LastInsertId()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find last insert ID for the payment reconciliation report!", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not store the payment reconciliation report!")
+	}
+	return report, nil
+}
+
+// reconcilePayment expires the stale checkout session of the payment and sets the payment status to the one
+// of the session; the discrepancy found is returned and counted in the report, nil if there is none
+func reconcilePayment(ctx context.Context, report *dbModels.PaymentReconciliation, payment *dbModels.Payment) *dbModels.PaymentDiscrepancy {
+	discrepancy := &dbModels.PaymentDiscrepancy{
+		PaymentID:         payment.ID,
+		OrderID:           payment.OrderID,
+		Gateway:           payment.Gateway,
+		CheckoutSessionID: payment.CheckoutSessionID,
+		PaymentIntentID:   payment.PaymentIntentId,
+		Status:            payment.Status,
+		Amount:            payment.Amount,
+		Currency:          payment.Currency,
+	}
+	reportError := func(message string) *dbModels.PaymentDiscrepancy {
+		Logger.Error("Could not reconcile payment %d: %s", payment.ID, message)
+		report.Errors++
+		discrepancy.Resolution = models.PaymentDiscrepancyResolutionError
+		discrepancy.Message = message
+		return discrepancy
+	}
+
+	gateway := getPaymentGateway(payment.Gateway)
+	if gateway == nil {
+		return reportError(fmt.Sprintf("Payment gateway %s is not available", payment.Gateway))
+	}
+	discrepancy.Gateway = gateway.Name()
+	sess, err := gateway.GetCheckoutSession(ctx, payment.CheckoutSessionID)
+	if err != nil {
+		return reportError(fmt.Sprintf("Could not retrieve checkout session: %s", err.Error()))
+	}
+	expired := false
+	if sess.Status == "open" && payment.DateCreated+checkoutSessionTimeout() < report.DateCreated {
+		if sess, err = gateway.ExpireCheckoutSession(ctx, payment.CheckoutSessionID); err != nil {
+			return reportError(fmt.Sprintf("Could not expire the stale checkout session: %s", err.Error()))
+		}
+		Logger.Info("Expired stale checkout session %s of payment %d", payment.CheckoutSessionID, payment.ID)
+		report.Expired++
+		expired = true
+	}
+	status := checkoutSessionPaymentStatus(sess)
+	discrepancy.GatewayStatus = status
+	discrepancy.GatewayAmount = sess.AmountTotal
+	discrepancy.GatewayCurrency = strings.ToUpper(sess.Currency)
+	if sess.PaymentIntentID != "" {
+		discrepancy.PaymentIntentID = sess.PaymentIntentID
+	}
+
+	amountDiffers := sess.AmountTotal > 0 && (sess.AmountTotal != discrepancy.Amount ||
+		discrepancy.GatewayCurrency != discrepancy.Currency)
+	statusDiffers := status != "" && !paymentStatusesMatch(payment.Status, status)
+	if !expired && !statusDiffers && !amountDiffers {
+		return nil
+	}
+
+	messages := make([]string, 0, 2)
+	discrepancy.Resolution = models.PaymentDiscrepancyResolutionReported
+	if statusDiffers {
+		event := &PaymentEvent{
+			ID:                fmt.Sprintf("reconciliation_%d_%d", report.DateCreated, payment.ID),
+			Type:              paymentEventReconciled,
+			Created:           time.Now().In(time.UTC).Unix(),
+			CheckoutSessionID: sess.ID,
+			PaymentIntentID:   sess.PaymentIntentID,
+			SessionStatus:     sess.Status,
+			PaymentStatus:     sess.PaymentStatus,
+		}
+		if sess.Status == "expired" {
+			event.FailureCode = checkoutSessionExpiredCode
+			event.FailureMessage = checkoutSessionExpiredMessage
+		}
+		if applyErr := applyPaymentEvent(payment, event, status); applyErr != nil {
+			return reportError(fmt.Sprintf("Could not set the payment status to %s: %s", status, applyErr.Error()))
+		}
+		if payment.Status == status {
+			report.Fixed++
+			discrepancy.Resolution = models.PaymentDiscrepancyResolutionFixed
+		} else {
+			// the payment has been changed by an event in the meantime
+			messages = append(messages, fmt.Sprintf("The payment status is %s now", payment.Status))
+		}
+	}
+	if expired {
+		discrepancy.Resolution = models.PaymentDiscrepancyResolutionExpired
+	}
+	if amountDiffers && discrepancy.GatewayCurrency != discrepancy.Currency {
+		messages = append(messages, fmt.Sprintf("The gateway charges %s",
+			money.New(discrepancy.GatewayAmount, discrepancy.GatewayCurrency)))
+	} else if amountDiffers {
+		messages = append(messages, fmt.Sprintf("The gateway amount differs by %s",
+			money.New(sess.AmountTotal-discrepancy.Amount, discrepancy.Currency)))
+	}
+	discrepancy.Message = strings.Join(messages, "; ")
+	return discrepancy
+}
+
+// checkoutSessionPaymentStatus returns the payment status of the checkout session or an empty string if it is unknown
+func checkoutSessionPaymentStatus(sess *GatewayCheckoutSession) string {
+	switch sess.Status {
+	case "expired":
+		return paymentStatusCanceled
+	case "open":
+		// the open sessions have not been paid yet unless the customer is authenticating the payment
+		status := gatewayPaymentStatuses[strings.ToLower(sess.PaymentStatus)]
+		if status == "" || status == paymentStatusProcessing {
+			return paymentStatusIntended
+		}
+		return status
+	}
+	return gatewayPaymentStatuses[strings.ToLower(sess.PaymentStatus)]
+}
+
+// paymentStatusesMatch tells whether the gateway status agrees with the one of the payment; the gateways
+// report the declined payments as waiting for another payment method
+func paymentStatusesMatch(status string, gatewayStatus string) bool {
+	return status == gatewayStatus || (status == paymentStatusFailed && gatewayStatus == paymentStatusIntended)
+}
+
+func allPaymentReconciliations(params *reconciliations.ListPaymentReconciliationsParams, principal *models.Principal) ([]*models.PaymentReconciliation, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbReconciliations := make([]*dbModels.PaymentReconciliation, 0)
+	query := db.NewSelect().Model(&dbReconciliations)
+	if params.Limit != nil {
+		query.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		query.Offset(int(*params.Offset))
+	}
+	query.Order("id DESC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find payment reconciliations!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find payment reconciliations!")
+	}
+	return dbModels.PaymentReconciliationDTOsFromPaymentReconciliations(dbReconciliations), nil
+}
+
+func getPaymentReconciliation(params *reconciliations.GetPaymentReconciliationParams, principal *models.Principal) (*models.PaymentReconciliation, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := new(dbModels.PaymentReconciliation)
+	query := db.NewSelect().Model(dbModel).Where("id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return nil, errors.New(404, "Could not find payment reconciliation %d!", params.ID)
+		}
+		Logger.Error("ERROR %v: Could not find payment reconciliation %d!\n", sqlErr, params.ID)
+		return nil, errors.New(500, "ERROR: Could not find payment reconciliation %d!", params.ID)
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func runPaymentReconciliation(params *reconciliations.ReconcilePaymentsParams, principal *models.Principal) (*models.PaymentReconciliation, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	report, err := reconcilePayments(params.HTTPRequest.Context(), models.PaymentReconciliationTriggerManual,
+		principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	return report.ToDTO(), nil
+}
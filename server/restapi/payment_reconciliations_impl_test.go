@@ -0,0 +1,130 @@
+package restapi
+
+import (
+	"context"
+	"estore-backend/server/models"
+	"testing"
+	"time"
+)
+
+func TestReconcilePayments(t *testing.T) {
+	now := time.Now().Unix()
+	tests := []struct {
+		name string
+		// amount charged by the checkout session; the order total is 1000
+		sessionAmount int64
+		// outcome chosen on the payment page, none if the session is left open
+		outcome string
+		// status the payment is recorded in and its creation time
+		status      string
+		dateCreated int64
+		// checkout session of the payment is unknown to the gateway
+		unknownSession bool
+		// reconciled payments and their counts in the report
+		wantChecked, wantFixed, wantExpired, wantErrors int64
+		// resolution of the discrepancy, empty if there is none
+		wantResolution  string
+		wantStatus      string
+		wantOrderStatus string
+	}{
+		{"open", 1000, "", paymentStatusIntended, now, false, 1, 0, 0, 0, "",
+			paymentStatusIntended, models.OrderStatusPendingPayment},
+		{"declined", 1000, fakePaymentFailed, paymentStatusFailed, now, false, 1, 0, 0, 0, "",
+			paymentStatusFailed, models.OrderStatusPendingPayment},
+		{"paid with the webhook missed", 1000, fakePaymentSucceeded, paymentStatusIntended, now, false, 1, 1, 0, 0,
+			models.PaymentDiscrepancyResolutionFixed, paymentStatusComplete, models.OrderStatusPaid},
+		{"canceled with the webhook missed", 1000, fakePaymentCanceled, paymentStatusIntended, now, false, 1, 1, 0, 0,
+			models.PaymentDiscrepancyResolutionFixed, paymentStatusCanceled, models.OrderStatusPendingPayment},
+		{"stale session", 1000, "", paymentStatusIntended, now - defaultCheckoutSessionTimeout - 60, false, 1, 1, 1, 0,
+			models.PaymentDiscrepancyResolutionExpired, paymentStatusCanceled, models.OrderStatusPendingPayment},
+		{"amount differs", 900, "", paymentStatusIntended, now, false, 1, 0, 0, 0,
+			models.PaymentDiscrepancyResolutionReported, paymentStatusIntended, models.OrderStatusPendingPayment},
+		{"unknown session", 1000, "", paymentStatusIntended, now, true, 1, 0, 0, 1,
+			models.PaymentDiscrepancyResolutionError, paymentStatusIntended, models.OrderStatusPendingPayment},
+		{"complete", 1000, fakePaymentSucceeded, paymentStatusComplete, now, false, 0, 0, 0, 0, "",
+			paymentStatusComplete, models.OrderStatusPendingPayment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			gateway := newFakeGateway("secret", "", nil)
+			registerPaymentGateway(gateway)
+			t.Cleanup(func() { delete(paymentGateways, fakeGatewayName) })
+			ctx := context.Background()
+			product := addTestProduct(t, 1000, 5)
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+			sess, err := gateway.CreateCheckoutSession(ctx, &CheckoutSessionRequest{OrderID: order.ID,
+				Currency: order.Currency, Amount: tt.sessionAmount})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.outcome != "" {
+				if _, err = gateway.Complete(sess.ID, tt.outcome); err != nil {
+					t.Fatal(err)
+				}
+			}
+			payment := addTestPayment(t, order, tt.status)
+			payment.Gateway = fakeGatewayName
+			payment.CheckoutSessionID = sess.ID
+			if tt.unknownSession {
+				payment.CheckoutSessionID = "fake_cs_unknown"
+			}
+			payment.PaymentIntentId = sess.PaymentIntentID
+			payment.DateCreated = tt.dateCreated
+			if _, err = db.NewUpdate().Model(payment).
+				Column("gateway", "checkout_session_id", "payment_intent_id", "date_created").
+				Where("id = ?", payment.ID).Exec(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			report, recErr := reconcilePayments(ctx, models.PaymentReconciliationTriggerManual, 1)
+			if recErr != nil {
+				t.Fatal(recErr)
+			}
+			if report.Checked != tt.wantChecked || report.Fixed != tt.wantFixed || report.Expired != tt.wantExpired ||
+				report.Errors != tt.wantErrors {
+				t.Errorf("report = %d checked, %d fixed, %d expired, %d errors, want %d, %d, %d, %d", report.Checked,
+					report.Fixed, report.Expired, report.Errors, tt.wantChecked, tt.wantFixed, tt.wantExpired,
+					tt.wantErrors)
+			}
+			resolution := ""
+			if len(report.Discrepancies) > 0 {
+				resolution = report.Discrepancies[0].Resolution
+			}
+			if len(report.Discrepancies) > 1 || resolution != tt.wantResolution {
+				t.Errorf("%d discrepancies resolved %q, want one resolved %q", len(report.Discrepancies),
+					resolution, tt.wantResolution)
+			}
+			if n := countTestRows(t, "payment_reconciliations", "id = ?", report.ID); n != 1 {
+				t.Error("report has not been stored")
+			}
+
+			updated, dbErr := getDBPayment(ctx, db, payment.ID)
+			if dbErr != nil {
+				t.Fatal(dbErr)
+			}
+			if updated.Status != tt.wantStatus {
+				t.Errorf("payment = %s, want %s", updated.Status, tt.wantStatus)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+			if tt.wantExpired > 0 && updated.FailureCode != checkoutSessionExpiredCode {
+				t.Errorf("payment failure code = %q, want %q", updated.FailureCode, checkoutSessionExpiredCode)
+			}
+		})
+	}
+}
+
+func TestReconcilePaymentsRunning(t *testing.T) {
+	newTestStore(t)
+	reconciliationMutex.Lock()
+	_, err := reconcilePayments(context.Background(), models.PaymentReconciliationTriggerManual, 1)
+	reconciliationMutex.Unlock()
+	if err == nil || err.Code() != 409 {
+		t.Errorf("reconcilePayments() while another runs = %v, want 409", err)
+	}
+	if n := countTestRows(t, "payment_reconciliations", "1 = 1"); n != 0 {
+		t.Errorf("reports = %d, want none", n)
+	}
+}
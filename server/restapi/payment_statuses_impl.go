@@ -0,0 +1,317 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"time"
+)
+
+// Statuses of the payments
+const (
+	// waiting for the customer to pay
+	paymentStatusIntended = "intended"
+	// waiting for the customer to authenticate the payment (e. g., 3D Secure)
+	paymentStatusRequiresAction = "requires_action"
+	// the payment method is being charged (e. g., bank debits)
+	paymentStatusProcessing = "processing"
+	// authorized and waiting to be captured
+	paymentStatusAuthorized = "authorized"
+	// the last attempt has been declined; the customer may try again
+	paymentStatusFailed            = "failed"
+	paymentStatusComplete          = "complete"
+	paymentStatusCanceled          = "canceled"
+	paymentStatusPartiallyRefunded = "partially_refunded"
+	paymentStatusRefunded          = "refunded"
+)
+
+// gatewayPaymentStatuses maps the payment statuses reported by the payment gateways (the PaymentIntent
+// statuses and the checkout session payment statuses) to the payment statuses
+var gatewayPaymentStatuses = map[string]string{
+	"requires_payment_method": paymentStatusIntended,
+	"requires_confirmation":   paymentStatusIntended,
+	"requires_action":         paymentStatusRequiresAction,
+	"processing":              paymentStatusProcessing,
+	"requires_capture":        paymentStatusAuthorized,
+	"succeeded":               paymentStatusComplete,
+	"canceled":                paymentStatusCanceled,
+	"paid":                    paymentStatusComplete,
+	"no_payment_required":     paymentStatusComplete,
+	// the checkout is completed before the delayed payment methods are charged
+	"unpaid": paymentStatusProcessing,
+}
+
+// paymentStatusRanks orders the statuses along the payment lifecycle; the events of the same time
+// may only move the payment forward
+var paymentStatusRanks = map[string]int{
+	paymentStatusIntended:          0,
+	paymentStatusFailed:            1,
+	paymentStatusRequiresAction:    1,
+	paymentStatusProcessing:        2,
+	paymentStatusAuthorized:        3,
+	paymentStatusComplete:          4,
+	paymentStatusCanceled:          4,
+	paymentStatusPartiallyRefunded: 5,
+	paymentStatusRefunded:          5,
+}
+
+// paymentStatusesOpen are the statuses of the payments which may still be paid
+var paymentStatusesOpen = []string{paymentStatusIntended, paymentStatusRequiresAction, paymentStatusProcessing,
+	paymentStatusAuthorized, paymentStatusFailed}
+
+// paymentStatusesRefundable are the statuses of the payments which have been charged
+var paymentStatusesRefundable = []string{paymentStatusComplete, paymentStatusPartiallyRefunded, paymentStatusRefunded}
+
+func isPaymentRefundable(status string) bool {
+	for _, refundable := range paymentStatusesRefundable {
+		if status == refundable {
+			return true
+		}
+	}
+	return false
+}
+
+// isPaymentStatusFinal tells whether the payment lifecycle has ended; the refunds change the status
+// of the complete payments afterwards
+func isPaymentStatusFinal(status string) bool {
+	return paymentStatusRanks[status] >= paymentStatusRanks[paymentStatusComplete]
+}
+
+// paymentStatusOfEvent returns the payment status the event reports or an empty string if it reports none
+func paymentStatusOfEvent(event *PaymentEvent) string {
+	switch event.Type {
+	case paymentEventPaymentSucceeded:
+		return paymentStatusComplete
+	case paymentEventPaymentFailed:
+		return paymentStatusFailed
+	case paymentEventPaymentCanceled:
+		return paymentStatusCanceled
+	}
+	return gatewayPaymentStatuses[strings.ToLower(event.PaymentStatus)]
+}
+
+// orderStatusOfPayment returns the status the order of the payment moves to or an empty string if it stays unchanged;
+// the orders of the canceled payments stay payable
+func orderStatusOfPayment(status string) string {
+	if status == paymentStatusComplete {
+		return models.OrderStatusPaid
+	}
+	return ""
+}
+
+// processPaymentLifecycleEvent applies the PaymentIntent event to the payment of the intent
+func processPaymentLifecycleEvent(event *PaymentEvent) errors.Error {
+	status := paymentStatusOfEvent(event)
+	if status == "" {
+		Logger.Debug("processPaymentLifecycleEvent: %s event %s reports no payment status (%s)", event.Type,
+			event.ID, event.PaymentStatus)
+		return nil
+	}
+	payment, err := findEventPayment(context.Background(), db, event)
+	if err != nil {
+		return err
+	}
+	if payment == nil {
+		// the intents not created by the checkout (e. g., through the gateway dashboard) have no payments
+		Logger.Info("processPaymentLifecycleEvent: ignoring %s event %s of unknown payment intent %s", event.Type,
+			event.ID, event.PaymentIntentID)
+		return nil
+	}
+	return applyPaymentEvent(payment, event, status)
+}
+
+// findEventPayment finds the payment of the event by the payment intent ID, by the checkout session ID or,
+// for the intent events preceding the completed checkout, by the order ID the intent is tagged with;
+// nil is returned if the event belongs to no payment
+func findEventPayment(ctx context.Context, idb bun.IDB, event *PaymentEvent) (*dbModels.Payment, errors.Error) {
+	if event.PaymentIntentID != "" {
+		payment, err := getDBPaymentByPaymentIntentID(ctx, idb, event.PaymentIntentID)
+		if err == nil || err.Code() != 404 {
+			return payment, err
+		}
+	}
+	if event.CheckoutSessionID != "" {
+		payment, err := getDBPaymentByCheckoutSessionId(event.CheckoutSessionID)
+		if err == nil || err.Code() != 404 {
+			return payment, err
+		}
+	}
+	if event.OrderID <= 0 {
+		return nil, nil
+	}
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := idb.NewSelect().Model(&dbPayments).
+		Where("order_id = ?", event.OrderID).
+		Where("payment_intent_id = ''").
+		Where("checkout_session_id != ''").
+		Order("id DESC").Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d payments!\n", sqlErr, event.OrderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d payments!", event.OrderID)
+	}
+	if len(dbPayments) == 0 {
+		// the payment of the checkout may not have been recorded yet, so the event is retried
+		return nil, errors.New(404, "Could not find order %d payment of payment intent %s!", event.OrderID,
+			event.PaymentIntentID)
+	}
+	return dbPayments[0], nil
+}
+
+// applyPaymentEvent moves the payment to the status reported by the event and the order along with it.
+// The events may arrive in any order, so the ones older than the event the status has been set from
+// are ignored, as are the ones of the ended payments.
+func applyPaymentEvent(payment *dbModels.Payment, event *PaymentEvent, status string) errors.Error {
+	order, err := getOrderFromDB(payment.OrderID, true, -1)
+	if err != nil {
+		Logger.Error("applyPaymentEvent: Could not find order of payment %d: %v", payment.ID, err)
+		return err
+	}
+	// webhook events are processed in background after the response has been sent,
+	// so there is no request context to run the transaction with
+	return runInTx(context.Background(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		current, err := getDBPayment(ctx, tx, payment.ID)
+		if err != nil {
+			return err
+		}
+		*payment = *current
+		if !isPaymentEventApplicable(payment, event, status) {
+			return nil
+		}
+
+		payment.Status = status
+		if event.Created > payment.StatusEventAt {
+			payment.StatusEventAt = event.Created
+		}
+		if payment.PaymentIntentId == "" {
+			payment.PaymentIntentId = event.PaymentIntentID
+		}
+		// the canceled payments keep the reason of the failed attempt unless the event gives another one
+		if status == paymentStatusFailed || (status == paymentStatusCanceled && event.FailureCode != "") {
+			payment.FailureCode = event.FailureCode
+			payment.FailureMessage = event.FailureMessage
+		}
+		// the stock reserved for the complete payments has been sold
+		switch status {
+		case paymentStatusCanceled:
+			if err = releasePaymentStock(ctx, tx, payment); err != nil {
+				return err
+			}
+		case paymentStatusComplete:
+			payment.ReservedStock = nil
+		}
+		payment.DateUpdated = time.Now().In(time.UTC).Unix()
+		query := tx.NewUpdate().Model(payment).
+			Column("status", "status_event_at", "payment_intent_id", "failure_code", "failure_message",
+				"reserved_stock", "date_updated").
+			Where("id = ?", payment.ID)
+		Logger.Debug("Built the query %s\n", query)
+		if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not update payment %d status!\n", sqlErr, payment.ID)
+			return errors.New(500, "ERROR: Could not update payment %d!", payment.ID)
+		}
+		Logger.Info("Payment %d is %s after %s event %s", payment.ID, status, event.Type, event.ID)
+
+		return syncOrderWithPayment(ctx, tx, order, payment, orderActorSystem, 0,
+			fmt.Sprintf("Payment %d is %s (%s event %s)", payment.ID, payment.Status, event.Type, event.ID))
+	})
+}
+
+func isPaymentEventApplicable(payment *dbModels.Payment, event *PaymentEvent, status string) bool {
+	switch {
+	case status == payment.Status && status != paymentStatusFailed:
+		Logger.Debug("Payment %d is already %s; %s event %s changes nothing", payment.ID, status, event.Type, event.ID)
+		return false
+	case isPaymentStatusFinal(payment.Status):
+		Logger.Info("Ignoring %s event %s of payment %d which is %s", event.Type, event.ID, payment.ID, payment.Status)
+		return false
+	case event.Created > 0 && event.Created < payment.StatusEventAt:
+		Logger.Info("Ignoring %s event %s of payment %d older than its status %s", event.Type, event.ID, payment.ID,
+			payment.Status)
+		return false
+	case event.Created == payment.StatusEventAt && paymentStatusRanks[status] < paymentStatusRanks[payment.Status]:
+		Logger.Info("Ignoring %s event %s of payment %d which is %s since the same time", event.Type, event.ID,
+			payment.ID, payment.Status)
+		return false
+	}
+	return true
+}
+
+// syncOrderWithPayment pays the order of the complete payment once its complete payments add up to its total;
+// the orders fulfilled before they have been paid (cash on delivery) are invoiced instead
+func syncOrderWithPayment(ctx context.Context, idb bun.IDB, order *dbModels.Order, payment *dbModels.Payment,
+	actorRole string, actorID int64, reason string) errors.Error {
+	query := idb.NewSelect().Model(order).Column("status", "total_price_minor", "currency").Where("id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", query)
+	if sqlErr := query.Scan(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, order.ID)
+		return errors.New(500, "ERROR: Could not find order %d!", order.ID)
+	}
+	toStatus := orderStatusOfPayment(payment.Status)
+	if toStatus == "" || toStatus == order.Status {
+		return nil
+	}
+	if toStatus == models.OrderStatusPaid {
+		paid, err := sumOrderPaidAmount(ctx, idb, order)
+		if err != nil {
+			return err
+		}
+		if paid < order.TotalPrice {
+			Logger.Info("Order %d stays %s: %s of its total %s has been paid", order.ID, order.Status,
+				money.New(paid, order.Currency), money.New(order.TotalPrice, order.Currency))
+			return nil
+		}
+	}
+	if toStatus == models.OrderStatusPaid && isOrderFulfilledUnpaid(order.Status) {
+		return issueInvoice(ctx, idb, order.ID)
+	}
+	if checkOrderStatusTransition(order.Status, toStatus, actorRole) != nil {
+		Logger.Info("Order %d status %s stays unchanged for payment %d status %s", order.ID, order.Status,
+			payment.ID, payment.Status)
+		return nil
+	}
+	return transitionOrderStatus(ctx, idb, order, toStatus, actorRole, actorID, reason)
+}
+
+// sumOrderPaidAmount adds up the payments of the order in its currency which have been completed, refunded or not
+func sumOrderPaidAmount(ctx context.Context, idb bun.IDB, order *dbModels.Order) (int64, errors.Error) {
+	var paid int64
+	query := idb.NewSelect().Model((*dbModels.Payment)(nil)).ColumnExpr("COALESCE(SUM(amount_minor), 0)").
+		Where("order_id = ?", order.ID).
+		Where("currency = ?", order.Currency).
+		Where("status IN (?)", bun.In(paymentStatusesRefundable))
+	Logger.Debug("Built the query %s\n", query)
+	if sqlErr := query.Scan(ctx, &paid); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not sum order %d payments!\n", sqlErr, order.ID)
+		return 0, errors.New(500, "ERROR: Could not find order %d payments!", order.ID)
+	}
+	return paid, nil
+}
+
+func isOrderFulfilledUnpaid(status string) bool {
+	return status == models.OrderStatusProcessing || status == models.OrderStatusShipped ||
+		status == models.OrderStatusDelivered
+}
+
+// countOpenOrderPayments counts the payments of the order which may still be paid
+func countOpenOrderPayments(ctx context.Context, idb bun.IDB, orderID int64) (int, errors.Error) {
+	query := idb.NewSelect().Model((*dbModels.Payment)(nil)).
+		Where("order_id = ?", orderID).
+		Where("status IN (?)", bun.In(paymentStatusesOpen))
+	Logger.Debug("Built the query %s\n", query)
+
+	count, sqlErr := query.Count(ctx)
+	if sqlErr != nil && sqlErr != sql.ErrNoRows {
+		Logger.Error("ERROR %v: Could not count order %d payments!\n", sqlErr, orderID)
+		return 0, errors.New(500, "ERROR: Could not find order %d payments!", orderID)
+	}
+	return count, nil
+}
@@ -0,0 +1,143 @@
+package restapi
+
+import (
+	"context"
+	"estore-backend/server/models"
+	"fmt"
+	"testing"
+)
+
+func TestPaymentStatusOfEvent(t *testing.T) {
+	tests := []struct {
+		eventType     string
+		paymentStatus string
+		want          string
+	}{
+		{paymentEventPaymentSucceeded, "", paymentStatusComplete},
+		{paymentEventPaymentFailed, "requires_payment_method", paymentStatusFailed},
+		{paymentEventPaymentCanceled, "", paymentStatusCanceled},
+		{paymentEventPaymentCreated, "requires_payment_method", paymentStatusIntended},
+		{paymentEventPaymentUpdated, "requires_action", paymentStatusRequiresAction},
+		{paymentEventPaymentUpdated, "Processing", paymentStatusProcessing},
+		{paymentEventPaymentUpdated, "requires_capture", paymentStatusAuthorized},
+		{paymentEventCheckoutCompleted, "paid", paymentStatusComplete},
+		{paymentEventCheckoutCompleted, "unpaid", paymentStatusProcessing},
+		{paymentEventPaymentUpdated, "unknown", ""},
+		{paymentEventPaymentUpdated, "", ""},
+	}
+	for _, tt := range tests {
+		event := &PaymentEvent{Type: tt.eventType, PaymentStatus: tt.paymentStatus}
+		if got := paymentStatusOfEvent(event); got != tt.want {
+			t.Errorf("paymentStatusOfEvent(%s, %q) = %q, want %q", tt.eventType, tt.paymentStatus, got, tt.want)
+		}
+	}
+}
+
+// testPaymentEvent is a payment intent event of the test order occurred at the time
+type testPaymentEvent struct {
+	eventType     string
+	paymentStatus string
+	created       int64
+	failureCode   string
+}
+
+func TestProcessPaymentLifecycleEvent(t *testing.T) {
+	tests := []struct {
+		name string
+		// the events in the order of their delivery
+		events          []testPaymentEvent
+		wantStatus      string
+		wantFailureCode string
+		wantOrderStatus string
+		// transitions of the order to paid
+		wantPaid int
+	}{
+		{"in order", []testPaymentEvent{
+			{paymentEventPaymentCreated, "requires_payment_method", 1, ""},
+			{paymentEventPaymentUpdated, "processing", 2, ""},
+			{paymentEventPaymentSucceeded, "succeeded", 3, ""},
+		}, paymentStatusComplete, "", models.OrderStatusPaid, 1},
+		{"succeeded before processing", []testPaymentEvent{
+			{paymentEventPaymentSucceeded, "succeeded", 3, ""},
+			{paymentEventPaymentUpdated, "processing", 2, ""},
+			{paymentEventPaymentCreated, "requires_payment_method", 1, ""},
+		}, paymentStatusComplete, "", models.OrderStatusPaid, 1},
+		{"duplicate success", []testPaymentEvent{
+			{paymentEventPaymentSucceeded, "succeeded", 3, ""},
+			{paymentEventPaymentSucceeded, "succeeded", 3, ""},
+		}, paymentStatusComplete, "", models.OrderStatusPaid, 1},
+		{"failure older than processing", []testPaymentEvent{
+			{paymentEventPaymentUpdated, "processing", 2, ""},
+			{paymentEventPaymentFailed, "requires_payment_method", 1, "card_declined"},
+		}, paymentStatusProcessing, "", models.OrderStatusPendingPayment, 0},
+		{"same time going back", []testPaymentEvent{
+			{paymentEventPaymentUpdated, "processing", 5, ""},
+			{paymentEventPaymentUpdated, "requires_action", 5, ""},
+		}, paymentStatusProcessing, "", models.OrderStatusPendingPayment, 0},
+		{"retried failure", []testPaymentEvent{
+			{paymentEventPaymentFailed, "requires_payment_method", 1, "insufficient_funds"},
+			{paymentEventPaymentFailed, "requires_payment_method", 2, "card_declined"},
+		}, paymentStatusFailed, "card_declined", models.OrderStatusPendingPayment, 0},
+		{"succeeded after a failure", []testPaymentEvent{
+			{paymentEventPaymentFailed, "requires_payment_method", 1, "card_declined"},
+			{paymentEventPaymentSucceeded, "succeeded", 2, ""},
+		}, paymentStatusComplete, "card_declined", models.OrderStatusPaid, 1},
+		{"canceled after a failure", []testPaymentEvent{
+			{paymentEventPaymentFailed, "requires_payment_method", 1, "do_not_honor"},
+			{paymentEventPaymentCanceled, "canceled", 2, ""},
+		}, paymentStatusCanceled, "do_not_honor", models.OrderStatusPendingPayment, 0},
+		{"failure after cancellation", []testPaymentEvent{
+			{paymentEventPaymentCanceled, "canceled", 1, ""},
+			{paymentEventPaymentFailed, "requires_payment_method", 2, "card_declined"},
+		}, paymentStatusCanceled, "", models.OrderStatusPendingPayment, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			product := addTestProduct(t, 1000, 5)
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+			payment := addTestPayment(t, order, paymentStatusIntended)
+
+			for i, e := range tt.events {
+				event := &PaymentEvent{ID: fmt.Sprintf("evt_%d", i), Type: e.eventType, Created: e.created,
+					PaymentIntentID: payment.PaymentIntentId, PaymentStatus: e.paymentStatus, FailureCode: e.failureCode}
+				if err := processPaymentLifecycleEvent(event); err != nil {
+					t.Fatalf("%s event %s: %s", e.eventType, event.ID, err)
+				}
+			}
+
+			updated, err := getDBPayment(context.Background(), db, payment.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.Status != tt.wantStatus || updated.FailureCode != tt.wantFailureCode {
+				t.Errorf("payment = %s failed with %q, want %s failed with %q", updated.Status, updated.FailureCode,
+					tt.wantStatus, tt.wantFailureCode)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+			if n := countTestRows(t, "order_status_history", "order_id = ? AND to_status = ?", order.ID,
+				models.OrderStatusPaid); n != tt.wantPaid {
+				t.Errorf("order paid %d times, want %d", n, tt.wantPaid)
+			}
+		})
+	}
+}
+
+func TestProcessPaymentLifecycleEventOfUnknownIntent(t *testing.T) {
+	newTestStore(t)
+	event := &PaymentEvent{ID: "evt_dashboard", Type: paymentEventPaymentSucceeded, PaymentIntentID: "pi_dashboard"}
+	if err := processPaymentLifecycleEvent(event); err != nil {
+		t.Errorf("processPaymentLifecycleEvent() of an unknown payment intent = %v, want nil", err)
+	}
+
+	// the intent event of an order whose checkout session has not been recorded yet is retried
+	product := addTestProduct(t, 1000, 5)
+	order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+	event = &PaymentEvent{ID: "evt_early", Type: paymentEventPaymentSucceeded, PaymentIntentID: "pi_early",
+		OrderID: order.ID}
+	if err := processPaymentLifecycleEvent(event); err == nil || err.Code() != 404 {
+		t.Errorf("processPaymentLifecycleEvent() before the checkout is recorded = %v, want 404", err)
+	}
+}
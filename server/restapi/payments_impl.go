@@ -2,42 +2,32 @@ package restapi
 
 import (
 	"context"
+	"database/sql"
 	dbModels "estore-backend/server/database/models"
 	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/payment"
 	"estore-backend/server/restapi/operations/payments"
+	"fmt"
 	"github.com/go-openapi/errors"
-	"log"
+	"github.com/uptrace/bun"
 	"time"
 )
 
-func addPayment(item *models.Payment) error {
-	log.Printf("adding item %s\n%s\n%s", item, &item, *item)
-	if item == nil {
-		return errors.New(500, "DB item cannot be nil!")
-	}
-
-	dbModel := dbModels.NewPaymentFrom(item)
-	_, err := createDBPayment(dbModel)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func createDBPayment(dbModel *dbModels.Payment) (*dbModels.Payment, errors.Error) {
+func createDBPayment(ctx context.Context, idb bun.IDB, dbModel *dbModels.Payment) (*dbModels.Payment, errors.Error) {
 	nowUnixEpoch := time.Now().In(time.UTC).Unix()
 	dbModel.DateCreated = nowUnixEpoch
 	dbModel.DateUpdated = nowUnixEpoch
-	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
+	query := idb.NewInsert().Model(dbModel).ExcludeColumn("id")
 	Logger.Debug("Built the query %s\n", query)
 
-	res, err := query.Exec(context.Background())
+	res, err := query.Exec(ctx)
 	if err != nil {
-		return nil, errors.New(500, "ERROR %v: Could not add payment %s!\n", err, dbModel)
+		return nil, errors.New(500, "ERROR %v: Could not add payment %v!\n", err, dbModel)
 	}
 	id, err := res.LastInsertId()
 	if err != nil {
-		return nil, errors.New(500, "ERROR %v: Could not add payment %s!\n", err, dbModel)
+		return nil, errors.New(500, "ERROR %v: Could not add payment %v!\n", err, dbModel)
 	}
 	dbModel.ID = id
 	Logger.Debug("Returning payment %v\n%s\n", dbModel, dbModel)
@@ -52,24 +42,61 @@ func getDBPaymentByCheckoutSessionId(sessionId string) (*dbModels.Payment, error
 
 	err := query.Scan(context.Background())
 	if err != nil {
-		Logger.Error("ERROR %v (%s): Could not find payment for checkout_session_id %d!\n", err, err, sessionId)
+		if err == sql.ErrNoRows {
+			return nil, errors.New(404, "Could not find payment of checkout session %s!", sessionId)
+		}
+		Logger.Error("ERROR %v (%s): Could not find payment for checkout_session_id %s!\n", err, err, sessionId)
 		return nil, errors.New(500, "Could not find payment!")
 	}
 
 	return dbModel, nil
 }
 
-func updateDBPayment(dbModel *dbModels.Payment) (*dbModels.Payment, errors.Error) {
+// getDBPayment finds the payment by ID
+func getDBPayment(ctx context.Context, idb bun.IDB, id int64) (*dbModels.Payment, errors.Error) {
+	dbModel := new(dbModels.Payment)
+	query := idb.NewSelect().Model(dbModel).Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return nil, errors.New(404, "Could not find payment %d!", id)
+		}
+		Logger.Error("ERROR %v: Could not find payment %d!\n", sqlErr, id)
+		return nil, errors.New(500, "ERROR: Could not find payment %d!", id)
+	}
+	return dbModel, nil
+}
+
+// getDBPaymentByPaymentIntentID finds the payment by the ID of its payment gateway payment intent
+func getDBPaymentByPaymentIntentID(ctx context.Context, idb bun.IDB, paymentIntentID string) (*dbModels.Payment, errors.Error) {
+	dbModel := new(dbModels.Payment)
+	query := idb.NewSelect().Model(dbModel).Where("payment_intent_id = ?", paymentIntentID).Order("id DESC").Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return nil, errors.New(404, "Could not find payment of payment intent %s!", paymentIntentID)
+		}
+		Logger.Error("ERROR %v: Could not find payment of payment intent %s!\n", sqlErr, paymentIntentID)
+		return nil, errors.New(500, "ERROR: Could not find payment of payment intent %s!", paymentIntentID)
+	}
+	return dbModel, nil
+}
+
+func updateDBPayment(ctx context.Context, idb bun.IDB, dbModel *dbModels.Payment) (*dbModels.Payment, errors.Error) {
 	if dbModel == nil {
 		return nil, errors.New(500, "Empty payment!")
 	}
 	nowUnixEpoch := time.Now().In(time.UTC).Unix()
 	dbModel.DateUpdated = nowUnixEpoch
-	query := db.NewUpdate().Where("id = ?", dbModel.ID).
+	query := idb.NewUpdate().Where("id = ?", dbModel.ID).
 		Model(dbModel).ExcludeColumn("id").ExcludeColumn("date_created")
 	Logger.Debug("Built the query %s\n", query)
 
-	_, err := query.Exec(context.Background())
+	_, err := query.Exec(ctx)
 	if err != nil {
 		Logger.Error("ERROR %v (%s): Could not update payment %d!\n", err, err, dbModel.ID)
 		return nil, errors.New(500, "Could not update payment %d!", dbModel.ID)
@@ -78,67 +105,376 @@ func updateDBPayment(dbModel *dbModels.Payment) (*dbModels.Payment, errors.Error
 	return dbModel, nil
 }
 
-func updatePayment(id int64, item *models.Payment) error {
-	if item == nil {
-		return errors.New(500, "Empty payment!")
+// Actions of the payment history entries
+const (
+	paymentActionCreated = "created"
+	paymentActionUpdated = "updated"
+	paymentActionDeleted = "deleted"
+)
+
+// paymentStatusesManual are the statuses the admins may set on the offline payments;
+// the refunded ones are set by the refunds
+var paymentStatusesManual = []string{paymentStatusIntended, paymentStatusFailed, paymentStatusComplete,
+	paymentStatusCanceled}
+
+func isPaymentStatusManual(status string) bool {
+	for _, manual := range paymentStatusesManual {
+		if status == manual {
+			return true
+		}
 	}
-	dbModel := dbModels.NewPaymentFrom(item)
-	dbModel.ID = id
-	_, err := updateDBPayment(dbModel)
-	if err != nil {
-		return err
+	return false
+}
+
+func formatPaymentAmount(payment *dbModels.Payment) string {
+	if payment.Currency == "" {
+		return ""
 	}
+	return money.New(payment.Amount, payment.Currency).String()
+}
 
+// checkPaymentAmount requires the positive payment amount in the currency of its order
+func checkPaymentAmount(amount *models.Money, currency string) errors.Error {
+	if dbModels.MoneyAmount(amount) <= 0 {
+		return errors.New(400, "Payment amount must be positive!")
+	}
+	if dbModels.MoneyCurrency(amount) != currency {
+		return errors.New(400, "Payment amount must be in %s, the currency of its order!", currency)
+	}
 	return nil
 }
 
-func deletePayment(id int64) error {
-	query := db.NewDelete().TableExpr("orders").Where("id = ?", id)
-	log.Printf("Built the query %s\n", query)
+// paymentChanges lists the properties the admins may change which differ between the payments
+func paymentChanges(from *dbModels.Payment, to *dbModels.Payment) []*dbModels.PaymentChange {
+	changes := make([]*dbModels.PaymentChange, 0)
+	values := [][3]string{
+		{"amount", formatPaymentAmount(from), formatPaymentAmount(to)},
+		{"method", from.Method, to.Method},
+		{"status", from.Status, to.Status},
+	}
+	for _, value := range values {
+		if value[1] != value[2] {
+			changes = append(changes, &dbModels.PaymentChange{Property: value[0], From: value[1], To: value[2]})
+		}
+	}
+	return changes
+}
 
-	_, err := query.Exec(context.Background())
-	if err != nil {
-		return errors.New(500, "ERROR %v: Could not delete payment %d!\n", err, id)
+func addPaymentHistory(ctx context.Context, idb bun.IDB, paymentID int64, action string, changes []*dbModels.PaymentChange,
+	actorID int64) errors.Error {
+	entry := &dbModels.PaymentHistory{
+		Action:      action,
+		ActorID:     actorID,
+		Changes:     changes,
+		DateCreated: time.Now().In(time.UTC).Unix(),
+		PaymentID:   paymentID,
 	}
+	query := idb.NewInsert().Model(entry).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
 
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add payment %d history entry %s!\n", sqlErr, paymentID, action)
+		return errors.New(500, "ERROR: Could not add payment %d history!", paymentID)
+	}
 	return nil
 }
 
-func getPayment(id int64) (result *models.Payment, err error) {
-	dbModel := new(dbModels.Payment)
-
-	query := db.NewSelect().Model(dbModel).Where("id = ?", id)
-	log.Printf("Built the query %s\n", query)
-
-	err = query.Scan(context.Background())
+// getPrincipalPayment finds the payment by ID; the customers find the payments of their orders only
+func getPrincipalPayment(ctx context.Context, id int64, principal *models.Principal) (*dbModels.Payment, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
 	if err != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find payment %d!\n", err, id)
+		return nil, err
 	}
-
-	result = dbModel.ToDTO()
-	return result, nil
+	dbModel, err := getDBPayment(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		if _, err = getOrderFromDB(dbModel.OrderID, false, principal.User.ID); err != nil {
+			if err.Code() == 404 {
+				return nil, errors.New(404, "Could not find payment %d!", id)
+			}
+			return nil, err
+		}
+	}
+	return dbModel, nil
 }
 
-func allPayments(params *payments.ListPaymentsParams) (result []*models.Payment, err error) {
-	dbModel := make([]*dbModels.Payment, 0)
+func getPayment(params *payment.GetPaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	dbModel, err := getPrincipalPayment(params.HTTPRequest.Context(), params.ID, principal)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
 
-	query := db.NewSelect().Model(&dbModel)
+func allPayments(params *payments.ListPaymentsParams, principal *models.Principal) ([]*models.Payment, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := db.NewSelect().Model(&dbPayments)
+	if !isAdmin {
+		// the guest orders claimed by the customer keep the payments made before
+		query.Where("order_id IN (?)", db.NewSelect().Model((*dbModels.Order)(nil)).Column("id").
+			Where("user_id = ?", principal.User.ID))
+	}
+	if params.OrderID != nil {
+		query.Where("order_id = ?", *params.OrderID)
+	}
+	if params.Status != nil {
+		query.Where("status = ?", *params.Status)
+	}
 	if params.Limit != nil {
 		query.Limit(int(*params.Limit))
 	}
 	if params.Offset != nil {
 		query.Offset(int(*params.Offset))
 	}
-	log.Printf("Built the query %s\n%s\n", query, query.QueryBuilder())
+	query.Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find payments!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find payments!")
+	}
+	result := make([]*models.Payment, len(dbPayments))
+	for i, m := range dbPayments {
+		result[i] = m.ToDTO()
+	}
+	return result, nil
+}
 
-	err = query.Scan(context.Background())
+// addPayment records the payment made outside of the payment gateways (e. g., a bank transfer);
+// the complete payment pays its unpaid order
+func addPayment(params *payments.AddPaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	item := params.Body
+	if item.Method == "" || item.Method == models.PaymentMethodOnline {
+		return nil, errors.New(400, "Only the offline payments can be added; the online ones are made through the checkout!")
+	}
+	if item.Status == "" {
+		item.Status = paymentStatusIntended
+	}
+	if item.Status != paymentStatusIntended && item.Status != paymentStatusComplete {
+		return nil, errors.New(400, "Payment can be added as '%s' or '%s' only!", paymentStatusIntended,
+			paymentStatusComplete)
+	}
+	dbOrder, err := getOrderFromDB(*item.OrderID, true, principal.User.ID)
 	if err != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find payments matching %s!\n", err, params)
+		return nil, err
+	}
+	if dbOrder.Status == models.OrderStatusCancelled || dbOrder.Status == models.OrderStatusRefunded {
+		return nil, errors.New(409, "Order in status '%s' cannot be paid!", dbOrder.Status)
+	}
+	err = checkPaymentAmount(item.Amount, dbOrder.Currency)
+	if err != nil {
+		return nil, err
 	}
 
-	result = make([]*models.Payment, len(dbModel))
-	for i, m := range dbModel {
-		result[i] = m.ToDTO()
+	dbModel := &dbModels.Payment{
+		Amount:   dbModels.MoneyAmount(item.Amount),
+		Currency: dbOrder.Currency,
+		Method:   item.Method,
+		OrderID:  dbOrder.ID,
+		Status:   item.Status,
+		UserID:   dbOrder.UserID,
+	}
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		_, err := createDBPayment(ctx, tx, dbModel)
+		if err != nil {
+			return err
+		}
+		err = addPaymentHistory(ctx, tx, dbModel.ID, paymentActionCreated,
+			paymentChanges(&dbModels.Payment{}, dbModel), principal.User.ID)
+		if err != nil {
+			return err
+		}
+		return syncOrderWithPayment(ctx, tx, dbOrder, dbModel, orderActorAdmin, principal.User.ID,
+			fmt.Sprintf("Payment %d (%s) is %s", dbModel.ID, dbModel.Method, dbModel.Status))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// changePayment validates the changes of the payment made by the admin, records them to the payment history
+// and moves the order along with the payment status
+func changePayment(ctx context.Context, idb bun.IDB, dbModel *dbModels.Payment, item *models.Payment,
+	principal *models.Principal) errors.Error {
+	if item.OrderID == nil || *item.OrderID != dbModel.OrderID {
+		return errors.New(400, "The order of payment %d cannot be changed!", dbModel.ID)
+	}
+	if item.Method != "" && item.Method != dbModel.PaymentMethod() {
+		return errors.New(400, "The method of payment %d cannot be changed!", dbModel.ID)
+	}
+	if err := checkPaymentAmount(item.Amount, dbModel.Currency); err != nil {
+		return err
+	}
+	changed := *dbModel
+	changed.Amount = dbModels.MoneyAmount(item.Amount)
+	changed.Status = item.Status
+	changes := paymentChanges(dbModel, &changed)
+	if len(changes) == 0 {
+		return nil
+	}
+	if dbModel.PaymentMethod() == models.PaymentMethodOnline {
+		return errors.New(409, "The amount and the status of payment %d are set by its payment gateway!", dbModel.ID)
+	}
+	if changed.Status != dbModel.Status && !isPaymentStatusManual(changed.Status) {
+		return errors.New(400, "Payment status can be set to %v only!", paymentStatusesManual)
+	}
+	if changed.Amount < dbModel.RefundedAmount {
+		return errors.New(409, "Payment %d amount cannot be less than its refunded amount %s!", dbModel.ID,
+			money.New(dbModel.RefundedAmount, dbModel.Currency))
+	}
+
+	*dbModel = changed
+	// the stock reserved for the payment is released when it is canceled and sold when it is received
+	switch dbModel.Status {
+	case paymentStatusCanceled:
+		if err := releasePaymentStock(ctx, idb, dbModel); err != nil {
+			return err
+		}
+	case paymentStatusComplete:
+		dbModel.ReservedStock = nil
+	}
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	query := idb.NewUpdate().Model(dbModel).
+		Column("amount_minor", "status", "reserved_stock", "date_updated").
+		Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update payment %d!\n", sqlErr, dbModel.ID)
+		return errors.New(500, "ERROR: Could not update payment %d!", dbModel.ID)
+	}
+	err := addPaymentHistory(ctx, idb, dbModel.ID, paymentActionUpdated, changes, principal.User.ID)
+	if err != nil {
+		return err
+	}
+	// the order status is read within the transaction by syncOrderWithPayment
+	dbOrder := &dbModels.Order{ID: dbModel.OrderID}
+	return syncOrderWithPayment(ctx, idb, dbOrder, dbModel, orderActorAdmin, principal.User.ID,
+		fmt.Sprintf("Payment %d (%s) is %s", dbModel.ID, dbModel.Method, dbModel.Status))
+}
+
+func updatePayment(params *payment.EditPaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	var result *models.Payment
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel, err := getDBPayment(ctx, tx, params.ID)
+		if err != nil {
+			return err
+		}
+		err = changePayment(ctx, tx, dbModel, params.Body, principal)
+		if err != nil {
+			return err
+		}
+		result = dbModel.ToDTO()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// patchPayment applies the merge patch to the payment; it is validated and recorded like the replaced payments
+func patchPayment(params *payment.PatchPaymentParams, principal *models.Principal) (*models.Payment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *models.Payment
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel, err := getDBPayment(ctx, tx, params.ID)
+		if err != nil {
+			return err
+		}
+		item := new(models.Payment)
+		err = applyMergePatch("payment", dbModel.ToDTO(), params.Body, item)
+		if err != nil {
+			return err
+		}
+		err = changePayment(ctx, tx, dbModel, item, principal)
+		if err != nil {
+			return err
+		}
+		result = dbModel.ToDTO()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return result, nil
 }
+
+// deletePayment deletes the offline payment which has not been received; the payments made through
+// the payment gateways and the received ones are kept for the accounting
+func deletePayment(params *payment.DeletePaymetParams, principal *models.Principal) errors.Error {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return err
+	}
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel, err := getDBPayment(ctx, tx, params.ID)
+		if err != nil {
+			return err
+		}
+		if dbModel.PaymentMethod() == models.PaymentMethodOnline {
+			return errors.New(409, "Payment %d has been made through a payment gateway and cannot be deleted!", dbModel.ID)
+		}
+		if isPaymentRefundable(dbModel.Status) {
+			return errors.New(409, "Payment %d in status '%s' cannot be deleted!", dbModel.ID, dbModel.Status)
+		}
+		if err = releasePaymentStock(ctx, tx, dbModel); err != nil {
+			return err
+		}
+		query := tx.NewDelete().Model((*dbModels.Payment)(nil)).Where("id = ?", dbModel.ID)
+		Logger.Debug("Built the query %s\n", query)
+
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete payment %d!\n", sqlErr, dbModel.ID)
+			return errors.New(500, "ERROR: Could not delete payment %d!", dbModel.ID)
+		}
+		return addPaymentHistory(ctx, tx, dbModel.ID, paymentActionDeleted,
+			paymentChanges(dbModel, &dbModels.Payment{}), principal.User.ID)
+	})
+}
+
+func allPaymentHistory(params *payment.ListPaymentHistoryParams, principal *models.Principal) ([]*models.PaymentHistoryEntry, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]*dbModels.PaymentHistory, 0)
+	query := db.NewSelect().Model(&history).Where("payment_id = ?", params.ID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find payment %d history!\n", sqlErr, params.ID)
+		return nil, errors.New(500, "ERROR: Could not find payment %d history!", params.ID)
+	}
+	if len(history) == 0 {
+		// the deleted payments keep their history
+		if _, err = getDBPayment(params.HTTPRequest.Context(), db, params.ID); err != nil {
+			return nil, err
+		}
+	}
+	return dbModels.PaymentHistoryDTOsFromPaymentHistory(history), nil
+}
@@ -0,0 +1,209 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/payment"
+	"estore-backend/server/restapi/operations/payments"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+// addTestOfflinePayment adds a payment of the whole order total made by the offline method
+func addTestOfflinePayment(t *testing.T, order *dbModels.Order, method string, status string) *dbModels.Payment {
+	dbModel := &dbModels.Payment{Amount: order.TotalPrice, Currency: order.Currency, Method: method,
+		OrderID: order.ID, UserID: order.UserID, Status: status}
+	if _, err := db.NewInsert().Model(dbModel).ExcludeColumn("id").Returning("id").Exec(context.Background(), &dbModel.ID); err != nil {
+		t.Fatal(err)
+	}
+	return dbModel
+}
+
+func testMoney(amount int64) *models.Money {
+	return &models.Money{Amount: swag.Int64(amount), Currency: swag.String(baseCurrency())}
+}
+
+func TestAllPayments(t *testing.T) {
+	newTestStore(t)
+	product := addTestProduct(t, 1000, 5)
+	order := addTestOrder(t, models.OrderStatusPaid, 1, product)
+	otherOrder := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+	if _, err := db.NewUpdate().Model(otherOrder).Set("user_id = 2").Where("id = ?", otherOrder.ID).
+		Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	complete := addTestPayment(t, order, paymentStatusComplete)
+	failed := addTestPayment(t, otherOrder, paymentStatusFailed)
+	intended := addTestOfflinePayment(t, otherOrder, models.PaymentMethodBankTransfer, paymentStatusIntended)
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		orderID   *int64
+		status    *string
+		want      []int64
+	}{
+		{"admin", testAdmin(), nil, nil, []int64{complete.ID, failed.ID, intended.ID}},
+		{"admin of an order", testAdmin(), swag.Int64(otherOrder.ID), nil, []int64{failed.ID, intended.ID}},
+		{"admin of a status", testAdmin(), nil, swag.String(paymentStatusIntended), []int64{intended.ID}},
+		{"customer", testCustomer(1), nil, nil, []int64{complete.ID}},
+		{"customer of another order", testCustomer(1), swag.Int64(otherOrder.ID), nil, []int64{}},
+		{"other customer", testCustomer(2), nil, nil, []int64{failed.ID, intended.ID}},
+		{"customer without payments", testCustomer(3), nil, nil, []int64{}},
+	}
+	for _, tt := range tests {
+		result, err := allPayments(&payments.ListPaymentsParams{HTTPRequest: testRequest(), OrderID: tt.orderID,
+			Status: tt.status}, tt.principal)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		ids := make([]int64, len(result))
+		for i, p := range result {
+			ids[i] = p.ID
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("%s: payments = %v, want %v", tt.name, ids, tt.want)
+		}
+	}
+
+	for _, principal := range []*models.Principal{testAdmin(), testCustomer(1)} {
+		if _, err := getPayment(&payment.GetPaymentParams{HTTPRequest: testRequest(), ID: complete.ID}, principal); err != nil {
+			t.Errorf("getPayment() of user %d = %v, want the payment", principal.User.ID, err)
+		}
+	}
+	_, err := getPayment(&payment.GetPaymentParams{HTTPRequest: testRequest(), ID: complete.ID}, testCustomer(2))
+	if err == nil || err.Code() != 404 {
+		t.Errorf("getPayment() of another customer = %v, want 404", err)
+	}
+}
+
+func TestAddPayment(t *testing.T) {
+	tests := []struct {
+		name        string
+		principal   *models.Principal
+		orderStatus string
+		method      string
+		status      string
+		amount      *models.Money
+		wantCode    int32
+		// status of the order after the payment has been added
+		wantOrderStatus string
+	}{
+		{"bank transfer", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodBankTransfer, "",
+			testMoney(1000), 0, models.OrderStatusPendingPayment},
+		{"received cash", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodCash,
+			paymentStatusComplete, testMoney(1000), 0, models.OrderStatusPaid},
+		{"received part", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodCash,
+			paymentStatusComplete, testMoney(400), 0, models.OrderStatusPendingPayment},
+		{"online", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodOnline, "",
+			testMoney(1000), 400, models.OrderStatusPendingPayment},
+		{"no method", testAdmin(), models.OrderStatusPendingPayment, "", "", testMoney(1000), 400,
+			models.OrderStatusPendingPayment},
+		{"refunded", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodCash,
+			paymentStatusRefunded, testMoney(1000), 400, models.OrderStatusPendingPayment},
+		{"no amount", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodCash, "", testMoney(0),
+			400, models.OrderStatusPendingPayment},
+		{"other currency", testAdmin(), models.OrderStatusPendingPayment, models.PaymentMethodCash, "",
+			&models.Money{Amount: swag.Int64(1000), Currency: swag.String("XTS")}, 400,
+			models.OrderStatusPendingPayment},
+		{"cancelled order", testAdmin(), models.OrderStatusCancelled, models.PaymentMethodCash, "",
+			testMoney(1000), 409, models.OrderStatusCancelled},
+		{"customer", testCustomer(1), models.OrderStatusPendingPayment, models.PaymentMethodBankTransfer, "",
+			testMoney(1000), 403, models.OrderStatusPendingPayment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			product := addTestProduct(t, 1000, 5)
+			order := addTestOrder(t, tt.orderStatus, 1, product)
+
+			added, err := addPayment(&payments.AddPaymentParams{HTTPRequest: testRequest(), Body: &models.Payment{
+				Amount: tt.amount, Method: tt.method, OrderID: swag.Int64(order.ID), Status: tt.status}}, tt.principal)
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Errorf("addPayment() = %v, want %d", err, tt.wantCode)
+				}
+				if n := countTestRows(t, "payments", "order_id = ?", order.ID); n != 0 {
+					t.Errorf("payments = %d, want none", n)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			} else if n := countTestRows(t, "payment_history", "payment_id = ? AND action = ?", added.ID,
+				paymentActionCreated); n != 1 {
+				t.Errorf("payment history entries = %d, want 1", n)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+		})
+	}
+}
+
+func TestUpdatePayment(t *testing.T) {
+	tests := []struct {
+		name   string
+		online bool
+		// amount of the payment refunded already
+		refunded int64
+		orderID  int64
+		method   string
+		status   string
+		amount   int64
+		wantCode int32
+		// payment history entries of the update
+		wantHistory     int
+		wantOrderStatus string
+	}{
+		{"received", false, 0, 0, "", paymentStatusComplete, 1000, 0, 1, models.OrderStatusPaid},
+		{"amount", false, 0, 0, "", paymentStatusIntended, 800, 0, 1, models.OrderStatusPendingPayment},
+		{"unchanged", false, 0, 0, models.PaymentMethodBankTransfer, paymentStatusIntended, 1000, 0, 0,
+			models.OrderStatusPendingPayment},
+		{"online", true, 0, 0, "", paymentStatusComplete, 1000, 409, 0, models.OrderStatusPendingPayment},
+		{"order", false, 0, -1, "", paymentStatusIntended, 1000, 400, 0, models.OrderStatusPendingPayment},
+		{"method", false, 0, 0, models.PaymentMethodCash, paymentStatusIntended, 1000, 400, 0,
+			models.OrderStatusPendingPayment},
+		{"refunded", false, 0, 0, "", paymentStatusRefunded, 1000, 400, 0, models.OrderStatusPendingPayment},
+		{"below the refunded amount", false, 500, 0, "", paymentStatusIntended, 400, 409, 0,
+			models.OrderStatusPendingPayment},
+		{"no amount", false, 0, 0, "", paymentStatusIntended, 0, 400, 0, models.OrderStatusPendingPayment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			product := addTestProduct(t, 1000, 5)
+			order := addTestOrder(t, models.OrderStatusPendingPayment, 1, product)
+			var dbModel *dbModels.Payment
+			if tt.online {
+				dbModel = addTestPayment(t, order, paymentStatusIntended)
+			} else {
+				dbModel = addTestOfflinePayment(t, order, models.PaymentMethodBankTransfer, paymentStatusIntended)
+			}
+			if tt.refunded > 0 {
+				if _, err := db.NewUpdate().Model(dbModel).Set("refunded_amount_minor = ?", tt.refunded).
+					Where("id = ?", dbModel.ID).Exec(context.Background()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := updatePayment(&payment.EditPaymentParams{HTTPRequest: testRequest(), ID: dbModel.ID,
+				Body: &models.Payment{Amount: testMoney(tt.amount), Method: tt.method,
+					OrderID: swag.Int64(order.ID + tt.orderID), Status: tt.status}}, testAdmin())
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Errorf("updatePayment() = %v, want %d", err, tt.wantCode)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if n := countTestRows(t, "payment_history", "payment_id = ?", dbModel.ID); n != tt.wantHistory {
+				t.Errorf("payment history entries = %d, want %d", n, tt.wantHistory)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ?", order.ID, tt.wantOrderStatus); n != 1 {
+				t.Errorf("order is not %s", tt.wantOrderStatus)
+			}
+		})
+	}
+}
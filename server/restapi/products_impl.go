@@ -2,6 +2,7 @@ package restapi
 
 import (
 	"context"
+	"database/sql"
 	dbModels "estore-backend/server/database/models"
 	"estore-backend/server/models"
 	"estore-backend/server/restapi/operations/products"
@@ -10,68 +11,89 @@ import (
 	"log"
 )
 
-func addProduct(item *models.Product) error {
-	log.Printf("adding item %s\n%s\n%s", item, &item, *item)
+func addProduct(ctx context.Context, item *models.Product) error {
 	if item == nil {
 		return errors.New(500, "DB item cannot be nil!")
 	}
+	log.Printf("adding item %v\n%v\n%v", item, &item, *item)
+	if err := checkMoneyCurrency("product price", item.Price); err != nil {
+		return err
+	}
+	if err := checkProductPrices(item); err != nil {
+		return err
+	}
 
 	product := dbModels.NewProductFrom(item)
-	query := db.NewInsert().Model(product).ExcludeColumn("id")
-	log.Printf("Built the query %s\n", query)
-
-	res, err := query.Exec(context.Background())
-	if err != nil {
-		return errors.New(500, "ERROR %s: Could not add product %s!", err.Error(), item)
-	}
+	product.Version = 1
+	return runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewInsert().Model(product).ExcludeColumn("id")
+		log.Printf("Built the query %s\n", query)
 
-	id, err := res.LastInsertId()
-	if err != nil {
-		log.Printf("ERROR %v: Could not find last insert ID for product %s!", err, item)
-		return nil
-	}
+		res, err := query.Exec(ctx)
+		if err != nil {
+			return errors.New(500, "ERROR %v: Could not add product %v!", err.Error(), item)
+		}
 
-	err = updateProductCategoriesIfNeeded(id, product)
-	if err != nil {
-		log.Printf("ERROR %v: Could not update product %d categories %s!", err.Error(), id, product.Categories)
-	}
+		id, err := res.LastInsertId()
+		if err != nil {
+			log.Printf("ERROR %v: Could not find last insert ID for product %v!", err, item)
+			return errors.New(500, "ERROR: Could not add product %v!", item)
+		}
+		item.ID = id
 
-	return nil
+		return updateProductCategoriesIfNeeded(ctx, tx, id, product)
+	})
 }
 
-func updateProduct(id int64, item *models.Product) error {
+// updateProduct replaces the product if it has not been modified since the version the If-Match header refers to;
+// the new version is returned
+func updateProduct(ctx context.Context, id int64, item *models.Product, ifMatch string) (int64, errors.Error) {
 	if item == nil {
-		return errors.New(500, "Empty product!")
+		return 0, errors.New(500, "Empty product!")
+	}
+	if err := checkMoneyCurrency("product price", item.Price); err != nil {
+		return 0, err
+	}
+	if err := checkProductPrices(item); err != nil {
+		return 0, err
 	}
 	product := dbModels.NewProductFrom(item)
-	query := db.NewUpdate().Where("id = ?", id).Model(product).ExcludeColumn("id")
-	log.Printf("Built the query %s\n", query)
+	err := runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		version, err := checkIfMatch(ctx, tx, "products", id, ifMatch)
+		if err != nil {
+			return err
+		}
+		product.Version = version + 1
+		query := tx.NewUpdate().Where("id = ?", id).Where("COALESCE(version, 0) = ?", version).Model(product).ExcludeColumn("id")
+		log.Printf("Built the query %s\n", query)
 
-	_, err := query.Exec(context.Background())
-	if err != nil {
-		return errors.New(500, "ERROR %s: Could not update product %d!", err.Error(), id)
-	}
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			return errors.New(500, "ERROR %s: Could not update product %d!", sqlErr.Error(), id)
+		}
+		err = checkVersionedUpdate(res, "products", id)
+		if err != nil {
+			return err
+		}
 
-	err = updateProductCategoriesIfNeeded(id, product)
+		return updateProductCategoriesIfNeeded(ctx, tx, id, product)
+	})
 	if err != nil {
-		log.Printf("ERROR %v: Could not update product %d categories %s!", err.Error(), product.Categories)
+		return 0, err
 	}
-
-	return nil
+	return product.Version, nil
 }
 
-func updateProductCategoriesIfNeeded(id int64, product *dbModels.Product) error {
-	if product.Categories == nil {
-		delQery := db.NewDelete().TableExpr("product_to_categories").Where("product_id = ?", id)
-		log.Printf("Built the query %s\n", delQery)
+// updateProductCategoriesIfNeeded replaces the product categories with the given ones
+func updateProductCategoriesIfNeeded(ctx context.Context, idb bun.IDB, id int64, product *dbModels.Product) errors.Error {
+	delQery := idb.NewDelete().TableExpr("product_to_categories").Where("product_id = ?", id)
+	log.Printf("Built the query %s\n", delQery)
 
-		_, err := delQery.Exec(context.Background())
-		if err != nil {
-			log.Printf("ERROR %v: Could not clean product %d categories %s!", err, id, product.Categories)
-			return errors.New(500,
-				"ERROR %s: Could not clean product %d categories %s!", err.Error(), id, product.Categories)
-		}
-		return nil
+	_, err := delQery.Exec(ctx)
+	if err != nil {
+		log.Printf("ERROR %v: Could not clean product %d categories %v!", err, id, product.Categories)
+		return errors.New(500,
+			"ERROR %v: Could not clean product %d categories %v!", err.Error(), id, product.Categories)
 	}
 	if len(product.Categories) > 0 {
 		pcModel := make([]dbModels.ProductToCategory, len(product.Categories))
@@ -83,16 +105,16 @@ func updateProductCategoriesIfNeeded(id int64, product *dbModels.Product) error
 				Category:   &c,
 			}
 		}
-		catQuery := db.NewInsert().Model(&pcModel)
+		catQuery := idb.NewInsert().Model(&pcModel)
 		log.Printf("Built the query %s\n", catQuery)
 
-		_, err := catQuery.Exec(context.Background())
+		_, err := catQuery.Exec(ctx)
 		if err != nil {
-			log.Printf("ERROR %v: Could not update product %d categories %s!", err, id, product.Categories)
+			log.Printf("ERROR %v: Could not update product %d categories %v!", err, id, product.Categories)
 			return errors.New(500,
-				"ERROR %s: Could not update product %d categories %s!", err.Error(), id, product.Categories)
+				"ERROR %v: Could not update product %d categories %v!", err.Error(), id, product.Categories)
 		} else {
-			log.Printf("Updated product %d categories: %s", id, product.Categories)
+			log.Printf("Updated product %d categories: %v", id, product.Categories)
 		}
 	}
 	return nil
@@ -110,22 +132,63 @@ func deleteProduct(id int64) error {
 	return nil
 }
 
-func getProduct(id int64) (result *models.Product, err error) {
+// patchProduct applies the merge patch to the product; the new version is returned
+func patchProduct(ctx context.Context, id int64, patch interface{}, ifMatch *string) (*models.Product, int64, errors.Error) {
+	current, version, err := getProduct(id, baseCurrency())
+	if err != nil {
+		return nil, 0, err.(errors.Error)
+	}
+	patchErr := checkPatchPrecondition("products", id, ifMatch, version)
+	if patchErr != nil {
+		return nil, 0, patchErr
+	}
+	item := new(models.Product)
+	patchErr = applyMergePatch("product", current, patch, item)
+	if patchErr != nil {
+		return nil, 0, patchErr
+	}
+	item.ID = id
+
+	// the update is conditioned on the patched version, so a concurrent change is never overwritten
+	version, patchErr = updateProduct(ctx, id, item, entityETag(version))
+	if patchErr != nil {
+		return nil, 0, patchErr
+	}
+	return item, version, nil
+}
+
+// getProduct finds the product with its selling price in the currency
+func getProduct(id int64, currency string) (result *models.Product, version int64, err error) {
 	product := new(dbModels.Product)
 
-	query := db.NewSelect().Model(product).Where("id = ?", id)
+	query := db.NewSelect().Model(product).Relation("Categories", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Column("id")
+	}).Where("?TableAlias.id = ?", id)
 	log.Printf("Built the query %s\n", query)
 
 	err = query.Scan(context.Background())
 	if err != nil {
-		return nil, errors.New(500, "ERROR %s: Could not find product %d!", err.Error(), id)
+		if err == sql.ErrNoRows {
+			return nil, 0, errors.New(404, "Could not find product %d!", id)
+		}
+		return nil, 0, errors.New(500, "ERROR %s: Could not find product %d!", err.Error(), id)
 	}
 
 	result = product.ToDTO()
-	return result, nil
+	err = setSellingPrices([]*models.Product{result}, []*dbModels.Product{product}, currency)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, product.Version, nil
 }
 
+// allProducts finds the products with their selling prices in the currency of the params or in the base one
 func allProducts(params *products.GetProductsParams) (result []*models.Product, err error) {
+	currency, err := resolveCurrency(params.XCurrency, "")
+	if err != nil {
+		return nil, err
+	}
+
 	queryResult := make([]*dbModels.Product, 0)
 
 	query := db.NewSelect().Model(&queryResult).Relation("Categories", func(q *bun.SelectQuery) *bun.SelectQuery {
@@ -154,12 +217,34 @@ func allProducts(params *products.GetProductsParams) (result []*models.Product,
 
 	err = query.Scan(context.Background())
 	if err != nil {
-		return nil, errors.New(500, "ERROR %s: Could not find product matching %s!", err.Error(), params)
+		return nil, errors.New(500, "ERROR %v: Could not find product matching %v!", err.Error(), params)
 	}
 
 	result = make([]*models.Product, len(queryResult))
 	for i, m := range queryResult {
 		result[i] = m.ToDTO()
 	}
+	err = setSellingPrices(result, queryResult, currency)
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
+
+// setSellingPrices sets the selling prices of the products in the currency; the products having no price in it
+// are left without one
+func setSellingPrices(result []*models.Product, dbProducts []*dbModels.Product, currency string) errors.Error {
+	converter, err := newCurrencyConverter(context.Background(), db, currency)
+	if err != nil {
+		return err
+	}
+	for i, product := range dbProducts {
+		price, err := converter.productPrice(product)
+		if err != nil {
+			result[i].SellingPrice = nil
+			continue
+		}
+		result[i].SellingPrice = dbModels.MoneyDTO(price, currency)
+	}
+	return nil
+}
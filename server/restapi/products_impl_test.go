@@ -0,0 +1,49 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestAddProductRollback(t *testing.T) {
+	newTestStore(t)
+	ctx := context.Background()
+	for _, name := range []string{"Books", "Music"} {
+		if _, err := db.NewRaw("INSERT INTO categories (title, description) VALUES (?, ?)", name, name).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	newProduct := func(categoryIDs ...int64) *models.Product {
+		return &models.Product{Title: swag.String("Novel"), Description: swag.String("A novel"),
+			Price: dbModels.MoneyDTO(1000, baseCurrency()), CategoryIds: categoryIDs}
+	}
+
+	if err := addProduct(ctx, newProduct(1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	// the product is added with its first category before the repeated one fails
+	if err := addProduct(ctx, newProduct(1, 1)); err == nil {
+		t.Fatal("addProduct() of a repeated category succeeded")
+	}
+	if n := countTestRows(t, "products", "1 = 1"); n != 1 {
+		t.Errorf("products = %d, want 1", n)
+	}
+	if n := countTestRows(t, "product_to_categories", "1 = 1"); n != 2 {
+		t.Errorf("product categories = %d, want 2", n)
+	}
+
+	product := newProduct(2, 2)
+	if _, err := updateProduct(ctx, 1, product, entityETag(1)); err == nil {
+		t.Fatal("updateProduct() of a repeated category succeeded")
+	}
+	if n := countTestRows(t, "product_to_categories", "product_id = 1"); n != 2 {
+		t.Errorf("categories of the product after the failed update = %d, want 2", n)
+	}
+	if n := countTestRows(t, "products", "id = 1 AND version = 1"); n != 1 {
+		t.Error("failed update changed the product version")
+	}
+}
@@ -0,0 +1,258 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/promotion"
+	"estore-backend/server/restapi/operations/promotions"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"time"
+)
+
+// normalizeCouponCode makes coupon codes case-insensitive
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func validatePromotion(ctx context.Context, idb bun.IDB, id int64, item *dbModels.Promotion) errors.Error {
+	switch *item.Kind {
+	case models.PromotionKindPercentage:
+		if item.Value <= 0 || item.Value > 100 {
+			return errors.New(400, "Percentage promotion value must be greater than 0 and not greater than 100!")
+		}
+	case models.PromotionKindFixed:
+		if item.AmountOff <= 0 {
+			return errors.New(400, "Fixed promotion amount off must be greater than 0!")
+		}
+	case models.PromotionKindBuyXGetY:
+		if item.BuyQuantity < 1 || item.GetQuantity < 1 {
+			return errors.New(400, "Buy-X-get-Y promotion must have positive buy and get quantities!")
+		}
+	}
+	if item.Currency == "" {
+		item.Currency = baseCurrency()
+	}
+	if item.Currency != baseCurrency() {
+		return errors.New(400, "Promotion amounts must be in %s, not %s!", baseCurrency(), item.Currency)
+	}
+	if item.AmountOff < 0 || item.MinOrderValue < 0 {
+		return errors.New(400, "Promotion amounts must not be negative!")
+	}
+	if item.ValidTo > 0 && item.ValidTo < item.ValidFrom {
+		return errors.New(400, "Promotion validity window ends before it starts!")
+	}
+	if item.Code == "" {
+		return nil
+	}
+
+	count, sqlErr := idb.NewSelect().Model((*dbModels.Promotion)(nil)).
+		Where("code = ?", item.Code).Where("id != ?", id).Count(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not check coupon code %s uniqueness!\n", sqlErr, item.Code)
+		return errors.New(500, "ERROR: Could not check coupon code uniqueness!")
+	}
+	if count > 0 {
+		return errors.New(409, "Coupon code %s already exists!", item.Code)
+	}
+	return nil
+}
+
+func addPromotion(params *promotions.AddPromotionParams, principal *models.Principal) (*models.Promotion, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbModel := dbModels.NewPromotionFrom(params.Body)
+	dbModel.Code = normalizeCouponCode(dbModel.Code)
+	err = validatePromotion(ctx, db, 0, dbModel)
+	if err != nil {
+		return nil, err
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add promotion %v!\n", sqlErr, params.Body)
+		return nil, errors.New(500, "ERROR: Could not add promotion!")
+	}
+	dbModel.ID, sqlErr = res.LastInsertId()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find last insert ID for promotion %v!", sqlErr, params.Body)
+		return nil, errors.New(500, "ERROR: Could not add promotion!")
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func updatePromotion(params *promotion.EditPromotionParams, principal *models.Principal) (*models.Promotion, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	existing, err := getDBPromotion(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbModel := dbModels.NewPromotionFrom(params.Body)
+	dbModel.ID = params.ID
+	dbModel.Code = normalizeCouponCode(dbModel.Code)
+	dbModel.DateCreated = existing.DateCreated
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	err = validatePromotion(ctx, db, params.ID, dbModel)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.NewUpdate().Model(dbModel).ExcludeColumn("id", "date_created").Where("id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update promotion %d!\n", sqlErr, params.ID)
+		return nil, errors.New(500, "ERROR: Could not update promotion %d!", params.ID)
+	}
+	return promotionToDTO(ctx, dbModel)
+}
+
+func deletePromotion(params *promotion.DeletePromotionParams, principal *models.Principal) errors.Error {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return err
+	}
+	// the discount lines of the orders keep the snapshot of the promotion, so only redemptions are deleted
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewDelete().TableExpr("promotion_redemptions").Where("promotion_id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete promotion %d redemptions!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete promotion %d!", params.ID)
+		}
+
+		query = tx.NewDelete().TableExpr("promotions").Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr = query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete promotion %d!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete promotion %d!", params.ID)
+		}
+		return nil
+	})
+}
+
+func getPromotion(params *promotion.GetPromotionParams, principal *models.Principal) (*models.Promotion, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbModel, err := getDBPromotion(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return promotionToDTO(ctx, dbModel)
+}
+
+func allPromotions(params *promotions.ListPromotionsParams, principal *models.Principal) ([]*models.Promotion, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbPromotions := make([]*dbModels.Promotion, 0)
+
+	query := db.NewSelect().Model(&dbPromotions).Order("id ASC")
+	if params.Limit != nil {
+		query.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		query.Offset(int(*params.Offset))
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find promotions!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find promotions!")
+	}
+
+	result := make([]*models.Promotion, len(dbPromotions))
+	for i, m := range dbPromotions {
+		result[i], err = promotionToDTO(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func getDBPromotion(ctx context.Context, id int64) (*dbModels.Promotion, errors.Error) {
+	dbModel := new(dbModels.Promotion)
+	query := db.NewSelect().Model(dbModel).Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find promotion %d!\n", sqlErr, id)
+		return nil, errors.New(404, "Could not find promotion %d!", id)
+	}
+	return dbModel, nil
+}
+
+func promotionToDTO(ctx context.Context, dbModel *dbModels.Promotion) (*models.Promotion, errors.Error) {
+	usageCount, err := countPromotionRedemptions(ctx, db, dbModel.ID, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := dbModel.ToDTO()
+	result.UsageCount = usageCount
+	return result, nil
+}
+
+// countPromotionRedemptions counts the orders the promotion has been applied to, except the cancelled ones,
+// which give their redemptions back; if the user ID is positive, only the orders of the user are counted
+func countPromotionRedemptions(ctx context.Context, idb bun.IDB, promotionID int64, userID int64) (int64, errors.Error) {
+	query := idb.NewSelect().Model((*dbModels.PromotionRedemption)(nil)).
+		Join("JOIN orders AS o ON o.id = promotion_redemption.order_id").
+		Where("promotion_redemption.promotion_id = ?", promotionID).
+		Where("o.status != ?", models.OrderStatusCancelled)
+	if userID > 0 {
+		query.Where("promotion_redemption.user_id = ?", userID)
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	count, sqlErr := query.Count(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not count promotion %d redemptions!\n", sqlErr, promotionID)
+		return 0, errors.New(500, "ERROR: Could not count promotion %d redemptions!", promotionID)
+	}
+	return int64(count), nil
+}
+
+// countGuestPromotionRedemptions counts the guest orders placed with the email the promotion has been applied to,
+// except the cancelled ones
+func countGuestPromotionRedemptions(ctx context.Context, idb bun.IDB, promotionID int64, email string) (int64, errors.Error) {
+	query := idb.NewSelect().Model((*dbModels.PromotionRedemption)(nil)).
+		Join("JOIN orders AS o ON o.id = promotion_redemption.order_id").
+		Where("promotion_redemption.promotion_id = ?", promotionID).
+		Where("o.status != ?", models.OrderStatusCancelled).
+		Where("LOWER(o.guest_email) = LOWER(?)", email)
+	Logger.Debug("Built the query %s\n", query)
+
+	count, sqlErr := query.Count(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not count promotion %d guest redemptions!\n", sqlErr, promotionID)
+		return 0, errors.New(500, "ERROR: Could not count promotion %d redemptions!", promotionID)
+	}
+	return int64(count), nil
+}
@@ -0,0 +1,54 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestCheckPromotionUsageLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		// statuses of the orders of user 1 the promotion has been applied to
+		statuses []string
+		limit    int64
+		perUser  int64
+		wantCode int32
+	}{
+		{"unused", nil, 1, 1, 0},
+		{"limit reached", []string{models.OrderStatusPaid}, 1, 0, 409},
+		{"per customer limit reached", []string{models.OrderStatusPendingPayment}, 0, 1, 409},
+		{"cancelled order", []string{models.OrderStatusCancelled}, 1, 1, 0},
+		{"cancelled and pending orders", []string{models.OrderStatusCancelled, models.OrderStatusPendingPayment}, 2, 0, 0},
+		{"cancelled and paid orders", []string{models.OrderStatusCancelled, models.OrderStatusPaid}, 2, 1, 409},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			ctx := context.Background()
+			promotion := &dbModels.Promotion{Active: true, Code: "SAVE10", Kind: swag.String(models.PromotionKindPercentage),
+				Title: swag.String("Save 10%"), Value: 10, UsageLimit: tt.limit, UsageLimitPerCustomer: tt.perUser}
+			if _, err := db.NewInsert().Model(promotion).ExcludeColumn("id").Returning("id").Exec(ctx, &promotion.ID); err != nil {
+				t.Fatal(err)
+			}
+			product := addTestProduct(t, 1000, 5)
+			for _, status := range tt.statuses {
+				order := addTestOrder(t, status, 1, product)
+				redemption := &dbModels.PromotionRedemption{DateCreated: time.Now().Unix(), OrderID: order.ID,
+					PromotionID: promotion.ID, UserID: order.UserID}
+				if _, err := db.NewInsert().Model(redemption).ExcludeColumn("id").Exec(ctx); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := checkPromotionUsageLimits(ctx, db, promotion, &dbModels.Order{UserID: 1})
+			if tt.wantCode == 0 && err != nil || tt.wantCode != 0 && (err == nil || err.Code() != tt.wantCode) {
+				t.Errorf("checkPromotionUsageLimits() = %v, want %d", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,585 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/refunds"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"math"
+	"strings"
+	"time"
+)
+
+// externalRefundReason is the reason of the refunds made through the payment gateway dashboard
+const externalRefundReason = "Refunded through the payment gateway"
+
+// refundStatuses maps the refund statuses reported by the payment gateways to the refund statuses;
+// the unknown ones (e. g., "requires_action") keep the refunds pending
+var refundStatuses = map[string]string{
+	"pending":   models.RefundStatusPending,
+	"succeeded": models.RefundStatusSucceeded,
+	"failed":    models.RefundStatusFailed,
+	"canceled":  models.RefundStatusCanceled,
+}
+
+func refundStatusOf(gatewayStatus string) string {
+	status, ok := refundStatuses[strings.ToLower(gatewayStatus)]
+	if !ok {
+		return models.RefundStatusPending
+	}
+	return status
+}
+
+// addPaymentRefundedAmount adds the refunded amount (negative for the reverted refunds) to the payment
+// and updates its status accordingly
+func addPaymentRefundedAmount(payment *dbModels.Payment, amount int64) {
+	payment.RefundedAmount = money.Max(payment.RefundedAmount+amount, 0)
+	switch {
+	case payment.RefundedAmount >= payment.Amount:
+		payment.Status = paymentStatusRefunded
+	case payment.RefundedAmount > 0:
+		payment.Status = paymentStatusPartiallyRefunded
+	case payment.Status == paymentStatusPartiallyRefunded || payment.Status == paymentStatusRefunded:
+		payment.Status = paymentStatusComplete
+	}
+}
+
+// createRefund refunds the payment through its payment gateway. The refund is recorded as pending first,
+// so it counts against the refundable amount while the gateway is called.
+func createRefund(params *refunds.CreateRefundParams, principal *models.Principal) (*models.Refund, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	payment, err := getDBPayment(params.HTTPRequest.Context(), db, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.PaymentIntentId == "" {
+		return nil, errors.New(409, "Payment %d has not been made through a payment gateway!", payment.ID)
+	}
+	if !isPaymentRefundable(payment.Status) {
+		return nil, errors.New(409, "Payment %d in status '%s' cannot be refunded!", payment.ID, payment.Status)
+	}
+	dbOrder, err := getOrderFromDB(payment.OrderID, true, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if dbOrder.Status == models.OrderStatusPendingPayment || dbOrder.Status == models.OrderStatusRefunded {
+		return nil, errors.New(409, "Order in status '%s' cannot be refunded!", dbOrder.Status)
+	}
+
+	if params.Body.Amount != nil && dbModels.MoneyCurrency(params.Body.Amount) != payment.Currency {
+		return nil, errors.New(400, "Refund amount must be in %s, the currency of the payment!", payment.Currency)
+	}
+	dbModel := &dbModels.Refund{
+		Amount:    dbModels.MoneyAmount(params.Body.Amount),
+		CreatedBy: principal.User.ID,
+		Currency:  payment.Currency,
+		Items:     make([]*dbModels.RefundItem, len(params.Body.Items)),
+		OrderID:   dbOrder.ID,
+		PaymentID: payment.ID,
+		Reason:    strings.TrimSpace(*params.Body.Reason),
+		Status:    models.RefundStatusPending,
+	}
+	if dbModel.Reason == "" {
+		return nil, errors.New(400, "Refund reason cannot be empty!")
+	}
+	for i, item := range params.Body.Items {
+		if item.Amount != nil && dbModels.MoneyCurrency(item.Amount) != payment.Currency {
+			return nil, errors.New(400, "Refund amount must be in %s, the currency of the payment!", payment.Currency)
+		}
+		dbModel.Items[i] = &dbModels.RefundItem{ProductID: *item.ProductID, Quantity: *item.Quantity,
+			Amount: dbModels.MoneyAmount(item.Amount)}
+	}
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		current, err := getDBPayment(ctx, tx, payment.ID)
+		if err != nil {
+			return err
+		}
+		*payment = *current
+		existing, err := findOrderRefunds(ctx, tx, dbOrder.ID)
+		if err != nil {
+			return err
+		}
+		orderReturns, err := findOrderReturns(ctx, tx, dbOrder.ID)
+		if err != nil {
+			return err
+		}
+		err = validateRefundItems(dbOrder, existing, orderReturns, dbModel)
+		if err != nil {
+			return err
+		}
+
+		refundable := payment.Amount - payment.RefundedAmount - pendingRefundAmount(payment.ID, existing, orderReturns)
+		if dbModel.Amount <= 0 {
+			if len(dbModel.Items) > 0 {
+				for _, item := range dbModel.Items {
+					dbModel.Amount += item.Amount
+				}
+			} else {
+				dbModel.Amount = refundable
+			}
+		}
+		if refundable <= 0 {
+			return errors.New(409, "Payment %d has been refunded already!", payment.ID)
+		}
+		if dbModel.Amount > refundable {
+			return errors.New(409, "Only %s of payment %d can be refunded!", money.New(refundable, payment.Currency),
+				payment.ID)
+		}
+		return addDBRefund(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayRefund, err := refundGatewayPayment(params.HTTPRequest.Context(), payment, dbModel.Amount,
+		fmt.Sprintf("refund-%d", dbModel.ID))
+	if err != nil {
+		txErr := runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+			return applyRefundStatus(ctx, tx, dbOrder, payment, dbModel, models.RefundStatusFailed, orderActorAdmin,
+				principal.User.ID)
+		})
+		if txErr != nil {
+			Logger.Error("Could not record refund %d of payment %d as failed: %v", dbModel.ID, payment.ID, txErr)
+		}
+		return nil, err
+	}
+
+	dbModel.GatewayRefundID = gatewayRefund.ID
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		return applyRefundStatus(ctx, tx, dbOrder, payment, dbModel, gatewayRefund.Status, orderActorAdmin,
+			principal.User.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// validateRefundItems checks the refunded items are ordered and neither refunded by the other refunds
+// nor received back by the order returns yet; the failed and canceled refunds do not count.
+// The items without an amount get the paid price of their quantity.
+func validateRefundItems(order *dbModels.Order, existing []*dbModels.Refund, orderReturns []*dbModels.OrderReturn, item *dbModels.Refund) errors.Error {
+	remaining := make(map[int64]int64)
+	for _, product := range order.Products {
+		remaining[*product.ProductID] += *product.Quantity
+	}
+	for _, r := range existing {
+		if r.Status == models.RefundStatusFailed || r.Status == models.RefundStatusCanceled {
+			continue
+		}
+		for _, refunded := range r.Items {
+			remaining[refunded.ProductID] -= refunded.Quantity
+		}
+	}
+	for _, r := range orderReturns {
+		for _, returned := range r.Items {
+			remaining[returned.ProductID] -= returned.ReceivedQuantity
+		}
+	}
+
+	taxableAmounts := allocateDiscounts(order)
+	for _, refunded := range item.Items {
+		quantity, ok := remaining[refunded.ProductID]
+		if !ok {
+			return errors.New(400, "Product %d is not ordered in order %d!", refunded.ProductID, order.ID)
+		}
+		if refunded.Quantity < 1 || refunded.Quantity > quantity {
+			return errors.New(409, "Only %d items of product %d of order %d can be refunded!",
+				int64(math.Max(float64(quantity), 0)), refunded.ProductID, order.ID)
+		}
+		remaining[refunded.ProductID] -= refunded.Quantity
+		if refunded.Amount <= 0 {
+			refunded.Amount = paidPrice(order, taxableAmounts, refunded.ProductID, refunded.Quantity)
+		}
+	}
+	return nil
+}
+
+// pendingRefundAmount sums up the refunds of the payment issued but not settled yet: the pending refunds
+// and the refunds of the returns pending their refund
+func pendingRefundAmount(paymentID int64, existing []*dbModels.Refund, orderReturns []*dbModels.OrderReturn) int64 {
+	var result int64 = 0
+	for _, r := range existing {
+		if r.PaymentID == paymentID && r.Status == models.RefundStatusPending {
+			result += r.Amount
+		}
+	}
+	for _, r := range orderReturns {
+		if r.PaymentID == paymentID && r.Status == models.OrderReturnStatusRefundPending {
+			result += r.RefundAmount
+		}
+	}
+	return result
+}
+
+// applyRefundStatus records the status of the refund reported by the payment gateway. Once the refund succeeds,
+// its amount is added to the refunded amounts of the payment and the order, a credit note is issued
+// and the order becomes partially refunded or refunded; a refund failing afterwards is deducted again.
+// The refund, the payment and the order are refreshed first, as the webhooks may have changed them meanwhile.
+func applyRefundStatus(ctx context.Context, idb bun.IDB, order *dbModels.Order, payment *dbModels.Payment,
+	dbModel *dbModels.Refund, gatewayStatus string, actorRole string, actorID int64) errors.Error {
+	err := refreshRefundState(ctx, idb, order, payment, dbModel)
+	if err != nil {
+		return err
+	}
+	wasSucceeded := dbModel.Status == models.RefundStatusSucceeded
+	dbModel.Status = refundStatusOf(gatewayStatus)
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	query := idb.NewUpdate().Model(dbModel).Column("status", "gateway_refund_id", "date_updated").
+		Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update refund %d status!\n", sqlErr, dbModel.ID)
+		return errors.New(500, "ERROR: Could not update refund %d!", dbModel.ID)
+	}
+	isSucceeded := dbModel.Status == models.RefundStatusSucceeded
+	if wasSucceeded == isSucceeded {
+		return nil
+	}
+
+	amount := dbModel.Amount
+	if wasSucceeded {
+		Logger.Info("Refund %d of payment %d is %s after it has succeeded", dbModel.ID, payment.ID, dbModel.Status)
+		amount = -amount
+	}
+	addPaymentRefundedAmount(payment, amount)
+	_, err = updateDBPayment(ctx, idb, payment)
+	if err != nil {
+		return err
+	}
+	order.RefundedTotal = money.Max(order.RefundedTotal+amount, 0)
+	orderQuery := idb.NewUpdate().Model(order).Column("refunded_total_minor").Where("id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", orderQuery)
+	if _, sqlErr = orderQuery.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update order %d refunded total!\n", sqlErr, order.ID)
+		return errors.New(500, "ERROR: Could not update order %d refunded total!", order.ID)
+	}
+	err = touchVersion(ctx, idb, "orders", order.ID)
+	if err != nil {
+		return err
+	}
+	if !isSucceeded {
+		return nil
+	}
+
+	err = issueCreditNote(ctx, idb, order.ID, refundCreditedRefund(dbModel))
+	if err != nil {
+		return err
+	}
+	toStatus := models.OrderStatusPartiallyRefunded
+	if order.RefundedTotal >= order.TotalPrice {
+		toStatus = models.OrderStatusRefunded
+	}
+	if toStatus == order.Status || checkOrderStatusTransition(order.Status, toStatus, actorRole) != nil {
+		Logger.Debug("Order %d status %s stays unchanged for refund %d", order.ID, order.Status, dbModel.ID)
+		return nil
+	}
+	return transitionOrderStatus(ctx, idb, order, toStatus, actorRole, actorID,
+		fmt.Sprintf("Refund %d of payment %d: %s", dbModel.ID, payment.ID, dbModel.Reason))
+}
+
+// refreshRefundState reads the current refund status, the payment and the order refunded total and status
+func refreshRefundState(ctx context.Context, idb bun.IDB, order *dbModels.Order, payment *dbModels.Payment,
+	dbModel *dbModels.Refund) errors.Error {
+	query := idb.NewSelect().Model(dbModel).Column("status").Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+	if sqlErr := query.Scan(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find refund %d!\n", sqlErr, dbModel.ID)
+		return errors.New(500, "ERROR: Could not find refund %d!", dbModel.ID)
+	}
+	current, err := getDBPayment(ctx, idb, payment.ID)
+	if err != nil {
+		return err
+	}
+	*payment = *current
+	orderQuery := idb.NewSelect().Model(order).Column("status", "refunded_total_minor").Where("id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", orderQuery)
+	if sqlErr := orderQuery.Scan(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, order.ID)
+		return errors.New(500, "ERROR: Could not find order %d!", order.ID)
+	}
+	return nil
+}
+
+// refundCreditedRefund describes the refund of the refunded items
+func refundCreditedRefund(dbModel *dbModels.Refund) *creditedRefund {
+	result := &creditedRefund{
+		Amount:     dbModel.Amount,
+		Reference:  fmt.Sprintf("Refund: %d", dbModel.ID),
+		ItemPrefix: "Refund: ",
+		Items:      make([]*creditedItem, 0, len(dbModel.Items)),
+		RefundID:   dbModel.ID,
+	}
+	for _, item := range dbModel.Items {
+		result.Items = append(result.Items, &creditedItem{ProductID: item.ProductID, Quantity: item.Quantity,
+			Amount: item.Amount})
+	}
+	return result
+}
+
+// processRefundUpdate records the status of the refund reported by the payment gateway;
+// the refunds unknown yet are recorded by processRefundedPayment
+func processRefundUpdate(event *PaymentEvent) errors.Error {
+	for _, gatewayRefund := range event.Refunds {
+		dbModel, err := getDBRefundByGatewayID(context.Background(), db, gatewayRefund.ID)
+		if err != nil {
+			if err.Code() != 404 {
+				return err
+			}
+			// the refunds made through the gateway dashboard are recorded by the payment refunded events
+			Logger.Debug("processRefundUpdate: ignoring refund %s of event %s: %v", gatewayRefund.ID, event.ID, err)
+			continue
+		}
+		payment, err := getDBPayment(context.Background(), db, dbModel.PaymentID)
+		if err != nil {
+			Logger.Error("processRefundUpdate: Could not find payment of refund %d: %v", dbModel.ID, err)
+			return err
+		}
+		order, err := getOrderFromDB(dbModel.OrderID, true, -1)
+		if err != nil {
+			Logger.Error("processRefundUpdate: Could not find order of refund %d: %v", dbModel.ID, err)
+			return err
+		}
+		err = runInTx(context.Background(), func(ctx context.Context, tx bun.Tx) errors.Error {
+			return applyRefundStatus(ctx, tx, order, payment, dbModel, gatewayRefund.Status, orderActorSystem, 0)
+		})
+		if err != nil {
+			Logger.Error("processRefundUpdate: Could not update refund %d: %v", dbModel.ID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// processRefundedPayment records the refunds of the payment reported by the payment gateway. The refunds made
+// through the gateway dashboard are recorded as well, so the refunded amount of the payment matches the gateway one.
+// The refunds of the returns are matched to the returns, which are completed if they are pending their refund.
+func processRefundedPayment(event *PaymentEvent) errors.Error {
+	payment, err := getDBPaymentByPaymentIntentID(context.Background(), db, event.PaymentIntentID)
+	if err != nil {
+		Logger.Error("processRefundedPayment: Could not find payment of event %s: %v", event.ID, err)
+		return err
+	}
+	order, err := getOrderFromDB(payment.OrderID, true, -1)
+	if err != nil {
+		Logger.Error("processRefundedPayment: Could not find order of payment %d: %v", payment.ID, err)
+		return err
+	}
+
+	err = runInTx(context.Background(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		for _, gatewayRefund := range event.Refunds {
+			dbModel, err := getDBRefundByGatewayID(ctx, tx, gatewayRefund.ID)
+			if err != nil && err.Code() != 404 {
+				return err
+			}
+			if dbModel == nil {
+				// the refund issued by createRefund may be reported before its gateway ID is recorded
+				dbModel, err = findUnmatchedRefund(ctx, tx, payment.ID, gatewayRefund.Amount)
+				if err != nil {
+					return err
+				}
+			}
+			if dbModel == nil {
+				// the refunds of the returns are recorded by the returns, not as refunds
+				orderReturn, err := findReturnOfGatewayRefund(ctx, tx, payment.ID, gatewayRefund)
+				if err != nil {
+					return err
+				}
+				if orderReturn != nil {
+					if orderReturn.Status != models.OrderReturnStatusRefundPending ||
+						refundStatusOf(gatewayRefund.Status) != models.RefundStatusSucceeded {
+						continue
+					}
+					err = completeReturnRefund(ctx, tx, order, payment, orderReturn, gatewayRefund.ID, orderActorSystem, 0)
+					if err != nil {
+						return err
+					}
+					continue
+				}
+			}
+			if dbModel != nil {
+				dbModel.GatewayRefundID = gatewayRefund.ID
+			} else {
+				dbModel = &dbModels.Refund{
+					Amount:          gatewayRefund.Amount,
+					Currency:        payment.Currency,
+					GatewayRefundID: gatewayRefund.ID,
+					Items:           make([]*dbModels.RefundItem, 0),
+					OrderID:         order.ID,
+					PaymentID:       payment.ID,
+					Reason:          externalRefundReason,
+					Status:          models.RefundStatusPending,
+				}
+				if err = addDBRefund(ctx, tx, dbModel); err != nil {
+					return err
+				}
+			}
+			err = applyRefundStatus(ctx, tx, order, payment, dbModel, gatewayRefund.Status, orderActorSystem, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the refunds the event does not list
+		existing, err := findOrderRefunds(ctx, tx, order.ID)
+		if err != nil {
+			return err
+		}
+		orderReturns, err := findOrderReturns(ctx, tx, order.ID)
+		if err != nil {
+			return err
+		}
+		unrecorded := event.RefundedAmount - payment.RefundedAmount -
+			pendingRefundAmount(payment.ID, existing, orderReturns)
+		if unrecorded <= 0 {
+			return nil
+		}
+		dbModel := &dbModels.Refund{
+			Amount:    unrecorded,
+			Currency:  payment.Currency,
+			Items:     make([]*dbModels.RefundItem, 0),
+			OrderID:   order.ID,
+			PaymentID: payment.ID,
+			Reason:    externalRefundReason,
+			Status:    models.RefundStatusPending,
+		}
+		if err = addDBRefund(ctx, tx, dbModel); err != nil {
+			return err
+		}
+		return applyRefundStatus(ctx, tx, order, payment, dbModel, models.RefundStatusSucceeded, orderActorSystem, 0)
+	})
+	if err != nil {
+		Logger.Error("processRefundedPayment: Could not record refunds of payment %d: %v", payment.ID, err)
+	}
+	return err
+}
+
+func allRefunds(params *refunds.ListRefundsParams, principal *models.Principal) ([]*models.Refund, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	payment, err := getDBPayment(params.HTTPRequest.Context(), db, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbRefunds := make([]*dbModels.Refund, 0)
+	query := db.NewSelect().Model(&dbRefunds).Where("payment_id = ?", payment.ID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find payment %d refunds!\n", sqlErr, payment.ID)
+		return nil, errors.New(500, "ERROR: Could not find payment %d refunds!", payment.ID)
+	}
+	return dbModels.RefundDTOsFromRefunds(dbRefunds), nil
+}
+
+func addDBRefund(ctx context.Context, idb bun.IDB, dbModel *dbModels.Refund) errors.Error {
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+	query := idb.NewInsert().Model(dbModel).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add payment %d refund %v!\n", sqlErr, dbModel.PaymentID, dbModel)
+		return errors.New(500, "ERROR: Could not add payment %d refund!", dbModel.PaymentID)
+	}
+	dbModel.ID, sqlErr = res.LastInsertId()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find last insert ID for payment %d refund!", sqlErr, dbModel.PaymentID)
+		return errors.New(500, "ERROR: Could not add payment %d refund!", dbModel.PaymentID)
+	}
+	return nil
+}
+
+func findOrderRefunds(ctx context.Context, idb bun.IDB, orderID int64) ([]*dbModels.Refund, errors.Error) {
+	result := make([]*dbModels.Refund, 0)
+	query := idb.NewSelect().Model(&result).Where("order_id = ?", orderID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d refunds!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d refunds!", orderID)
+	}
+	return result, nil
+}
+
+func getDBRefundByGatewayID(ctx context.Context, idb bun.IDB, gatewayRefundID string) (*dbModels.Refund, errors.Error) {
+	result := make([]*dbModels.Refund, 0)
+	query := idb.NewSelect().Model(&result).Where("gateway_refund_id = ?", gatewayRefundID).Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find refund %s!\n", sqlErr, gatewayRefundID)
+		return nil, errors.New(500, "ERROR: Could not find refund %s!", gatewayRefundID)
+	}
+	if gatewayRefundID == "" || len(result) == 0 {
+		return nil, errors.New(404, "Could not find refund %s!", gatewayRefundID)
+	}
+	return result[0], nil
+}
+
+// findUnmatchedRefund finds the pending refund of the payment with the amount which has no gateway ID yet
+func findUnmatchedRefund(ctx context.Context, idb bun.IDB, paymentID int64, amount int64) (*dbModels.Refund, errors.Error) {
+	result := make([]*dbModels.Refund, 0)
+	query := idb.NewSelect().Model(&result).
+		Where("payment_id = ?", paymentID).
+		Where("status = ?", models.RefundStatusPending).
+		Where("gateway_refund_id = ''").
+		Where("amount_minor = ?", amount).
+		Order("id ASC").Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find payment %d refunds!\n", sqlErr, paymentID)
+		return nil, errors.New(500, "ERROR: Could not find payment %d refunds!", paymentID)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result[0], nil
+}
+
+// findReturnOfGatewayRefund finds the return of the payment refunded by the gateway refund, or else the return
+// pending its refund of the amount which has no refund ID yet, as the refund may be reported before it is recorded
+func findReturnOfGatewayRefund(ctx context.Context, idb bun.IDB, paymentID int64, gatewayRefund *GatewayRefund) (*dbModels.OrderReturn, errors.Error) {
+	result := make([]*dbModels.OrderReturn, 0)
+	query := idb.NewSelect().Model(&result).
+		Where("payment_id = ?", paymentID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("refund_id = ?", gatewayRefund.ID).
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.Where("status = ?", models.OrderReturnStatusRefundPending).
+						Where("refund_id = ''").
+						Where("refund_amount_minor = ?", gatewayRefund.Amount)
+				})
+		}).
+		OrderExpr("refund_id = ? DESC, id ASC", gatewayRefund.ID).Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find payment %d returns!\n", sqlErr, paymentID)
+		return nil, errors.New(500, "ERROR: Could not find payment %d returns!", paymentID)
+	}
+	if gatewayRefund.ID == "" || len(result) == 0 {
+		return nil, nil
+	}
+	return result[0], nil
+}
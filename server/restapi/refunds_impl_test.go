@@ -0,0 +1,279 @@
+package restapi
+
+import (
+	"context"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/refunds"
+	"estore-backend/server/restapi/operations/returns"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestProcessRefundedPaymentOfReturn(t *testing.T) {
+	tests := []struct {
+		name string
+		// the refund is reported before the inspection records it, while the return is pending its refund
+		reportedFirst bool
+	}{
+		{"webhook after the return refund", false},
+		{"webhook before the return refund is recorded", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			gateway := registerTestGateway(t)
+			ctx := context.Background()
+			product := addTestProduct(t, 1500, 0)
+			order := addTestOrder(t, models.OrderStatusDelivered, 2, product)
+			payment := addTestPayment(t, order, paymentStatusComplete)
+			if err := issueInvoice(ctx, db, order.ID); err != nil {
+				t.Fatal(err)
+			}
+			orderReturn := addTestReturn(t, order, 1)
+
+			inspect := &returns.InspectReturnParams{HTTPRequest: testRequest(), ID: order.ID,
+				ReturnID: orderReturn.ID, Body: &models.ReturnInspection{Items: []*models.ReturnInspectionItem{
+					{ProductID: swag.Int64(product.ID), ReceivedQuantity: swag.Int64(1)}}}}
+			var refund *GatewayRefund
+			if tt.reportedFirst {
+				// the gateway has refunded the return, but recording the refund has failed
+				gateway.refundErr = errTestGateway
+				if _, err := inspectReturn(inspect, testAdmin()); err == nil {
+					t.Fatal("inspectReturn() with a failing gateway succeeded")
+				}
+				gateway.refundErr = nil
+				var gatewayErr error
+				refund, gatewayErr = gateway.RefundPayment(ctx, payment.PaymentIntentId, 1500, orderReturn.RmaNumber)
+				if gatewayErr != nil {
+					t.Fatal(gatewayErr)
+				}
+			} else {
+				if _, err := inspectReturn(inspect, testAdmin()); err != nil {
+					t.Fatal(err)
+				}
+				refund = gateway.refunds[orderReturn.RmaNumber]
+			}
+
+			event := &PaymentEvent{ID: "evt_refunded", Type: paymentEventPaymentRefunded,
+				PaymentIntentID: payment.PaymentIntentId, RefundedAmount: 1500, Refunds: []*GatewayRefund{refund}}
+			for i := 0; i < 2; i++ {
+				if err := processRefundedPayment(event); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.reportedFirst {
+				_, err := retryReturnRefund(&returns.RetryReturnRefundParams{HTTPRequest: testRequest(), ID: order.ID,
+					ReturnID: orderReturn.ID}, testAdmin())
+				if err == nil || err.Code() != 409 {
+					t.Errorf("retryReturnRefund() of the return refunded by the webhook = %v, want 409", err)
+				}
+			}
+
+			refunded, err := getDBOrderReturn(ctx, db, order.ID, orderReturn.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if refunded.Status != models.OrderReturnStatusRefunded || refunded.RefundID != refund.ID {
+				t.Errorf("return = %s with refund %q, want %s with refund %q", refunded.Status, refunded.RefundID,
+					models.OrderReturnStatusRefunded, refund.ID)
+			}
+			updated, err := getDBPayment(ctx, db, payment.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.RefundedAmount != 1500 {
+				t.Errorf("payment refunded amount = %d, want 1500", updated.RefundedAmount)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND refunded_total_minor = 1500", order.ID); n != 1 {
+				t.Error("order refunded total is not 1500")
+			}
+			if n := countTestRows(t, "refunds", "order_id = ?", order.ID); n != 0 {
+				t.Errorf("refunds = %d, want none", n)
+			}
+			if n := countTestRows(t, "invoices", "order_id = ? AND kind = ?", order.ID, models.InvoiceKindCreditNote); n != 1 {
+				t.Errorf("credit notes = %d, want 1", n)
+			}
+		})
+	}
+}
+
+func TestProcessRefundedPaymentOfDashboardRefund(t *testing.T) {
+	newTestStore(t)
+	registerTestGateway(t)
+	product := addTestProduct(t, 1500, 0)
+	order := addTestOrder(t, models.OrderStatusDelivered, 2, product)
+	payment := addTestPayment(t, order, paymentStatusComplete)
+
+	event := &PaymentEvent{ID: "evt_refunded", Type: paymentEventPaymentRefunded,
+		PaymentIntentID: payment.PaymentIntentId, RefundedAmount: 1000,
+		Refunds: []*GatewayRefund{{ID: "re_dashboard", Amount: 1000, Status: "succeeded"}}}
+	for i := 0; i < 2; i++ {
+		if err := processRefundedPayment(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := countTestRows(t, "refunds", "order_id = ? AND reason = ? AND status = ?", order.ID,
+		externalRefundReason, models.RefundStatusSucceeded); n != 1 {
+		t.Errorf("external refunds = %d, want 1", n)
+	}
+	updated, err := getDBPayment(context.Background(), db, payment.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.RefundedAmount != 1000 || updated.Status != paymentStatusPartiallyRefunded {
+		t.Errorf("payment = %s refunded %d, want %s refunded 1000", updated.Status, updated.RefundedAmount,
+			paymentStatusPartiallyRefunded)
+	}
+}
+
+func testRefundParams(paymentID int64, amount int64, currency string, items ...*models.RefundItem) *refunds.CreateRefundParams {
+	body := &models.Refund{Reason: swag.String("Damaged"), Items: items}
+	if amount > 0 {
+		body.Amount = &models.Money{Amount: swag.Int64(amount), Currency: swag.String(currency)}
+	}
+	return &refunds.CreateRefundParams{HTTPRequest: testRequest(), ID: paymentID, Body: body}
+}
+
+func TestCreateRefund(t *testing.T) {
+	tests := []struct {
+		name string
+		// amount refunded by an earlier refund of the payment
+		refundedBefore int64
+		amount         int64
+		currency       string
+		// quantity of the refunded items of the product instead of an amount
+		quantity          int64
+		gatewayErr        error
+		wantCode          int32
+		wantRefunded      int64
+		wantPaymentStatus string
+		wantOrderStatus   string
+	}{
+		{"whole payment", 0, 0, "", 0, nil, 0, 2000, paymentStatusRefunded, models.OrderStatusRefunded},
+		{"partial amount", 0, 500, "", 0, nil, 0, 500, paymentStatusPartiallyRefunded,
+			models.OrderStatusPartiallyRefunded},
+		{"items", 0, 0, "", 1, nil, 0, 1000, paymentStatusPartiallyRefunded, models.OrderStatusPartiallyRefunded},
+		{"rest of the payment", 1500, 500, "", 0, nil, 0, 2000, paymentStatusRefunded, models.OrderStatusRefunded},
+		{"exceeding the payment", 0, 2500, "", 0, nil, 409, 0, paymentStatusComplete, models.OrderStatusDelivered},
+		{"exceeding the rest", 1500, 600, "", 0, nil, 409, 1500, paymentStatusPartiallyRefunded,
+			models.OrderStatusPartiallyRefunded},
+		{"refunded already", 2000, 0, "", 0, nil, 409, 2000, paymentStatusRefunded, models.OrderStatusRefunded},
+		{"other currency", 0, 500, "EUR", 0, nil, 400, 0, paymentStatusComplete, models.OrderStatusDelivered},
+		{"more items than ordered", 0, 0, "", 3, nil, 409, 0, paymentStatusComplete, models.OrderStatusDelivered},
+		{"failing gateway", 0, 500, "", 0, errTestGateway, 502, 0, paymentStatusComplete,
+			models.OrderStatusDelivered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			gateway := registerTestGateway(t)
+			product := addTestProduct(t, 1000, 0)
+			order := addTestOrder(t, models.OrderStatusDelivered, 2, product)
+			payment := addTestPayment(t, order, paymentStatusComplete)
+			if tt.refundedBefore > 0 {
+				if _, err := createRefund(testRefundParams(payment.ID, tt.refundedBefore, order.Currency), testAdmin()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.currency == "" {
+				tt.currency = order.Currency
+			}
+			var items []*models.RefundItem
+			if tt.quantity > 0 {
+				items = append(items, &models.RefundItem{ProductID: swag.Int64(product.ID), Quantity: swag.Int64(tt.quantity)})
+			}
+			gateway.refundErr = tt.gatewayErr
+
+			refund, err := createRefund(testRefundParams(payment.ID, tt.amount, tt.currency, items...), testAdmin())
+			if tt.wantCode != 0 {
+				if err == nil || err.Code() != tt.wantCode {
+					t.Errorf("createRefund() = %v, want %d", err, tt.wantCode)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			} else if refund.Status != models.RefundStatusSucceeded || refund.GatewayRefundID == "" {
+				t.Errorf("refund = %s of gateway refund %q, want succeeded", refund.Status, refund.GatewayRefundID)
+			}
+
+			updated, err := getDBPayment(context.Background(), db, payment.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.RefundedAmount != tt.wantRefunded || updated.Status != tt.wantPaymentStatus {
+				t.Errorf("payment = %s refunded %d, want %s refunded %d", updated.Status, updated.RefundedAmount,
+					tt.wantPaymentStatus, tt.wantRefunded)
+			}
+			if n := countTestRows(t, "orders", "id = ? AND status = ? AND refunded_total_minor = ?", order.ID,
+				tt.wantOrderStatus, tt.wantRefunded); n != 1 {
+				t.Errorf("order is not %s refunded %d", tt.wantOrderStatus, tt.wantRefunded)
+			}
+			if n := countTestRows(t, "refunds", "order_id = ? AND status = ?", order.ID,
+				models.RefundStatusPending); n != 0 {
+				t.Errorf("pending refunds = %d, want none", n)
+			}
+			if tt.gatewayErr != nil {
+				if n := countTestRows(t, "refunds", "order_id = ? AND status = ?", order.ID,
+					models.RefundStatusFailed); n != 1 {
+					t.Errorf("failed refunds = %d, want 1", n)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessRefundEvents(t *testing.T) {
+	newTestStore(t)
+	registerTestGateway(t)
+	product := addTestProduct(t, 1000, 0)
+	order := addTestOrder(t, models.OrderStatusDelivered, 2, product)
+	payment := addTestPayment(t, order, paymentStatusComplete)
+	refund, err := createRefund(testRefundParams(payment.ID, 1000, order.Currency), testAdmin())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the events are delivered one after another, some of them twice
+	tests := []struct {
+		name           string
+		eventType      string
+		status         string
+		refundedAmount int64
+		wantRefunded   int64
+		wantStatus     string
+	}{
+		{"refund succeeded", paymentEventRefundUpdated, "succeeded", 1000, 1000, paymentStatusPartiallyRefunded},
+		{"payment refunded", paymentEventPaymentRefunded, "succeeded", 1000, 1000, paymentStatusPartiallyRefunded},
+		{"payment refunded again", paymentEventPaymentRefunded, "succeeded", 1000, 1000,
+			paymentStatusPartiallyRefunded},
+		{"refund failed", paymentEventRefundUpdated, "failed", 0, 0, paymentStatusComplete},
+		{"refund failed again", paymentEventRefundUpdated, "failed", 0, 0, paymentStatusComplete},
+		{"payment of the failed refund", paymentEventPaymentRefunded, "failed", 0, 0, paymentStatusComplete},
+	}
+	for i, tt := range tests {
+		event := &PaymentEvent{ID: fmt.Sprintf("evt_%d", i), Type: tt.eventType,
+			PaymentIntentID: payment.PaymentIntentId, RefundedAmount: tt.refundedAmount,
+			Refunds: []*GatewayRefund{{ID: refund.GatewayRefundID, Amount: 1000, Status: tt.status}}}
+		var err error
+		if tt.eventType == paymentEventRefundUpdated {
+			err = processRefundUpdate(event)
+		} else {
+			err = processRefundedPayment(event)
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		updated, dbErr := getDBPayment(context.Background(), db, payment.ID)
+		if dbErr != nil {
+			t.Fatal(dbErr)
+		}
+		if updated.RefundedAmount != tt.wantRefunded || updated.Status != tt.wantStatus {
+			t.Errorf("%s: payment = %s refunded %d, want %s refunded %d", tt.name, updated.Status,
+				updated.RefundedAmount, tt.wantStatus, tt.wantRefunded)
+		}
+		if n := countTestRows(t, "refunds", "order_id = ?", order.ID); n != 1 {
+			t.Errorf("%s: refunds = %d, want 1", tt.name, n)
+		}
+	}
+}
@@ -0,0 +1,568 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/returns"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"time"
+)
+
+// returnOrderStatuses are the statuses of the orders the items of which can be returned
+var returnOrderStatuses = []string{models.OrderStatusShipped, models.OrderStatusDelivered,
+	models.OrderStatusPartiallyRefunded}
+
+func requestReturn(params *returns.RequestReturnParams, principal *models.Principal) (*models.OrderReturn, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	// restricts the non-admin users to their own orders
+	dbOrder, err := getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(returnOrderStatuses, dbOrder.Status) {
+		return nil, errors.New(409, "Items of order in status '%s' cannot be returned!", dbOrder.Status)
+	}
+
+	dbModel := dbModels.NewOrderReturnFrom(params.Body)
+	dbModel.ID = 0
+	dbModel.OrderID = dbOrder.ID
+	dbModel.UserID = dbOrder.UserID
+	dbModel.Status = models.OrderReturnStatusRequested
+	dbModel.RmaNumber = ""
+	dbModel.ResolutionComment = ""
+	dbModel.RefundAmount = 0
+	dbModel.Currency = dbOrder.Currency
+	dbModel.RefundID = ""
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		existing, err := findOrderReturns(ctx, tx, dbOrder.ID)
+		if err != nil {
+			return err
+		}
+		err = validateReturnItems(dbOrder, existing, dbModel)
+		if err != nil {
+			return err
+		}
+
+		query := tx.NewInsert().Model(dbModel).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %d return %v!\n", sqlErr, dbOrder.ID, params.Body)
+			return errors.New(500, "ERROR: Could not add order %d return!", dbOrder.ID)
+		}
+		dbModel.ID, sqlErr = res.LastInsertId()
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find last insert ID for order %d return!", sqlErr, dbOrder.ID)
+			return errors.New(500, "ERROR: Could not add order %d return!", dbOrder.ID)
+		}
+		// the returns are a part of the order representation
+		return touchVersion(ctx, tx, "orders", dbOrder.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// validateReturnItems checks the returned items are ordered and not requested by the other order returns yet;
+// rejected returns do not count
+func validateReturnItems(order *dbModels.Order, existing []*dbModels.OrderReturn, item *dbModels.OrderReturn) errors.Error {
+	if len(item.Items) == 0 {
+		return errors.New(400, "Return item list cannot be empty!")
+	}
+	remaining := make(map[int64]int64)
+	for _, product := range order.Products {
+		remaining[*product.ProductID] += *product.Quantity
+	}
+	for _, r := range existing {
+		if r.Status == models.OrderReturnStatusRejected {
+			continue
+		}
+		for _, returned := range r.Items {
+			remaining[returned.ProductID] -= returned.Quantity
+		}
+	}
+	for _, returned := range item.Items {
+		returned.Reason = strings.TrimSpace(returned.Reason)
+		returned.ReceivedQuantity = 0
+		returned.RestockedQuantity = 0
+		if returned.Reason == "" {
+			return errors.New(400, "Return reason of product %d cannot be empty!", returned.ProductID)
+		}
+		quantity, ok := remaining[returned.ProductID]
+		if !ok {
+			return errors.New(400, "Product %d is not ordered in order %d!", returned.ProductID, order.ID)
+		}
+		if returned.Quantity < 1 || returned.Quantity > quantity {
+			return errors.New(409, "Only %d items of product %d of order %d can be returned!",
+				quantity, returned.ProductID, order.ID)
+		}
+		remaining[returned.ProductID] = quantity - returned.Quantity
+	}
+	return nil
+}
+
+func decideReturn(params *returns.DecideReturnParams, principal *models.Principal) (*models.OrderReturn, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	var dbModel *dbModels.OrderReturn
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel, err = getDBOrderReturn(ctx, tx, params.ID, params.ReturnID)
+		if err != nil {
+			return err
+		}
+		if dbModel.Status != models.OrderReturnStatusRequested {
+			return errors.New(409, "Return in status '%s' cannot be decided on!", dbModel.Status)
+		}
+		dbModel.Status = models.OrderReturnStatusRejected
+		if *params.Body.Approved {
+			dbModel.Status = models.OrderReturnStatusApproved
+			dbModel.RmaNumber = fmt.Sprintf("RMA-%d-%d", dbModel.OrderID, dbModel.ID)
+		}
+		dbModel.ResolutionComment = params.Body.Comment
+		return updateDBOrderReturn(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// inspectReturn records the received items of the approved return, puts the sellable ones back in stock
+// and refunds the paid price of the received items. Once all the ordered items are received back,
+// the rest of the order total (e. g., shipping) is refunded too and the order is moved to the refunded status.
+// The return is recorded as pending its refund before the payment gateway is called, so a failed refund
+// can be retried by retryReturnRefund without receiving the items twice.
+func inspectReturn(params *returns.InspectReturnParams, principal *models.Principal) (*models.OrderReturn, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbOrder, err := getOrderFromDB(params.ID, true, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var dbModel *dbModels.OrderReturn
+	var payment *dbModels.Payment
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		dbModel, err = getDBOrderReturn(ctx, tx, params.ID, params.ReturnID)
+		if err != nil {
+			return err
+		}
+		if dbModel.Status != models.OrderReturnStatusApproved {
+			return errors.New(409, "Return in status '%s' cannot be inspected!", dbModel.Status)
+		}
+		err = receiveReturnItems(ctx, tx, dbModel, params.Body.Items)
+		if err != nil {
+			return err
+		}
+
+		existing, err := findOrderReturns(ctx, tx, dbOrder.ID)
+		if err != nil {
+			return err
+		}
+		refundable := dbOrder.TotalPrice - dbOrder.RefundedTotal
+		dbModel.Currency = dbOrder.Currency
+		switch {
+		case isOrderFullyReturned(dbOrder, existing, dbModel):
+			dbModel.RefundAmount = refundable
+		case dbModels.MoneyAmount(params.Body.RefundAmount) > 0:
+			if dbModels.MoneyCurrency(params.Body.RefundAmount) != dbOrder.Currency {
+				return errors.New(400, "Refund amount must be in %s, the currency of the order!", dbOrder.Currency)
+			}
+			dbModel.RefundAmount = money.Min(dbModels.MoneyAmount(params.Body.RefundAmount), refundable)
+		default:
+			dbModel.RefundAmount = money.Min(calculateReturnRefund(dbOrder, dbModel), refundable)
+		}
+		dbModel.Status = models.OrderReturnStatusReceived
+		if dbModel.RefundAmount > 0 {
+			dbModel.Status = models.OrderReturnStatusRefundPending
+		}
+		if params.Body.Comment != "" {
+			dbModel.ResolutionComment = params.Body.Comment
+		}
+
+		payment, err = findOrderRefundablePayment(ctx, tx, dbOrder.ID)
+		if err != nil {
+			return err
+		}
+		if payment != nil {
+			dbModel.PaymentID = payment.ID
+		}
+		return updateDBOrderReturn(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dbModel.Status != models.OrderReturnStatusRefundPending {
+		return dbModel.ToDTO(), nil
+	}
+	return refundReturn(params.HTTPRequest.Context(), dbOrder, payment, dbModel, principal.User.ID)
+}
+
+// retryReturnRefund refunds the inspected return again after its refund has failed
+func retryReturnRefund(params *returns.RetryReturnRefundParams, principal *models.Principal) (*models.OrderReturn, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbOrder, err := getOrderFromDB(params.ID, true, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBOrderReturn(params.HTTPRequest.Context(), db, params.ID, params.ReturnID)
+	if err != nil {
+		return nil, err
+	}
+	if dbModel.Status != models.OrderReturnStatusRefundPending {
+		return nil, errors.New(409, "Return in status '%s' has no refund to retry!", dbModel.Status)
+	}
+	var payment *dbModels.Payment
+	if dbModel.PaymentID != 0 {
+		payment, err = getDBPayment(params.HTTPRequest.Context(), db, dbModel.PaymentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return refundReturn(params.HTTPRequest.Context(), dbOrder, payment, dbModel, principal.User.ID)
+}
+
+// refundReturn refunds the return pending its refund through the gateway of the payment and records the refund;
+// the returns of the orders not paid through a gateway are settled outside of it. The RMA number is the idempotency
+// key of the gateway refund, so a retry does not refund the return twice even if the refund has been issued
+// but not recorded. If the gateway fails, the return stays pending its refund.
+func refundReturn(ctx context.Context, dbOrder *dbModels.Order, payment *dbModels.Payment, dbModel *dbModels.OrderReturn, actorID int64) (*models.OrderReturn, errors.Error) {
+	refundID := ""
+	if payment != nil {
+		refund, err := refundGatewayPayment(ctx, payment, dbModel.RefundAmount, dbModel.RmaNumber)
+		if err != nil {
+			return nil, err
+		}
+		if status := refundStatusOf(refund.Status); status == models.RefundStatusFailed ||
+			status == models.RefundStatusCanceled {
+			return nil, errors.New(502, "Refund %s of return %s is %s!", refund.ID, dbModel.RmaNumber, status)
+		}
+		refundID = refund.ID
+	}
+	err := runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		return completeReturnRefund(ctx, tx, dbOrder, payment, dbModel, refundID, orderActorAdmin, actorID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err = getDBOrderReturn(ctx, db, dbModel.OrderID, dbModel.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// completeReturnRefund records the refund of the return pending its refund: the return becomes refunded,
+// its amount is added to the refunded amounts of the payment and the order, a credit note is issued and
+// the order becomes refunded once all its items are received back. A return refunded meanwhile (e. g.,
+// by the refund webhook) is left as it is, so the refund is counted once.
+func completeReturnRefund(ctx context.Context, idb bun.IDB, order *dbModels.Order, payment *dbModels.Payment,
+	dbModel *dbModels.OrderReturn, refundID string, actorRole string, actorID int64) errors.Error {
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	query := idb.NewUpdate().TableExpr("order_returns").
+		Set("status = ?", models.OrderReturnStatusRefunded).
+		Set("refund_id = ?", refundID).
+		Set("date_updated = ?", dbModel.DateUpdated).
+		Where("id = ?", dbModel.ID).
+		Where("status = ?", models.OrderReturnStatusRefundPending)
+	Logger.Debug("Built the query %s\n", query)
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update order %d return %d!\n", sqlErr, dbModel.OrderID, dbModel.ID)
+		return errors.New(500, "ERROR: Could not update order %d return %d!", dbModel.OrderID, dbModel.ID)
+	}
+	if rows, sqlErr := res.RowsAffected(); sqlErr != nil || rows != 1 {
+		Logger.Info("Return %d of order %d has been refunded already", dbModel.ID, dbModel.OrderID)
+		return nil
+	}
+	dbModel.Status = models.OrderReturnStatusRefunded
+	dbModel.RefundID = refundID
+
+	if payment != nil {
+		current, err := getDBPayment(ctx, idb, payment.ID)
+		if err != nil {
+			return err
+		}
+		*payment = *current
+		addPaymentRefundedAmount(payment, dbModel.RefundAmount)
+		if _, err = updateDBPayment(ctx, idb, payment); err != nil {
+			return err
+		}
+	}
+	orderQuery := idb.NewSelect().Model(order).Column("status", "refunded_total_minor").Where("id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", orderQuery)
+	if sqlErr = orderQuery.Scan(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, order.ID)
+		return errors.New(500, "ERROR: Could not find order %d!", order.ID)
+	}
+	order.RefundedTotal += dbModel.RefundAmount
+	updateQuery := idb.NewUpdate().Model(order).Column("refunded_total_minor").Where("id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", updateQuery)
+	if _, sqlErr = updateQuery.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update order %d refunded total!\n", sqlErr, order.ID)
+		return errors.New(500, "ERROR: Could not update order %d refunded total!", order.ID)
+	}
+	err := touchVersion(ctx, idb, "orders", order.ID)
+	if err != nil {
+		return err
+	}
+	err = issueCreditNote(ctx, idb, order.ID, returnCreditedRefund(dbModel))
+	if err != nil {
+		return err
+	}
+
+	existing, err := findOrderReturns(ctx, idb, order.ID)
+	if err != nil {
+		return err
+	}
+	if !isOrderFullyReturned(order, existing, dbModel) {
+		return nil
+	}
+	if order.Status == models.OrderStatusRefunded ||
+		checkOrderStatusTransition(order.Status, models.OrderStatusRefunded, actorRole) != nil {
+		Logger.Debug("Order %d status %s stays unchanged for return %d", order.ID, order.Status, dbModel.ID)
+		return nil
+	}
+	return transitionOrderStatus(ctx, idb, order, models.OrderStatusRefunded, actorRole, actorID,
+		fmt.Sprintf("Return %s refunded", dbModel.RmaNumber))
+}
+
+// receiveReturnItems records the received quantities of the return items and restocks the sellable ones
+func receiveReturnItems(ctx context.Context, idb bun.IDB, dbModel *dbModels.OrderReturn, inspected []*models.ReturnInspectionItem) errors.Error {
+	for _, inspectedItem := range inspected {
+		var item *dbModels.ReturnItem
+		for _, i := range dbModel.Items {
+			if i.ProductID == *inspectedItem.ProductID {
+				item = i
+				break
+			}
+		}
+		if item == nil {
+			return errors.New(400, "Product %d is not returned by return %s!", *inspectedItem.ProductID,
+				dbModel.RmaNumber)
+		}
+		if *inspectedItem.ReceivedQuantity > item.Quantity {
+			return errors.New(409, "Only %d items of product %d are returned by return %s!", item.Quantity,
+				item.ProductID, dbModel.RmaNumber)
+		}
+		item.ReceivedQuantity = *inspectedItem.ReceivedQuantity
+		item.RestockedQuantity = 0
+		if !inspectedItem.Sellable || item.ReceivedQuantity == 0 {
+			continue
+		}
+		item.RestockedQuantity = item.ReceivedQuantity
+		query := idb.NewUpdate().TableExpr("products").
+			Set("number_in_stock = number_in_stock + ?", item.RestockedQuantity).
+			Set("version = COALESCE(version, 0) + 1").
+			Where("id = ?", item.ProductID)
+		Logger.Debug("Built the query %s\n", query)
+		if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not restock product %d!\n", sqlErr, item.ProductID)
+			return errors.New(500, "ERROR: Could not restock product %d!", item.ProductID)
+		}
+	}
+	return nil
+}
+
+// calculateReturnRefund calculates the paid price of the received items of the return
+func calculateReturnRefund(order *dbModels.Order, dbModel *dbModels.OrderReturn) int64 {
+	taxableAmounts := allocateDiscounts(order)
+	var result int64 = 0
+	for _, item := range dbModel.Items {
+		result += paidPrice(order, taxableAmounts, item.ProductID, item.ReceivedQuantity)
+	}
+	return result
+}
+
+// paidPrice calculates the paid price of the quantity of the ordered product from its line total after discounts
+// (as allocated by allocateDiscounts), with the tax if it has been added on top of it; the share is rounded half to even
+func paidPrice(order *dbModels.Order, taxableAmounts []int64, productID int64, quantity int64) int64 {
+	for i, product := range order.Products {
+		if *product.ProductID != productID || *product.Quantity == 0 {
+			continue
+		}
+		linePaid := taxableAmounts[i]
+		if !order.PricesIncludeTax {
+			linePaid += product.TaxAmount
+		}
+		return money.Share(linePaid, quantity, *product.Quantity)
+	}
+	return 0
+}
+
+// isOrderFullyReturned checks whether all the ordered items have been received back by the order returns
+func isOrderFullyReturned(order *dbModels.Order, existing []*dbModels.OrderReturn, current *dbModels.OrderReturn) bool {
+	notReceived := make(map[int64]int64)
+	for _, product := range order.Products {
+		notReceived[*product.ProductID] += *product.Quantity
+	}
+	for _, r := range existing {
+		if r.ID == current.ID {
+			r = current
+		}
+		for _, item := range r.Items {
+			notReceived[item.ProductID] -= item.ReceivedQuantity
+		}
+	}
+	for _, quantity := range notReceived {
+		if quantity > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// findOrderRefundablePayment finds the latest order payment charged through the payment provider;
+// nil is returned if the order has not been paid through it, so refunds are settled outside of it
+func findOrderRefundablePayment(ctx context.Context, idb bun.IDB, orderID int64) (*dbModels.Payment, errors.Error) {
+	dbPayments := make([]*dbModels.Payment, 0)
+	query := idb.NewSelect().Model(&dbPayments).
+		Where("order_id = ?", orderID).
+		Where("payment_intent_id != ''").
+		Where("status IN (?)", bun.In(paymentStatusesRefundable)).
+		Order("id DESC").Limit(1)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d payments!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d payments!", orderID)
+	}
+	if len(dbPayments) == 0 {
+		return nil, nil
+	}
+	return dbPayments[0], nil
+}
+
+// refundGatewayPayment refunds the amount of the payment through the gateway it has been made with;
+// the reference (e. g., an RMA number) is attached to the gateway refund
+func refundGatewayPayment(ctx context.Context, payment *dbModels.Payment, amount int64, reference string) (*GatewayRefund, errors.Error) {
+	gateway := getPaymentGateway(payment.Gateway)
+	if gateway == nil {
+		return nil, errors.New(502, "Could not refund payment %d: gateway %s is not available!", payment.ID, payment.Gateway)
+	}
+	r, gatewayErr := gateway.RefundPayment(ctx, payment.PaymentIntentId, amount, reference)
+	if gatewayErr != nil {
+		Logger.Error("ERROR: Could not refund %s payment %d: %v", gateway.Name(), payment.ID, gatewayErr)
+		return nil, errors.New(502, "Could not refund payment %d: %s", payment.ID, gatewayErr.Error())
+	}
+	return r, nil
+}
+
+func getReturn(params *returns.GetReturnParams, principal *models.Principal) (*models.OrderReturn, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	_, err = getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBOrderReturn(params.HTTPRequest.Context(), db, params.ID, params.ReturnID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func allOrderReturns(params *returns.ListReturnsParams, principal *models.Principal) ([]*models.OrderReturn, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	_, err = getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbReturns, err := findOrderReturns(params.HTTPRequest.Context(), db, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModels.OrderReturnDTOsFromOrderReturns(dbReturns), nil
+}
+
+func allReturns(params *returns.ListAllReturnsParams, principal *models.Principal) ([]*models.OrderReturn, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbReturns := make([]*dbModels.OrderReturn, 0)
+	query := db.NewSelect().Model(&dbReturns).Order("id DESC")
+	if params.Status != nil {
+		query.Where("status = ?", *params.Status)
+	}
+	if params.Limit != nil {
+		query.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		query.Offset(int(*params.Offset))
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find returns!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find returns!")
+	}
+	return dbModels.OrderReturnDTOsFromOrderReturns(dbReturns), nil
+}
+
+func getDBOrderReturn(ctx context.Context, idb bun.IDB, orderID int64, id int64) (*dbModels.OrderReturn, errors.Error) {
+	dbModel := new(dbModels.OrderReturn)
+	query := idb.NewSelect().Model(dbModel).Where("id = ?", id).Where("order_id = ?", orderID)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d return %d!\n", sqlErr, orderID, id)
+		return nil, errors.New(404, "Could not find order %d return %d!", orderID, id)
+	}
+	return dbModel, nil
+}
+
+func findOrderReturns(ctx context.Context, idb bun.IDB, orderID int64) ([]*dbModels.OrderReturn, errors.Error) {
+	result := make([]*dbModels.OrderReturn, 0)
+	query := idb.NewSelect().Model(&result).Where("order_id = ?", orderID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d returns!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d returns!", orderID)
+	}
+	return result, nil
+}
+
+func updateDBOrderReturn(ctx context.Context, idb bun.IDB, dbModel *dbModels.OrderReturn) errors.Error {
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	query := idb.NewUpdate().Model(dbModel).ExcludeColumn("id", "date_created").Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update order %d return %d!\n", sqlErr, dbModel.OrderID, dbModel.ID)
+		return errors.New(500, "ERROR: Could not update order %d return %d!", dbModel.OrderID, dbModel.ID)
+	}
+	return touchVersion(ctx, idb, "orders", dbModel.OrderID)
+}
@@ -0,0 +1,73 @@
+package restapi
+
+import (
+	"context"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/returns"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func TestReturnRefundRetry(t *testing.T) {
+	newTestStore(t)
+	gateway := registerTestGateway(t)
+	ctx := context.Background()
+	product := addTestProduct(t, 2500, 0)
+	order := addTestOrder(t, models.OrderStatusDelivered, 2, product)
+	payment := addTestPayment(t, order, paymentStatusComplete)
+	if err := issueInvoice(ctx, db, order.ID); err != nil {
+		t.Fatal(err)
+	}
+	orderReturn := addTestReturn(t, order, 1)
+
+	gateway.refundErr = errTestGateway
+	_, err := inspectReturn(&returns.InspectReturnParams{HTTPRequest: testRequest(), ID: order.ID,
+		ReturnID: orderReturn.ID, Body: &models.ReturnInspection{Items: []*models.ReturnInspectionItem{
+			{ProductID: swag.Int64(product.ID), ReceivedQuantity: swag.Int64(1), Sellable: true}}}}, testAdmin())
+	if err == nil || err.Code() != 502 {
+		t.Fatalf("inspectReturn() with a failing gateway = %v, want 502", err)
+	}
+	pending, err := getDBOrderReturn(ctx, db, order.ID, orderReturn.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending.Status != models.OrderReturnStatusRefundPending || pending.RefundAmount != 2500 {
+		t.Fatalf("return after the failed refund = %s of %d, want %s of 2500", pending.Status,
+			pending.RefundAmount, models.OrderReturnStatusRefundPending)
+	}
+	if stock := countTestRows(t, "products", "id = ? AND number_in_stock = 1", product.ID); stock != 1 {
+		t.Errorf("received item restocked %d times, want once", stock)
+	}
+
+	retry := &returns.RetryReturnRefundParams{HTTPRequest: testRequest(), ID: order.ID, ReturnID: orderReturn.ID}
+	gateway.refundErr = nil
+	refunded, err := retryReturnRefund(retry, testAdmin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refunded.Status != models.OrderReturnStatusRefunded || refunded.RefundID == "" {
+		t.Errorf("retried return = %s with refund %q, want %s with a refund", refunded.Status, refunded.RefundID,
+			models.OrderReturnStatusRefunded)
+	}
+	if _, err = retryReturnRefund(retry, testAdmin()); err == nil || err.Code() != 409 {
+		t.Errorf("retryReturnRefund() of the refunded return = %v, want 409", err)
+	}
+
+	updated, err := getDBPayment(ctx, db, payment.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.RefundedAmount != 2500 {
+		t.Errorf("payment refunded amount = %d, want 2500", updated.RefundedAmount)
+	}
+	if n := countTestRows(t, "orders", "id = ? AND refunded_total_minor = 2500", order.ID); n != 1 {
+		t.Error("order refunded total is not 2500")
+	}
+	if n := countTestRows(t, "invoices", "order_id = ? AND kind = ?", order.ID, models.InvoiceKindCreditNote); n != 1 {
+		t.Errorf("credit notes = %d, want 1", n)
+	}
+	if len(gateway.refunds) != 1 {
+		t.Errorf("gateway refunds = %d, want 1", len(gateway.refunds))
+	}
+}
@@ -0,0 +1,439 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/shipment"
+	"estore-backend/server/restapi/operations/shipments"
+	"estore-backend/server/restapi/operations/webhooks"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"io"
+	"strings"
+	"time"
+)
+
+// shipmentStatuses lists the known shipment statuses
+var shipmentStatuses = []string{models.ShipmentStatusPending, models.ShipmentStatusInTransit,
+	models.ShipmentStatusOutForDelivery, models.ShipmentStatusDelivered, models.ShipmentStatusException}
+
+// shipmentShippedStatuses are the statuses of the shipments handed over to the carrier
+var shipmentShippedStatuses = []string{models.ShipmentStatusInTransit, models.ShipmentStatusOutForDelivery,
+	models.ShipmentStatusDelivered}
+
+// shipmentOrderStatuses are the statuses of the orders which can be shipped; the partially refunded orders
+// keep their status while shipped, so the admin moves them on
+var shipmentOrderStatuses = []string{models.OrderStatusPaid, models.OrderStatusProcessing, models.OrderStatusShipped,
+	models.OrderStatusPartiallyRefunded}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func addShipment(params *shipments.AddShipmentParams, principal *models.Principal) (*models.Shipment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbOrder, err := getOrderFromDB(params.ID, true, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(shipmentOrderStatuses, dbOrder.Status) {
+		return nil, errors.New(409, "Order in status '%s' cannot be shipped!", dbOrder.Status)
+	}
+
+	dbModel := dbModels.NewShipmentFrom(params.Body)
+	*dbModel.Carrier = strings.TrimSpace(*dbModel.Carrier)
+	dbModel.TrackingNumber = strings.TrimSpace(dbModel.TrackingNumber)
+	dbModel.OrderID = dbOrder.ID
+	dbModel.Status = models.ShipmentStatusPending
+	dbModel.StatusDetails = ""
+	dbModel.LabelURL = ""
+	dbModel.DateShipped = 0
+	dbModel.DateDelivered = 0
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		existing, err := findOrderShipments(ctx, tx, dbOrder.ID)
+		if err != nil {
+			return err
+		}
+		err = validateShipmentItems(dbOrder, existing, dbModel)
+		if err != nil {
+			return err
+		}
+		if dbModel.TrackingNumber == "" {
+			carrier := getCarrier(*dbModel.Carrier)
+			if carrier == nil {
+				return errors.New(400, "Carrier %s has no integration, so the tracking number is required!",
+					*dbModel.Carrier)
+			}
+			label, labelErr := carrier.CreateLabel(ctx, dbOrder, dbModel)
+			if labelErr != nil {
+				Logger.Error("ERROR %v: Carrier %s could not create label for order %d!\n", labelErr,
+					*dbModel.Carrier, dbOrder.ID)
+				return errors.New(502, "Carrier %s could not create the shipping label!", *dbModel.Carrier)
+			}
+			dbModel.LabelURL = label.LabelURL
+			dbModel.TrackingNumber = label.TrackingNumber
+			dbModel.TrackingURL = label.TrackingURL
+		}
+
+		query := tx.NewInsert().Model(dbModel).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %d shipment %v!\n", sqlErr, dbOrder.ID, params.Body)
+			return errors.New(500, "ERROR: Could not add order %d shipment!", dbOrder.ID)
+		}
+		dbModel.ID, sqlErr = res.LastInsertId()
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find last insert ID for order %d shipment!", sqlErr, dbOrder.ID)
+			return errors.New(500, "ERROR: Could not add order %d shipment!", dbOrder.ID)
+		}
+
+		// shipping the items means the order is being processed
+		if dbOrder.Status == models.OrderStatusPaid {
+			return transitionOrderStatus(ctx, tx, dbOrder, models.OrderStatusProcessing, orderActorAdmin,
+				principal.User.ID, "Shipment created")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// validateShipmentItems checks the shipment items are ordered and not shipped by the other order shipments yet
+func validateShipmentItems(order *dbModels.Order, existing []*dbModels.Shipment, item *dbModels.Shipment) errors.Error {
+	if len(item.Items) == 0 {
+		return errors.New(400, "Shipment item list cannot be empty!")
+	}
+	remaining := make(map[int64]int64)
+	for _, product := range order.Products {
+		remaining[*product.ProductID] += *product.Quantity
+	}
+	for _, s := range existing {
+		for _, shipped := range s.Items {
+			remaining[shipped.ProductID] -= shipped.Quantity
+		}
+	}
+	for _, shipped := range item.Items {
+		quantity, ok := remaining[shipped.ProductID]
+		if !ok {
+			return errors.New(400, "Product %d is not ordered in order %d!", shipped.ProductID, order.ID)
+		}
+		if shipped.Quantity < 1 || shipped.Quantity > quantity {
+			return errors.New(409, "Only %d items of product %d of order %d remain to be shipped!",
+				quantity, shipped.ProductID, order.ID)
+		}
+		remaining[shipped.ProductID] = quantity - shipped.Quantity
+	}
+	return nil
+}
+
+func getShipment(params *shipment.GetShipmentParams, principal *models.Principal) (*models.Shipment, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	// restricts the non-admin users to their own orders
+	_, err = getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBShipment(params.HTTPRequest.Context(), db, params.ID, params.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func allShipments(params *shipments.ListShipmentsParams, principal *models.Principal) ([]*models.Shipment, errors.Error) {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	_, err = getOrderFromDB(params.ID, isAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbShipments, err := findOrderShipments(params.HTTPRequest.Context(), db, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.Shipment, len(dbShipments))
+	for i, m := range dbShipments {
+		result[i] = m.ToDTO()
+	}
+	return result, nil
+}
+
+func changeShipmentStatus(params *shipment.ChangeShipmentStatusParams, principal *models.Principal) (*models.Shipment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	var result *dbModels.Shipment
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		result, err = getDBShipment(ctx, tx, params.ID, params.ShipmentID)
+		if err != nil {
+			return err
+		}
+		return applyTrackingUpdate(ctx, tx, result, &TrackingUpdate{
+			Details:        params.Body.Details,
+			Status:         *params.Body.Status,
+			TrackingNumber: result.TrackingNumber,
+		}, orderActorAdmin, principal.User.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.ToDTO(), nil
+}
+
+func refreshShipmentTracking(params *shipment.RefreshShipmentTrackingParams, principal *models.Principal) (*models.Shipment, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbModel, err := getDBShipment(ctx, db, params.ID, params.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+	err = pollShipmentTracking(ctx, dbModel, orderActorAdmin, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// pollShipmentTracking requests the shipment tracking status from the carrier and applies it
+func pollShipmentTracking(ctx context.Context, dbModel *dbModels.Shipment, actorRole string, actorID int64) errors.Error {
+	carrier := getCarrier(*dbModel.Carrier)
+	if carrier == nil {
+		return errors.New(400, "Carrier %s has no integration, so the shipment can only be tracked manually!",
+			*dbModel.Carrier)
+	}
+	update, carrierErr := carrier.GetTrackingStatus(ctx, dbModel.TrackingNumber)
+	if carrierErr != nil {
+		Logger.Error("ERROR %v: Carrier %s could not track shipment %d (%s)!\n", carrierErr, *dbModel.Carrier,
+			dbModel.ID, dbModel.TrackingNumber)
+		return errors.New(502, "Carrier %s could not track shipment %d!", *dbModel.Carrier, dbModel.ID)
+	}
+	return runInTx(ctx, func(ctx context.Context, tx bun.Tx) errors.Error {
+		return applyTrackingUpdate(ctx, tx, dbModel, update, actorRole, actorID)
+	})
+}
+
+// processTrackingEvent applies the tracking updates of the carrier webhook event to the shipments;
+// updates of unknown tracking numbers are skipped, so the carrier does not resend them
+func processTrackingEvent(params *webhooks.ProcessTrackingEventParams) errors.Error {
+	carrier := getCarrier(params.Carrier)
+	if carrier == nil {
+		return errors.New(404, "Carrier %s has no integration!", params.Carrier)
+	}
+	payload, readErr := io.ReadAll(params.HTTPRequest.Body)
+	if readErr != nil {
+		Logger.Error("Error reading request body: %v\n", readErr)
+		return errors.New(500, "Error reading request body")
+	}
+	Logger.Debug(string(payload))
+	updates, parseErr := carrier.ParseTrackingEvent(payload, params.XTrackingSignature)
+	if parseErr != nil {
+		Logger.Error("processTrackingEvent: Could not parse carrier %s event! error: %v", params.Carrier, parseErr)
+		return errors.New(400, "Could not parse event")
+	}
+
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		for _, update := range updates {
+			dbShipments := make([]*dbModels.Shipment, 0)
+			query := tx.NewSelect().Model(&dbShipments).
+				Where("LOWER(carrier) = ?", normalizeCarrierName(params.Carrier)).
+				Where("tracking_number = ?", update.TrackingNumber)
+			Logger.Debug("Built the query %s\n", query)
+			sqlErr := query.Scan(ctx)
+			if sqlErr != nil {
+				Logger.Error("ERROR %v: Could not find shipment %s!\n", sqlErr, update.TrackingNumber)
+				return errors.New(500, "ERROR: Could not find shipment %s!", update.TrackingNumber)
+			}
+			if len(dbShipments) == 0 {
+				Logger.Info("processTrackingEvent: no shipment of carrier %s with tracking number %s",
+					params.Carrier, update.TrackingNumber)
+				continue
+			}
+			for _, dbModel := range dbShipments {
+				err := applyTrackingUpdate(ctx, tx, dbModel, update, orderActorSystem, 0)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// applyTrackingUpdate moves the shipment to the reported status and moves its order
+// to the shipped or delivered status accordingly
+func applyTrackingUpdate(ctx context.Context, idb bun.IDB, dbModel *dbModels.Shipment, update *TrackingUpdate, actorRole string, actorID int64) errors.Error {
+	if !containsString(shipmentStatuses, update.Status) {
+		return errors.New(400, "Unknown shipment status '%s'!", update.Status)
+	}
+	if dbModel.Status == update.Status && dbModel.StatusDetails == update.Details {
+		return nil
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.Status = update.Status
+	dbModel.StatusDetails = update.Details
+	dbModel.DateUpdated = nowUnixEpoch
+	if dbModel.DateShipped == 0 && containsString(shipmentShippedStatuses, update.Status) {
+		dbModel.DateShipped = nowUnixEpoch
+	}
+	if dbModel.DateDelivered == 0 && update.Status == models.ShipmentStatusDelivered {
+		dbModel.DateDelivered = nowUnixEpoch
+	}
+	query := idb.NewUpdate().Model(dbModel).
+		Column("status", "status_details", "date_shipped", "date_delivered", "date_updated").
+		Where("id = ?", dbModel.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update shipment %d status to %s!\n", sqlErr, dbModel.ID, update.Status)
+		return errors.New(500, "ERROR: Could not update shipment %d status!", dbModel.ID)
+	}
+	return syncOrderShippingStatus(ctx, idb, dbModel.OrderID, actorRole, actorID)
+}
+
+// syncOrderShippingStatus moves the order to the shipped status once any of its shipments is handed over
+// to the carrier and to the delivered status once all the ordered items are delivered
+func syncOrderShippingStatus(ctx context.Context, idb bun.IDB, orderID int64, actorRole string, actorID int64) errors.Error {
+	dbOrder := new(dbModels.Order)
+	query := idb.NewSelect().Model(dbOrder).Relation("Products").Where("id = ?", orderID)
+	Logger.Debug("Built the query %s\n", query)
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d!\n", sqlErr, orderID)
+		return errors.New(404, "Could not find order %d!", orderID)
+	}
+	dbShipments, err := findOrderShipments(ctx, idb, orderID)
+	if err != nil {
+		return err
+	}
+
+	isShipped := false
+	undelivered := make(map[int64]int64)
+	for _, product := range dbOrder.Products {
+		undelivered[*product.ProductID] += *product.Quantity
+	}
+	for _, s := range dbShipments {
+		if containsString(shipmentShippedStatuses, s.Status) {
+			isShipped = true
+		}
+		if s.Status == models.ShipmentStatusDelivered {
+			for _, item := range s.Items {
+				undelivered[item.ProductID] -= item.Quantity
+			}
+		}
+	}
+	isDelivered := isShipped
+	for _, quantity := range undelivered {
+		if quantity > 0 {
+			isDelivered = false
+		}
+	}
+
+	reason := "Shipment tracking update"
+	if isShipped && dbOrder.Status == models.OrderStatusPaid {
+		err = transitionOrderStatus(ctx, idb, dbOrder, models.OrderStatusProcessing, actorRole, actorID, reason)
+		if err != nil {
+			return err
+		}
+	}
+	if isShipped && dbOrder.Status == models.OrderStatusProcessing {
+		err = transitionOrderStatus(ctx, idb, dbOrder, models.OrderStatusShipped, actorRole, actorID, reason)
+		if err != nil {
+			return err
+		}
+	}
+	if isDelivered && dbOrder.Status == models.OrderStatusShipped {
+		return transitionOrderStatus(ctx, idb, dbOrder, models.OrderStatusDelivered, actorRole, actorID, reason)
+	}
+	return nil
+}
+
+func getDBShipment(ctx context.Context, idb bun.IDB, orderID int64, id int64) (*dbModels.Shipment, errors.Error) {
+	dbModel := new(dbModels.Shipment)
+	query := idb.NewSelect().Model(dbModel).Where("id = ?", id).Where("order_id = ?", orderID)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d shipment %d!\n", sqlErr, orderID, id)
+		return nil, errors.New(404, "Could not find order %d shipment %d!", orderID, id)
+	}
+	return dbModel, nil
+}
+
+func findOrderShipments(ctx context.Context, idb bun.IDB, orderID int64) ([]*dbModels.Shipment, errors.Error) {
+	result := make([]*dbModels.Shipment, 0)
+	query := idb.NewSelect().Model(&result).Where("order_id = ?", orderID).Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find order %d shipments!\n", sqlErr, orderID)
+		return nil, errors.New(500, "ERROR: Could not find order %d shipments!", orderID)
+	}
+	return result, nil
+}
+
+// startTrackingPolling periodically polls the carriers for the statuses of the undelivered shipments
+// if the polling interval is configured
+func startTrackingPolling() {
+	interval := ApiConfiguration.Shipping.TrackingPollInterval
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			pollUndeliveredShipments(context.Background())
+		}
+	}()
+}
+
+func pollUndeliveredShipments(ctx context.Context) {
+	dbShipments := make([]*dbModels.Shipment, 0)
+	query := db.NewSelect().Model(&dbShipments).
+		Where("status != ?", models.ShipmentStatusDelivered).
+		Where("tracking_number != ''").
+		Order("id ASC")
+	Logger.Debug("Built the query %s\n", query)
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find undelivered shipments!\n", sqlErr)
+		return
+	}
+	for _, dbModel := range dbShipments {
+		if getCarrier(*dbModel.Carrier) == nil {
+			continue
+		}
+		if err := pollShipmentTracking(ctx, dbModel, orderActorSystem, 0); err != nil {
+			Logger.Error("Could not poll shipment %d tracking status: %s", dbModel.ID, err.Error())
+		}
+	}
+}
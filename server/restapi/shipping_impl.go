@@ -0,0 +1,596 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/shipping"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// volumetricDivisor converts the product volume in cubic centimeters to its volumetric weight in kilograms
+const volumetricDivisor = 5000
+
+// shippingCountryPattern matches the shipping zone countries: "DE" or "US-CA"
+var shippingCountryPattern = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,3})?$`)
+
+func validateShippingZone(item *dbModels.ShippingZone) errors.Error {
+	for i, country := range item.Countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if !shippingCountryPattern.MatchString(country) {
+			return errors.New(400, "Shipping zone country %s must be an ISO 3166-1 alpha-2 code with an optional region!",
+				country)
+		}
+		item.Countries[i] = country
+	}
+	return nil
+}
+
+func validateShippingMethod(ctx context.Context, item *dbModels.ShippingMethod) errors.Error {
+	if _, err := getDBShippingZone(ctx, item.ZoneID); err != nil {
+		return err
+	}
+	if item.Currency == "" {
+		item.Currency = baseCurrency()
+	}
+	if item.Currency != baseCurrency() {
+		return errors.New(400, "Shipping method prices must be in %s, not %s!", baseCurrency(), item.Currency)
+	}
+	if item.Price < 0 || item.RatePerKg < 0 || item.FreeOverAmount < 0 {
+		return errors.New(400, "Shipping method prices must not be negative!")
+	}
+	for _, tier := range item.Tiers {
+		if tier.Price < 0 || tier.MinSubtotal < 0 {
+			return errors.New(400, "Shipping method prices must not be negative!")
+		}
+	}
+	switch *item.Kind {
+	case models.ShippingMethodKindPriceTiers:
+		if len(item.Tiers) == 0 {
+			return errors.New(400, "Price-tiered shipping method must have tiers!")
+		}
+	case models.ShippingMethodKindFreeOver:
+		if item.FreeOverAmount <= 0 {
+			return errors.New(400, "Free-over shipping method must have a positive threshold amount!")
+		}
+	}
+	sort.SliceStable(item.Tiers, func(i, j int) bool {
+		if item.Tiers[i].MinWeight != item.Tiers[j].MinWeight {
+			return item.Tiers[i].MinWeight < item.Tiers[j].MinWeight
+		}
+		return item.Tiers[i].MinSubtotal < item.Tiers[j].MinSubtotal
+	})
+	return nil
+}
+
+func addShippingZone(params *shipping.AddShippingZoneParams, principal *models.Principal) (*models.ShippingZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbModel := dbModels.NewShippingZoneFrom(params.Body)
+	err = validateShippingZone(dbModel)
+	if err != nil {
+		return nil, err
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add shipping zone %v!\n", sqlErr, params.Body)
+		return nil, errors.New(500, "ERROR: Could not add shipping zone!")
+	}
+	dbModel.ID, sqlErr = res.LastInsertId()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find last insert ID for shipping zone %v!", sqlErr, params.Body)
+		return nil, errors.New(500, "ERROR: Could not add shipping zone!")
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func updateShippingZone(params *shipping.EditShippingZoneParams, principal *models.Principal) (*models.ShippingZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	existing, err := getDBShippingZone(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := dbModels.NewShippingZoneFrom(params.Body)
+	dbModel.ID = params.ID
+	dbModel.DateCreated = existing.DateCreated
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	err = validateShippingZone(dbModel)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.NewUpdate().Model(dbModel).ExcludeColumn("id", "date_created").Where("id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update shipping zone %d!\n", sqlErr, params.ID)
+		return nil, errors.New(500, "ERROR: Could not update shipping zone %d!", params.ID)
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func deleteShippingZone(params *shipping.DeleteShippingZoneParams, principal *models.Principal) errors.Error {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return err
+	}
+	// the orders keep the snapshot of the shipping method name and price, so they are not affected
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewDelete().TableExpr("shipping_methods").Where("zone_id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete shipping zone %d methods!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete shipping zone %d!", params.ID)
+		}
+
+		query = tx.NewDelete().TableExpr("shipping_zones").Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr = query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete shipping zone %d!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete shipping zone %d!", params.ID)
+		}
+		return nil
+	})
+}
+
+func getShippingZone(params *shipping.GetShippingZoneParams, principal *models.Principal) (*models.ShippingZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBShippingZone(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func allShippingZones(params *shipping.ListShippingZonesParams, principal *models.Principal) ([]*models.ShippingZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := findShippingZones(params.HTTPRequest.Context(), db, false)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.ShippingZone, len(zones))
+	for i, zone := range zones {
+		result[i] = zone.ToDTO()
+	}
+	return result, nil
+}
+
+func getDBShippingZone(ctx context.Context, id int64) (*dbModels.ShippingZone, errors.Error) {
+	dbModel := new(dbModels.ShippingZone)
+	query := db.NewSelect().Model(dbModel).Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find shipping zone %d!\n", sqlErr, id)
+		return nil, errors.New(404, "Could not find shipping zone %d!", id)
+	}
+	return dbModel, nil
+}
+
+// findShippingZones finds the shipping zones; if withMethods is set, the active methods of the zones are loaded too
+func findShippingZones(ctx context.Context, idb bun.IDB, withMethods bool) ([]*dbModels.ShippingZone, errors.Error) {
+	zones := make([]*dbModels.ShippingZone, 0)
+	query := idb.NewSelect().Model(&zones).Order("id ASC")
+	if withMethods {
+		query.Relation("Methods", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("active = ?", true).Order("id ASC")
+		})
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find shipping zones!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find shipping zones!")
+	}
+	return zones, nil
+}
+
+// findShippingZone finds the most specific shipping zone of the delivery address with its active methods:
+// the zone listing the region, then the zone listing the whole country, then the rest of the world zone.
+// Nil is returned if no zone matches, so the address is not shipped to.
+func findShippingZone(ctx context.Context, idb bun.IDB, country string, region string) (*dbModels.ShippingZone, errors.Error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	region = strings.ToUpper(strings.TrimSpace(region))
+	zones, err := findShippingZones(ctx, idb, true)
+	if err != nil {
+		return nil, err
+	}
+	var countryZone, defaultZone *dbModels.ShippingZone
+	for _, zone := range zones {
+		if len(zone.Countries) == 0 && defaultZone == nil {
+			defaultZone = zone
+		}
+		for _, c := range zone.Countries {
+			if region != "" && c == country+"-"+region {
+				return zone, nil
+			}
+			if c == country && countryZone == nil {
+				countryZone = zone
+			}
+		}
+	}
+	if countryZone != nil {
+		return countryZone, nil
+	}
+	return defaultZone, nil
+}
+
+func addShippingMethod(params *shipping.AddShippingMethodParams, principal *models.Principal) (*models.ShippingMethod, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbModel := dbModels.NewShippingMethodFrom(params.Body)
+	err = validateShippingMethod(ctx, dbModel)
+	if err != nil {
+		return nil, err
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add shipping method %v!\n", sqlErr, params.Body)
+		return nil, errors.New(500, "ERROR: Could not add shipping method!")
+	}
+	dbModel.ID, sqlErr = res.LastInsertId()
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find last insert ID for shipping method %v!", sqlErr, params.Body)
+		return nil, errors.New(500, "ERROR: Could not add shipping method!")
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func updateShippingMethod(params *shipping.EditShippingMethodParams, principal *models.Principal) (*models.ShippingMethod, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	existing, err := getDBShippingMethod(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := dbModels.NewShippingMethodFrom(params.Body)
+	dbModel.ID = params.ID
+	dbModel.DateCreated = existing.DateCreated
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	err = validateShippingMethod(ctx, dbModel)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.NewUpdate().Model(dbModel).ExcludeColumn("id", "date_created").Where("id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update shipping method %d!\n", sqlErr, params.ID)
+		return nil, errors.New(500, "ERROR: Could not update shipping method %d!", params.ID)
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func deleteShippingMethod(params *shipping.DeleteShippingMethodParams, principal *models.Principal) errors.Error {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return err
+	}
+	ctx := params.HTTPRequest.Context()
+	query := db.NewDelete().TableExpr("shipping_methods").Where("id = ?", params.ID)
+	Logger.Debug("Built the query %s\n", query)
+
+	_, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete shipping method %d!\n", sqlErr, params.ID)
+		return errors.New(500, "ERROR: Could not delete shipping method %d!", params.ID)
+	}
+	return nil
+}
+
+func getShippingMethod(params *shipping.GetShippingMethodParams, principal *models.Principal) (*models.ShippingMethod, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBShippingMethod(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func allShippingMethods(params *shipping.ListShippingMethodsParams, principal *models.Principal) ([]*models.ShippingMethod, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	methods := make([]*dbModels.ShippingMethod, 0)
+
+	query := db.NewSelect().Model(&methods).Order("id ASC")
+	if params.ZoneID != nil {
+		query.Where("zone_id = ?", *params.ZoneID)
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find shipping methods!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find shipping methods!")
+	}
+	result := make([]*models.ShippingMethod, len(methods))
+	for i, method := range methods {
+		result[i] = method.ToDTO()
+	}
+	return result, nil
+}
+
+func getDBShippingMethod(ctx context.Context, id int64) (*dbModels.ShippingMethod, errors.Error) {
+	dbModel := new(dbModels.ShippingMethod)
+	query := db.NewSelect().Model(dbModel).Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find shipping method %d!\n", sqlErr, id)
+		return nil, errors.New(404, "Could not find shipping method %d!", id)
+	}
+	return dbModel, nil
+}
+
+// getShippingRates quotes the shipping methods available for the address and the given items
+// or, if no items are given, for the cart of the user or of the cart token; the rates are quoted
+// in the requested currency or in the one of the cart
+func getShippingRates(params *shipping.GetShippingRatesParams, principal *models.Principal) ([]*models.ShippingRate, errors.Error) {
+	ctx := params.HTTPRequest.Context()
+	address := dbModels.NewAddressFieldsFrom(params.Body.Address)
+	err := validateAddress("shipping", &address)
+	if err != nil {
+		return nil, err
+	}
+
+	quantities := make(map[int64]int64)
+	for _, item := range params.Body.Items {
+		quantities[*item.ProductID] += *item.Quantity
+	}
+	cartCurrency := ""
+	if len(params.Body.Items) == 0 {
+		dbCart, err := resolveCart(ctx, principal, params.XCartToken, false)
+		if err != nil {
+			return nil, err
+		}
+		if dbCart != nil {
+			cartCurrency = dbCart.Currency
+			for _, item := range dbCart.Items {
+				quantities[item.ProductID] += item.Quantity
+			}
+		}
+	}
+	if len(quantities) == 0 {
+		return nil, errors.New(400, "There are no items to quote!")
+	}
+	currency, err := resolveCurrency(params.XCurrency, cartCurrency)
+	if err != nil {
+		return nil, err
+	}
+	converter, err := newCurrencyConverter(ctx, db, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]int64, 0, len(quantities))
+	for id := range quantities {
+		productIDs = append(productIDs, id)
+	}
+	actualProducts := make([]*dbModels.Product, 0)
+	query := db.NewSelect().Model(&actualProducts).Where("id in (?)", bun.In(productIDs))
+	Logger.Debug("Built the query %s\n", query)
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find products %v!\n", sqlErr, productIDs)
+		return nil, errors.New(500, "ERROR: Could not find products!")
+	}
+	var subtotal int64 = 0
+	var weight float64 = 0
+	for _, product := range actualProducts {
+		unitPrice, err := converter.productPrice(product)
+		if err != nil {
+			return nil, err
+		}
+		subtotal += unitPrice * quantities[product.ID]
+		weight += productShippingWeight(product) * float64(quantities[product.ID])
+	}
+
+	zone, err := findShippingZone(ctx, db, address.Country, address.Region)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := calculateShippingRates(zone, converter, subtotal, weight)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.ShippingRate, 0)
+	for _, rate := range rates {
+		result = append(result, &models.ShippingRate{
+			Kind:       *rate.method.Kind,
+			MethodID:   rate.method.ID,
+			MethodName: *rate.method.Name,
+			Price:      dbModels.MoneyDTO(rate.price, currency),
+			ZoneName:   *zone.Name,
+		})
+	}
+	return result, nil
+}
+
+// productShippingWeight is the chargeable weight of a product unit in kilograms:
+// the greater of the actual and the volumetric weight
+func productShippingWeight(product *dbModels.Product) float64 {
+	volumetricWeight := product.Length * product.Width * product.Height / volumetricDivisor
+	return math.Max(product.Weight, volumetricWeight)
+}
+
+type shippingRate struct {
+	method *dbModels.ShippingMethod
+	price  int64
+}
+
+// calculateShippingRates calculates the prices of the zone methods available for the order subtotal and weight,
+// the cheapest first; the subtotal and the prices are in the minor units of the currency of the converter
+func calculateShippingRates(zone *dbModels.ShippingZone, converter *currencyConverter, subtotal int64, weight float64) ([]*shippingRate, errors.Error) {
+	result := make([]*shippingRate, 0)
+	if zone == nil {
+		return result, nil
+	}
+	for _, method := range zone.Methods {
+		price, ok, err := calculateShippingPrice(method, converter, subtotal, weight)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, &shippingRate{method: method, price: price})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].price < result[j].price
+	})
+	return result, nil
+}
+
+// calculateShippingPrice calculates the price of the shipping method in the currency of the converter;
+// tiered methods are not available below their lowest tier, and the price per kilogram is rounded half to even.
+// The base currency price is converted by the rounding rules of the exchange rate, while the subtotal thresholds
+// are converted exactly.
+func calculateShippingPrice(method *dbModels.ShippingMethod, converter *currencyConverter, subtotal int64, weight float64) (int64, bool, errors.Error) {
+	isSubtotalReached := func(threshold int64) (bool, errors.Error) {
+		converted, err := converter.convert(threshold)
+		return err == nil && subtotal >= converted, err
+	}
+	findTierPrice := func(isReached func(tier *dbModels.ShippingRateTier) (bool, errors.Error)) (int64, bool, errors.Error) {
+		var price int64 = 0
+		ok := false
+		for _, tier := range method.Tiers {
+			reached, err := isReached(tier)
+			if err != nil {
+				return 0, false, err
+			}
+			if reached {
+				price, ok = tier.Price, true
+			}
+		}
+		return price, ok, nil
+	}
+
+	var price int64
+	ok := true
+	var err errors.Error
+	switch *method.Kind {
+	case models.ShippingMethodKindWeight:
+		if len(method.Tiers) > 0 {
+			price, ok, err = findTierPrice(func(tier *dbModels.ShippingRateTier) (bool, errors.Error) {
+				return weight >= tier.MinWeight, nil
+			})
+		} else {
+			price = method.Price + money.Multiply(method.RatePerKg, weight)
+		}
+	case models.ShippingMethodKindPriceTiers:
+		price, ok, err = findTierPrice(func(tier *dbModels.ShippingRateTier) (bool, errors.Error) {
+			return isSubtotalReached(tier.MinSubtotal)
+		})
+	case models.ShippingMethodKindFreeOver:
+		var isFree bool
+		isFree, err = isSubtotalReached(method.FreeOverAmount)
+		if !isFree {
+			price = method.Price
+		}
+	default:
+		price = method.Price
+	}
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	price, err = converter.convertPrice(price)
+	return price, err == nil, err
+}
+
+// applyShipping checks the chosen shipping method is available for the order delivery address and
+// sets the order shipping price in the order currency; the subtotal is the total price of the products before
+// discounts. If no methods are available for the address, the order is not charged for shipping.
+func applyShipping(ctx context.Context, idb bun.IDB, order *dbModels.Order, converter *currencyConverter, actualProducts []*dbModels.Product, subtotal int64) errors.Error {
+	order.ShippingMethodName = ""
+	order.ShippingPrice = 0
+
+	products := make(map[int64]*dbModels.Product, len(actualProducts))
+	for _, product := range actualProducts {
+		products[product.ID] = product
+	}
+	var weight float64 = 0
+	for _, product := range order.Products {
+		if actualProduct, ok := products[*product.ProductID]; ok {
+			weight += productShippingWeight(actualProduct) * float64(*product.Quantity)
+		}
+	}
+
+	zone, err := findShippingZone(ctx, idb, order.DeliveryCountry, order.DeliveryRegion)
+	if err != nil {
+		return err
+	}
+	rates, err := calculateShippingRates(zone, converter, subtotal, weight)
+	if err != nil {
+		return err
+	}
+	if order.ShippingMethodID == 0 {
+		if len(rates) > 0 {
+			return errors.New(400, "Shipping method must be chosen for the delivery to %s!", order.DeliveryCountry)
+		}
+		Logger.Debug("No shipping methods are available for order %d delivery to %s %s",
+			order.ID, order.DeliveryCountry, order.DeliveryRegion)
+		return nil
+	}
+	for _, rate := range rates {
+		if rate.method.ID == order.ShippingMethodID {
+			order.ShippingMethodName = *rate.method.Name
+			order.ShippingPrice = rate.price
+			Logger.Debug("Order %d shipping: %s, %d", order.ID, order.ShippingMethodName, order.ShippingPrice)
+			return nil
+		}
+	}
+	return errors.New(400, "Shipping method %d is not available for the order!", order.ShippingMethodID)
+}
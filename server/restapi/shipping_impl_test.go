@@ -0,0 +1,119 @@
+package restapi
+
+import (
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func testShippingMethod(id int64, kind string, price int64, tiers ...*dbModels.ShippingRateTier) *dbModels.ShippingMethod {
+	return &dbModels.ShippingMethod{ID: id, Kind: swag.String(kind), Name: swag.String(kind), Price: price,
+		Tiers: tiers}
+}
+
+func TestCalculateShippingPrice(t *testing.T) {
+	base := &currencyConverter{currency: baseCurrency()}
+	euro := &currencyConverter{currency: "EUR", rate: &dbModels.ExchangeRate{Currency: swag.String("EUR"),
+		Rate: swag.Float64(0.9), RoundingIncrement: 100, PriceEnding: 99, RoundingMode: "up"}}
+
+	perKg := testShippingMethod(1, models.ShippingMethodKindWeight, 300)
+	perKg.RatePerKg = 250
+	halfPerKg := testShippingMethod(2, models.ShippingMethodKindWeight, 0)
+	halfPerKg.RatePerKg = 125
+	weightTiers := testShippingMethod(3, models.ShippingMethodKindWeight, 0,
+		&dbModels.ShippingRateTier{MinWeight: 0, Price: 400},
+		&dbModels.ShippingRateTier{MinWeight: 2, Price: 700},
+		&dbModels.ShippingRateTier{MinWeight: 5, Price: 1200})
+	heavyTiers := testShippingMethod(4, models.ShippingMethodKindWeight, 0,
+		&dbModels.ShippingRateTier{MinWeight: 1, Price: 400})
+	priceTiers := testShippingMethod(5, models.ShippingMethodKindPriceTiers, 0,
+		&dbModels.ShippingRateTier{MinSubtotal: 2000, Price: 500},
+		&dbModels.ShippingRateTier{MinSubtotal: 5000, Price: 200},
+		&dbModels.ShippingRateTier{MinSubtotal: 10000, Price: 0})
+	freeOver := testShippingMethod(6, models.ShippingMethodKindFreeOver, 500)
+	freeOver.FreeOverAmount = 5000
+	flat := testShippingMethod(7, models.ShippingMethodKindFlat, 500)
+
+	tests := []struct {
+		name      string
+		method    *dbModels.ShippingMethod
+		converter *currencyConverter
+		subtotal  int64
+		weight    float64
+		wantPrice int64
+		wantOK    bool
+	}{
+		{"flat", flat, base, 1000, 1, 500, true},
+		{"per kg", perKg, base, 1000, 2.5, 925, true},
+		{"per kg rounded half to even", halfPerKg, base, 1000, 0.5, 62, true},
+		{"lowest weight tier", weightTiers, base, 1000, 1, 400, true},
+		{"weight tier lower bound", weightTiers, base, 1000, 2, 700, true},
+		{"highest weight tier", weightTiers, base, 1000, 6, 1200, true},
+		{"below the lowest weight tier", heavyTiers, base, 1000, 0.5, 0, false},
+		{"below the lowest price tier", priceTiers, base, 1999, 1, 0, false},
+		{"price tier lower bound", priceTiers, base, 2000, 1, 500, true},
+		{"middle price tier", priceTiers, base, 7500, 1, 200, true},
+		{"free price tier", priceTiers, base, 10000, 1, 0, true},
+		{"below the free shipping threshold", freeOver, base, 4999, 1, 500, true},
+		{"free shipping threshold", freeOver, base, 5000, 1, 0, true},
+		{"converted flat", flat, euro, 1000, 1, 499, true},
+		{"converted price tier threshold", priceTiers, euro, 1800, 1, 499, true},
+		{"below the converted price tier threshold", priceTiers, euro, 1799, 1, 0, false},
+		{"converted free shipping", freeOver, euro, 4500, 1, 0, true},
+	}
+	for _, tt := range tests {
+		price, ok, err := calculateShippingPrice(tt.method, tt.converter, tt.subtotal, tt.weight)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		if price != tt.wantPrice || ok != tt.wantOK {
+			t.Errorf("%s: calculateShippingPrice() = %d, %t, want %d, %t", tt.name, price, ok, tt.wantPrice, tt.wantOK)
+		}
+	}
+
+	noRate := &currencyConverter{currency: "GBP"}
+	if _, _, err := calculateShippingPrice(flat, noRate, 1000, 1); err == nil || err.Code() != 409 {
+		t.Errorf("calculateShippingPrice() without an exchange rate = %v, want 409", err)
+	}
+}
+
+func TestCalculateShippingRates(t *testing.T) {
+	converter := &currencyConverter{currency: baseCurrency()}
+	freeOver := testShippingMethod(1, models.ShippingMethodKindFreeOver, 800)
+	freeOver.FreeOverAmount = 5000
+	zone := &dbModels.ShippingZone{Name: swag.String("Domestic"), Methods: []*dbModels.ShippingMethod{
+		freeOver,
+		testShippingMethod(2, models.ShippingMethodKindFlat, 500),
+		testShippingMethod(3, models.ShippingMethodKindWeight, 0, &dbModels.ShippingRateTier{MinWeight: 10, Price: 300}),
+	}}
+
+	tests := []struct {
+		name     string
+		zone     *dbModels.ShippingZone
+		subtotal int64
+		want     map[int64]int64
+		order    []int64
+	}{
+		{"cheapest first", zone, 1000, map[int64]int64{2: 500, 1: 800}, []int64{2, 1}},
+		{"free shipping first", zone, 5000, map[int64]int64{1: 0, 2: 500}, []int64{1, 2}},
+		{"no zone", nil, 1000, map[int64]int64{}, []int64{}},
+	}
+	for _, tt := range tests {
+		rates, err := calculateShippingRates(tt.zone, converter, tt.subtotal, 1)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		prices := make(map[int64]int64, len(rates))
+		order := make([]int64, 0, len(rates))
+		for _, rate := range rates {
+			prices[rate.method.ID] = rate.price
+			order = append(order, rate.method.ID)
+		}
+		if !reflect.DeepEqual(prices, tt.want) || !reflect.DeepEqual(order, tt.order) {
+			t.Errorf("%s: rates = %v in order %v, want %v in order %v", tt.name, prices, order, tt.want, tt.order)
+		}
+	}
+}
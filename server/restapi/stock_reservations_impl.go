@@ -0,0 +1,65 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+)
+
+// reserveOrderStock takes the ordered products out of stock for the payment of a new checkout session, so
+// they cannot be sold out while the customer is paying; the payment remembers the reserved quantities
+func reserveOrderStock(ctx context.Context, idb bun.IDB, order *dbModels.Order, payment *dbModels.Payment) errors.Error {
+	reservations := make([]*dbModels.StockReservation, 0, len(order.Products))
+	for _, p := range order.Products {
+		// a negative quantity would pass the stock check and raise the stock
+		if p.Quantity == nil || *p.Quantity < 1 {
+			return errors.New(409, "Product %s of order %d has no quantity to reserve!", p.ProductName, order.ID)
+		}
+		query := idb.NewUpdate().TableExpr("products").
+			Set("number_in_stock = number_in_stock - ?", *p.Quantity).
+			Set("version = COALESCE(version, 0) + 1").
+			Where("id = ?", *p.ProductID).
+			Where("number_in_stock >= ?", *p.Quantity)
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not reserve stock of product %d!\n", sqlErr, *p.ProductID)
+			return errors.New(500, "ERROR: Could not reserve stock of product %d!", *p.ProductID)
+		}
+		if rows, _ := res.RowsAffected(); rows == 0 {
+			return errors.New(409, "Product %s is out of stock!", p.ProductName)
+		}
+		reservations = append(reservations, &dbModels.StockReservation{ProductID: *p.ProductID, Quantity: *p.Quantity})
+	}
+	payment.ReservedStock = reservations
+	return nil
+}
+
+// releasePaymentStock returns the stock reserved for the payment
+func releasePaymentStock(ctx context.Context, idb bun.IDB, payment *dbModels.Payment) errors.Error {
+	if len(payment.ReservedStock) == 0 {
+		return nil
+	}
+	for _, r := range payment.ReservedStock {
+		query := idb.NewUpdate().TableExpr("products").
+			Set("number_in_stock = number_in_stock + ?", r.Quantity).
+			Set("version = COALESCE(version, 0) + 1").
+			Where("id = ?", r.ProductID)
+		Logger.Debug("Built the query %s\n", query)
+		if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not release stock of product %d!\n", sqlErr, r.ProductID)
+			return errors.New(500, "ERROR: Could not release stock of product %d!", r.ProductID)
+		}
+	}
+	payment.ReservedStock = nil
+	query := idb.NewUpdate().Model(payment).Column("reserved_stock").Where("id = ?", payment.ID)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update payment %d reserved stock!\n", sqlErr, payment.ID)
+		return errors.New(500, "ERROR: Could not update payment %d!", payment.ID)
+	}
+	Logger.Info("Released the stock reserved for payment %d of order %d", payment.ID, payment.OrderID)
+	return nil
+}
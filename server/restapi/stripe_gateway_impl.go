@@ -0,0 +1,264 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stripe/stripe-go/v76"
+	"github.com/stripe/stripe-go/v76/client"
+	"github.com/stripe/stripe-go/v76/webhook"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// stripeGateway charges the orders through the embedded Stripe checkout; every request carries
+// the configured secret key, so the global stripe.Key is never set
+type stripeGateway struct {
+	api           *client.API
+	webhookSecret string
+}
+
+func newStripeGateway(secret string, webhookSecret string) *stripeGateway {
+	return &stripeGateway{
+		api:           client.New(secret, nil),
+		webhookSecret: webhookSecret,
+	}
+}
+
+// stripeOrderIDMetadata is the payment intent metadata key of the order ID
+const stripeOrderIDMetadata = "order_id"
+
+func (g *stripeGateway) Name() string {
+	return stripeGatewayName
+}
+
+func (g *stripeGateway) CreateCheckoutSession(ctx context.Context, request *CheckoutSessionRequest) (*GatewayCheckoutSession, error) {
+	currency := strings.ToLower(request.Currency)
+	lineItems := make([]*stripe.CheckoutSessionLineItemParams, len(request.LineItems))
+	for i, item := range request.LineItems {
+		lineItems[i] = &stripe.CheckoutSessionLineItemParams{
+			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+				Currency: stripe.String(currency),
+				ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+					Name: stripe.String(item.Name),
+				},
+				UnitAmount: stripe.Int64(item.UnitAmount),
+			},
+			Quantity: stripe.Int64(item.Quantity),
+		}
+	}
+
+	sessionParams := &stripe.CheckoutSessionParams{
+		UIMode:               stripe.String("embedded"),
+		ReturnURL:            stripe.String(request.ReturnURL),
+		RedirectOnCompletion: stripe.String("if_required"),
+		LineItems:            lineItems,
+		Mode:                 stripe.String(string(stripe.CheckoutSessionModePayment)),
+		// the payment intent events preceding the completed checkout are matched to the order by it
+		PaymentIntentData: &stripe.CheckoutSessionPaymentIntentDataParams{
+			Metadata: map[string]string{stripeOrderIDMetadata: strconv.FormatInt(request.OrderID, 10)},
+		},
+	}
+	sessionParams.Context = ctx
+
+	// line items carry the prices before discounts, so the order discounts are sent as a one-off coupon
+	// making the charged amount match the order total price
+	if request.DiscountAmount > 0 {
+		couponParams := &stripe.CouponParams{
+			AmountOff:      stripe.Int64(request.DiscountAmount),
+			Currency:       stripe.String(currency),
+			Duration:       stripe.String(string(stripe.CouponDurationOnce)),
+			MaxRedemptions: stripe.Int64(1),
+			Name:           stripe.String(fmt.Sprintf("Order %d discounts", request.OrderID)),
+		}
+		couponParams.Context = ctx
+		c, err := g.api.Coupons.New(couponParams)
+		if err != nil {
+			return nil, err
+		}
+		sessionParams.Discounts = []*stripe.CheckoutSessionDiscountParams{{Coupon: stripe.String(c.ID)}}
+	}
+
+	s, err := g.api.CheckoutSessions.New(sessionParams)
+	if err != nil {
+		return nil, err
+	}
+	return stripeCheckoutSession(s), nil
+}
+
+func stripeCheckoutSession(s *stripe.CheckoutSession) *GatewayCheckoutSession {
+	result := &GatewayCheckoutSession{
+		ID:            s.ID,
+		ClientSecret:  s.ClientSecret,
+		Status:        string(s.Status),
+		PaymentStatus: string(s.PaymentStatus),
+		AmountTotal:   s.AmountTotal,
+		Currency:      strings.ToUpper(string(s.Currency)),
+	}
+	if s.PaymentIntent != nil {
+		result.PaymentIntentID = s.PaymentIntent.ID
+	}
+	if s.CustomerDetails != nil {
+		result.CustomerEmail = s.CustomerDetails.Email
+	}
+	return result
+}
+
+func (g *stripeGateway) GetCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error) {
+	params := &stripe.CheckoutSessionParams{}
+	params.Context = ctx
+	params.AddExpand("payment_intent")
+	s, err := g.api.CheckoutSessions.Get(id, params)
+	if err != nil {
+		return nil, err
+	}
+	result := stripeCheckoutSession(s)
+	// the status of the payment intent is more specific than the one of the session
+	if s.PaymentIntent != nil && s.PaymentIntent.Status != "" {
+		result.PaymentStatus = string(s.PaymentIntent.Status)
+	}
+	return result, nil
+}
+
+func (g *stripeGateway) ExpireCheckoutSession(ctx context.Context, id string) (*GatewayCheckoutSession, error) {
+	params := &stripe.CheckoutSessionExpireParams{}
+	params.Context = ctx
+	s, err := g.api.CheckoutSessions.Expire(id, params)
+	if err != nil {
+		return nil, err
+	}
+	return stripeCheckoutSession(s), nil
+}
+
+func (g *stripeGateway) CapturePayment(ctx context.Context, paymentIntentID string, amount int64) error {
+	params := &stripe.PaymentIntentCaptureParams{
+		AmountToCapture: stripe.Int64(amount),
+	}
+	params.Context = ctx
+	_, err := g.api.PaymentIntents.Capture(paymentIntentID, params)
+	return err
+}
+
+func (g *stripeGateway) RefundPayment(ctx context.Context, paymentIntentID string, amount int64, reference string) (*GatewayRefund, error) {
+	params := &stripe.RefundParams{
+		Amount:        stripe.Int64(amount),
+		PaymentIntent: stripe.String(paymentIntentID),
+		Reason:        stripe.String(string(stripe.RefundReasonRequestedByCustomer)),
+	}
+	params.Context = ctx
+	if reference != "" {
+		params.AddMetadata("reference", reference)
+		// Stripe keeps the idempotency keys for 24 hours at least
+		params.SetIdempotencyKey("refund-" + paymentIntentID + "-" + reference)
+	}
+	r, err := g.api.Refunds.New(params)
+	if err != nil {
+		return nil, err
+	}
+	return stripeRefund(r), nil
+}
+
+func stripeRefund(r *stripe.Refund) *GatewayRefund {
+	return &GatewayRefund{ID: r.ID, Amount: r.Amount, Status: string(r.Status)}
+}
+
+// stripePaymentIntentEvents maps the payment intent events to the payment event types
+var stripePaymentIntentEvents = map[stripe.EventType]string{
+	"payment_intent.created":                   paymentEventPaymentCreated,
+	"payment_intent.succeeded":                 paymentEventPaymentSucceeded,
+	"payment_intent.payment_failed":            paymentEventPaymentFailed,
+	"payment_intent.canceled":                  paymentEventPaymentCanceled,
+	"payment_intent.processing":                paymentEventPaymentUpdated,
+	"payment_intent.requires_action":           paymentEventPaymentUpdated,
+	"payment_intent.requires_capture":          paymentEventPaymentUpdated,
+	"payment_intent.requires_confirmation":     paymentEventPaymentUpdated,
+	"payment_intent.requires_payment_method":   paymentEventPaymentUpdated,
+	"payment_intent.amount_capturable_updated": paymentEventPaymentUpdated,
+}
+
+func (g *stripeGateway) ParseWebhookEvent(payload []byte, header http.Header) (*PaymentEvent, error) {
+	// If you are testing your webhook locally with the Stripe CLI you
+	// can find the endpoint's secret by running `stripe listen`
+	// Otherwise, find your endpoint's secret in your webhook settings
+	// in the Developer Dashboard
+	event, err := webhook.ConstructEvent(payload, header.Get("Stripe-Signature"), g.webhookSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PaymentEvent{ID: event.ID, Type: string(event.Type), Created: event.Created}
+	switch event.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded",
+		"checkout.session.async_payment_failed", "checkout.session.expired":
+		var sess stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Raw, &sess); err != nil {
+			return nil, err
+		}
+		switch event.Type {
+		case "checkout.session.expired":
+			result.Type = paymentEventCheckoutExpired
+		case "checkout.session.async_payment_failed":
+			// the delayed payment method (e. g., a bank debit) has not been charged
+			result.Type = paymentEventCheckoutFailed
+		default:
+			result.Type = paymentEventCheckoutCompleted
+		}
+		result.CheckoutSessionID = sess.ID
+		result.SessionStatus = string(sess.Status)
+		result.PaymentStatus = string(sess.PaymentStatus)
+		if sess.PaymentIntent != nil {
+			result.PaymentIntentID = sess.PaymentIntent.ID
+		}
+	case "charge.refunded":
+		var charge stripe.Charge
+		if err := json.Unmarshal(event.Data.Raw, &charge); err != nil {
+			return nil, err
+		}
+		result.Type = paymentEventPaymentRefunded
+		if charge.PaymentIntent != nil {
+			result.PaymentIntentID = charge.PaymentIntent.ID
+		}
+		result.RefundedAmount = charge.AmountRefunded
+		if charge.Refunds != nil {
+			for _, r := range charge.Refunds.Data {
+				result.Refunds = append(result.Refunds, stripeRefund(r))
+			}
+		}
+	case "refund.updated", "charge.refund.updated":
+		var refund stripe.Refund
+		if err := json.Unmarshal(event.Data.Raw, &refund); err != nil {
+			return nil, err
+		}
+		result.Type = paymentEventRefundUpdated
+		if refund.PaymentIntent != nil {
+			result.PaymentIntentID = refund.PaymentIntent.ID
+		}
+		result.Refunds = []*GatewayRefund{stripeRefund(&refund)}
+	default:
+		eventType, ok := stripePaymentIntentEvents[event.Type]
+		if !ok {
+			// the other events are passed through under their Stripe types and ignored
+			return result, nil
+		}
+		var intent stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Raw, &intent); err != nil {
+			return nil, err
+		}
+		result.Type = eventType
+		result.PaymentIntentID = intent.ID
+		result.PaymentStatus = string(intent.Status)
+		result.OrderID, _ = strconv.ParseInt(intent.Metadata[stripeOrderIDMetadata], 10, 64)
+		if intent.LastPaymentError != nil && eventType == paymentEventPaymentFailed {
+			result.FailureCode = string(intent.LastPaymentError.DeclineCode)
+			if result.FailureCode == "" {
+				result.FailureCode = string(intent.LastPaymentError.Code)
+			}
+			result.FailureMessage = intent.LastPaymentError.Msg
+		}
+		if eventType == paymentEventPaymentCanceled {
+			result.FailureCode = string(intent.CancellationReason)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,481 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/money"
+	"estore-backend/server/restapi/operations/reports"
+	"estore-backend/server/restapi/operations/tax"
+	"estore-backend/server/restapi/operations/taxes"
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+	"strings"
+	"time"
+)
+
+// defaultTaxClass is the tax class of the products and rates without an explicit one
+const defaultTaxClass = "standard"
+
+// taxReportStatuses are the statuses of the orders the taxes of which are collected
+var taxReportStatuses = []string{models.OrderStatusPaid, models.OrderStatusProcessing, models.OrderStatusShipped,
+	models.OrderStatusDelivered, models.OrderStatusPartiallyRefunded}
+
+func normalizeTaxClass(taxClass string) string {
+	taxClass = strings.ToLower(strings.TrimSpace(taxClass))
+	if taxClass == "" {
+		return defaultTaxClass
+	}
+	return taxClass
+}
+
+func validateTaxZone(item *dbModels.TaxZone) errors.Error {
+	item.Country = strings.ToUpper(strings.TrimSpace(item.Country))
+	item.Region = strings.ToUpper(strings.TrimSpace(item.Region))
+	if item.Country == "" && item.Region != "" {
+		return errors.New(400, "Tax zone region requires a country!")
+	}
+	for _, rate := range item.Rates {
+		if *rate.Rate < 0 || *rate.Rate >= 100 {
+			return errors.New(400, "Tax rate %s must be at least 0 and less than 100 percent!", *rate.Name)
+		}
+		rate.TaxClass = normalizeTaxClass(rate.TaxClass)
+	}
+	return nil
+}
+
+func addTaxZone(params *taxes.AddTaxZoneParams, principal *models.Principal) (*models.TaxZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := dbModels.NewTaxZoneFrom(params.Body)
+	err = validateTaxZone(dbModel)
+	if err != nil {
+		return nil, err
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.DateCreated = nowUnixEpoch
+	dbModel.DateUpdated = nowUnixEpoch
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewInsert().Model(dbModel).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add tax zone %v!\n", sqlErr, params.Body)
+			return errors.New(500, "ERROR: Could not add tax zone!")
+		}
+		dbModel.ID, sqlErr = res.LastInsertId()
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find last insert ID for tax zone %v!", sqlErr, params.Body)
+			return errors.New(500, "ERROR: Could not add tax zone!")
+		}
+		return saveTaxRates(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func updateTaxZone(params *tax.EditTaxZoneParams, principal *models.Principal) (*models.TaxZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := getDBTaxZone(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbModel := dbModels.NewTaxZoneFrom(params.Body)
+	dbModel.ID = params.ID
+	dbModel.DateCreated = existing.DateCreated
+	dbModel.DateUpdated = time.Now().In(time.UTC).Unix()
+	err = validateTaxZone(dbModel)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewUpdate().Model(dbModel).ExcludeColumn("id", "date_created").Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not update tax zone %d!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not update tax zone %d!", params.ID)
+		}
+		return saveTaxRates(ctx, tx, dbModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+// saveTaxRates replaces the rates of the tax zone
+func saveTaxRates(ctx context.Context, idb bun.IDB, zone *dbModels.TaxZone) errors.Error {
+	delQuery := idb.NewDelete().TableExpr("tax_rates").Where("zone_id = ?", zone.ID)
+	Logger.Debug("Built the query %s\n", delQuery)
+	_, sqlErr := delQuery.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete tax zone %d rates!\n", sqlErr, zone.ID)
+		return errors.New(500, "ERROR: Could not update tax zone %d rates!", zone.ID)
+	}
+	if len(zone.Rates) == 0 {
+		return nil
+	}
+
+	for _, rate := range zone.Rates {
+		rate.ZoneID = zone.ID
+	}
+	query := idb.NewInsert().Model(&zone.Rates).ExcludeColumn("id")
+	Logger.Debug("Built the query %s\n", query)
+	_, sqlErr = query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not add tax zone %d rates!\n", sqlErr, zone.ID)
+		return errors.New(500, "ERROR: Could not update tax zone %d rates!", zone.ID)
+	}
+	return nil
+}
+
+func deleteTaxZone(params *tax.DeleteTaxZoneParams, principal *models.Principal) errors.Error {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return err
+	}
+	// the tax lines of the orders keep the snapshot of the rates, so they are not affected
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewDelete().TableExpr("tax_rates").Where("zone_id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete tax zone %d rates!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete tax zone %d!", params.ID)
+		}
+
+		query = tx.NewDelete().TableExpr("tax_zones").Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr = query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not delete tax zone %d!\n", sqlErr, params.ID)
+			return errors.New(500, "ERROR: Could not delete tax zone %d!", params.ID)
+		}
+		return nil
+	})
+}
+
+func getTaxZone(params *tax.GetTaxZoneParams, principal *models.Principal) (*models.TaxZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBTaxZone(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(), nil
+}
+
+func allTaxZones(params *taxes.ListTaxZonesParams, principal *models.Principal) ([]*models.TaxZone, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := findTaxZones(params.HTTPRequest.Context(), db, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.TaxZone, len(zones))
+	for i, zone := range zones {
+		result[i] = zone.ToDTO()
+	}
+	return result, nil
+}
+
+func getDBTaxZone(ctx context.Context, id int64) (*dbModels.TaxZone, errors.Error) {
+	dbModel := new(dbModels.TaxZone)
+	query := db.NewSelect().Model(dbModel).Relation("Rates").Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find tax zone %d!\n", sqlErr, id)
+		return nil, errors.New(404, "Could not find tax zone %d!", id)
+	}
+	return dbModel, nil
+}
+
+// findTaxZones finds the tax zones with their rates; if the countries are given,
+// only the zones of the countries are returned
+func findTaxZones(ctx context.Context, idb bun.IDB, countries []string) ([]*dbModels.TaxZone, errors.Error) {
+	zones := make([]*dbModels.TaxZone, 0)
+	query := idb.NewSelect().Model(&zones).Relation("Rates").Order("id ASC")
+	if countries != nil {
+		query.Where("country IN (?)", bun.In(countries))
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find tax zones!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find tax zones!")
+	}
+	return zones, nil
+}
+
+// findTaxZone finds the most specific tax zone of the delivery address:
+// the zone of the region, then the zone of the whole country, then the default zone.
+// Nil is returned if no zone matches, so no taxes are charged.
+func findTaxZone(ctx context.Context, idb bun.IDB, country string, region string) (*dbModels.TaxZone, errors.Error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	region = strings.ToUpper(strings.TrimSpace(region))
+	zones, err := findTaxZones(ctx, idb, []string{country, ""})
+	if err != nil {
+		return nil, err
+	}
+	var countryZone, defaultZone *dbModels.TaxZone
+	for _, zone := range zones {
+		switch {
+		case zone.Country == "" && defaultZone == nil:
+			defaultZone = zone
+		case zone.Country == country && zone.Region == "" && countryZone == nil:
+			countryZone = zone
+		case zone.Country == country && zone.Region == region && region != "":
+			return zone, nil
+		}
+	}
+	if countryZone != nil {
+		return countryZone, nil
+	}
+	return defaultZone, nil
+}
+
+// allocateDiscounts splits the order discounts between the order lines: product discounts go to the lines
+// of the product, order discounts are split in proportion to the line totals.
+// The result holds the taxable amounts of the lines, which add up to the discounted subtotal exactly.
+func allocateDiscounts(order *dbModels.Order) []int64 {
+	result := make([]int64, len(order.Products))
+	for i, product := range order.Products {
+		result[i] = product.TotalPrice
+	}
+	for _, discount := range order.Discounts {
+		if discount.Kind == models.PromotionKindFreeShipping {
+			// waives the shipping price, not the product prices
+			continue
+		}
+		var base int64 = 0
+		weights := make([]int64, len(result))
+		for i, product := range order.Products {
+			if discount.ProductID == 0 || discount.ProductID == *product.ProductID {
+				weights[i] = result[i]
+				base += result[i]
+			}
+		}
+		if base <= 0 || discount.Amount <= 0 {
+			continue
+		}
+		for i, allocated := range money.Allocate(discount.Amount, weights) {
+			result[i] -= allocated
+		}
+	}
+	return result
+}
+
+// applyTaxes calculates the taxes of the order lines and of the shipping after discounts according to the tax zone of the delivery
+// address and the tax classes of the products, replaces the order tax lines and returns the tax total.
+// With tax inclusive prices the tax is extracted from the line totals, otherwise it is added on top of them.
+// The taxes are rounded half to even per line.
+func applyTaxes(ctx context.Context, idb bun.IDB, order *dbModels.Order, actualProducts []*dbModels.Product) (int64, errors.Error) {
+	delQuery := idb.NewDelete().TableExpr("order_tax_lines").Where("order_id = ?", order.ID)
+	Logger.Debug("Built the query %s\n", delQuery)
+	_, sqlErr := delQuery.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not delete order %d tax lines!\n", sqlErr, order.ID)
+		return 0, errors.New(500, "Could not delete order %d taxes!", order.ID)
+	}
+	order.PricesIncludeTax = ApiConfiguration.Taxes.PricesIncludeTax
+	order.Taxes = make([]*dbModels.OrderTaxLine, 0)
+	order.TaxTotal = 0
+	for _, product := range order.Products {
+		product.TaxAmount = 0
+	}
+
+	zone, err := findTaxZone(ctx, idb, order.DeliveryCountry, order.DeliveryRegion)
+	if err != nil {
+		return 0, err
+	}
+	if zone == nil {
+		Logger.Debug("No tax zone matches order %d delivery country %s region %s",
+			order.ID, order.DeliveryCountry, order.DeliveryRegion)
+		return 0, nil
+	}
+
+	taxClasses := make(map[int64]string, len(actualProducts))
+	for _, product := range actualProducts {
+		taxClasses[product.ID] = normalizeTaxClass(product.TaxClass)
+	}
+	// addTaxLines adds the lines of the zone rates of the tax class and returns their total
+	addTaxLines := func(productID int64, taxClass string, taxableAmount int64) int64 {
+		rates := make([]*dbModels.TaxRate, 0)
+		var totalRate float64 = 0
+		for _, rate := range zone.Rates {
+			if rate.TaxClass == taxClass {
+				rates = append(rates, rate)
+				totalRate += *rate.Rate
+			}
+		}
+		// the net amount the rates apply to; compound rates of a zone are summed up
+		netAmount := taxableAmount
+		var includedTax int64 = 0
+		if order.PricesIncludeTax {
+			includedTax = money.IncludedPercent(taxableAmount, totalRate)
+			netAmount = taxableAmount - includedTax
+		}
+		var result int64 = 0
+		for i, rate := range rates {
+			amount := money.Percent(netAmount, *rate.Rate)
+			if order.PricesIncludeTax && i == len(rates)-1 {
+				// the included tax is split between the rates without losing a minor unit
+				amount = includedTax - result
+			}
+			line := &dbModels.OrderTaxLine{
+				Amount:        amount,
+				Country:       zone.Country,
+				Inclusive:     order.PricesIncludeTax,
+				Name:          *rate.Name,
+				OrderID:       order.ID,
+				ProductID:     productID,
+				Rate:          *rate.Rate,
+				Region:        zone.Region,
+				TaxClass:      taxClass,
+				TaxableAmount: netAmount,
+				ZoneID:        zone.ID,
+			}
+			result += line.Amount
+			order.TaxTotal += line.Amount
+			order.Taxes = append(order.Taxes, line)
+		}
+		return result
+	}
+
+	taxableAmounts := allocateDiscounts(order)
+	for i, product := range order.Products {
+		taxClass, ok := taxClasses[*product.ProductID]
+		if !ok || taxableAmounts[i] <= 0 {
+			continue
+		}
+		product.TaxAmount = addTaxLines(*product.ProductID, taxClass, taxableAmounts[i])
+	}
+	// shipping is taxed at the standard rates; its lines have no product
+	taxableShipping := order.ShippingPrice
+	for _, discount := range order.Discounts {
+		if discount.Kind == models.PromotionKindFreeShipping {
+			taxableShipping -= discount.Amount
+		}
+	}
+	if taxableShipping > 0 {
+		addTaxLines(0, defaultTaxClass, taxableShipping)
+	}
+
+	if len(order.Taxes) > 0 {
+		query := idb.NewInsert().Model(&order.Taxes).ExcludeColumn("id")
+		Logger.Debug("Built the query %s\n", query)
+		_, sqlErr = query.Exec(ctx)
+		if sqlErr != nil {
+			Logger.Error("ERROR %v: Could not add order %d tax lines!\n", sqlErr, order.ID)
+			return 0, errors.New(500, "ERROR: Could not add order %d taxes!", order.ID)
+		}
+	}
+	Logger.Debug("Order %d tax total: %d (inclusive: %t)", order.ID, order.TaxTotal, order.PricesIncludeTax)
+	return order.TaxTotal, nil
+}
+
+// orderTaxSummary sums up the order tax lines by tax name and rate, e. g., for the Stripe line items and invoices
+func orderTaxSummary(order *dbModels.Order) []*dbModels.OrderTaxLine {
+	result := make([]*dbModels.OrderTaxLine, 0)
+	for _, line := range order.Taxes {
+		var summary *dbModels.OrderTaxLine
+		for _, s := range result {
+			if s.Name == line.Name && s.Rate == line.Rate {
+				summary = s
+				break
+			}
+		}
+		if summary == nil {
+			summary = &dbModels.OrderTaxLine{
+				Country:   line.Country,
+				Inclusive: line.Inclusive,
+				Name:      line.Name,
+				OrderID:   line.OrderID,
+				Rate:      line.Rate,
+				Region:    line.Region,
+				TaxClass:  line.TaxClass,
+			}
+			result = append(result, summary)
+		}
+		summary.Amount += line.Amount
+		summary.TaxableAmount += line.TaxableAmount
+	}
+	return result
+}
+
+func getTaxReport(params *reports.GetTaxReportParams, principal *models.Principal) ([]*models.TaxReportLine, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]struct {
+		Country       string
+		Region        string
+		TaxClass      string
+		Name          string
+		Rate          float64
+		Inclusive     bool
+		Currency      string
+		TaxableAmount int64
+		TaxAmount     int64
+		OrdersCount   int64
+	}, 0)
+	// the amounts of the orders in different currencies are summed up separately
+	query := db.NewSelect().TableExpr("order_tax_lines AS tl").
+		Join("JOIN orders AS o ON o.id = tl.order_id").
+		ColumnExpr("tl.country, tl.region, tl.tax_class, tl.name, tl.rate, tl.inclusive, o.currency").
+		ColumnExpr("SUM(tl.taxable_amount_minor) AS taxable_amount, SUM(tl.amount_minor) AS tax_amount").
+		ColumnExpr("COUNT(DISTINCT tl.order_id) AS orders_count").
+		Where("o.status IN (?)", bun.In(taxReportStatuses)).
+		GroupExpr("tl.country, tl.region, tl.tax_class, tl.name, tl.rate, tl.inclusive, o.currency").
+		OrderExpr("tl.country, tl.region, tl.tax_class, tl.name, tl.rate, o.currency")
+	if params.DateFrom != nil {
+		query.Where("o.date_created >= ?", *params.DateFrom)
+	}
+	if params.DateTo != nil {
+		query.Where("o.date_created <= ?", *params.DateTo)
+	}
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context(), &rows)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not build tax report!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not build tax report!")
+	}
+
+	result := make([]*models.TaxReportLine, len(rows))
+	for i, row := range rows {
+		result[i] = &models.TaxReportLine{
+			Country:       row.Country,
+			Inclusive:     row.Inclusive,
+			Name:          row.Name,
+			OrdersCount:   row.OrdersCount,
+			Rate:          row.Rate,
+			Region:        row.Region,
+			TaxAmount:     dbModels.MoneyDTO(row.TaxAmount, row.Currency),
+			TaxClass:      row.TaxClass,
+			TaxableAmount: dbModels.MoneyDTO(row.TaxableAmount, row.Currency),
+		}
+	}
+	return result, nil
+}
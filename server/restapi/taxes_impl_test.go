@@ -0,0 +1,146 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+)
+
+func testOrderedProducts(totals ...int64) []*dbModels.OrderedProduct {
+	result := make([]*dbModels.OrderedProduct, len(totals))
+	for i, total := range totals {
+		result[i] = &dbModels.OrderedProduct{ProductID: swag.Int64(int64(i + 1)), Quantity: swag.Int64(1),
+			TotalPrice: total}
+	}
+	return result
+}
+
+func TestAllocateDiscounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		totals    []int64
+		discounts []*dbModels.OrderDiscount
+		want      []int64
+	}{
+		{"no discounts", []int64{1000, 2000}, nil, []int64{1000, 2000}},
+		{"order discount", []int64{1000, 2000, 1000},
+			[]*dbModels.OrderDiscount{{Kind: models.PromotionKindFixed, Amount: 100}},
+			[]int64{975, 1950, 975}},
+		{"order discount remainder", []int64{1000, 1000, 1000},
+			[]*dbModels.OrderDiscount{{Kind: models.PromotionKindFixed, Amount: 100}},
+			[]int64{966, 967, 967}},
+		{"product and order discounts", []int64{1000, 1000, 1000},
+			[]*dbModels.OrderDiscount{
+				{Kind: models.PromotionKindPercentage, ProductID: 2, Amount: 50},
+				{Kind: models.PromotionKindFixed, Amount: 100},
+			},
+			[]int64{966, 918, 966}},
+		{"discount of a product not ordered", []int64{1000, 1000},
+			[]*dbModels.OrderDiscount{{Kind: models.PromotionKindPercentage, ProductID: 3, Amount: 50}},
+			[]int64{1000, 1000}},
+		{"free shipping", []int64{1000, 1000},
+			[]*dbModels.OrderDiscount{{Kind: models.PromotionKindFreeShipping, Amount: 500}},
+			[]int64{1000, 1000}},
+	}
+	for _, tt := range tests {
+		order := &dbModels.Order{Products: testOrderedProducts(tt.totals...), Discounts: tt.discounts}
+		if got := allocateDiscounts(order); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: allocateDiscounts() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestApplyTaxes(t *testing.T) {
+	testDB := newTestDB(t, &dbModels.TaxZone{}, &dbModels.TaxRate{}, &dbModels.OrderTaxLine{})
+	ctx := context.Background()
+	newRate := func(name string, rate float64, taxClass string) *dbModels.TaxRate {
+		return &dbModels.TaxRate{Name: swag.String(name), Rate: swag.Float64(rate), TaxClass: taxClass}
+	}
+	zones := []*dbModels.TaxZone{
+		{Country: "DE", Name: swag.String("Germany"), Rates: []*dbModels.TaxRate{
+			newRate("VAT", 19, defaultTaxClass), newRate("VAT", 7, "reduced"), newRate("VAT", 0, "zero")}},
+		{Country: "CA", Region: "QC", Name: swag.String("Quebec"), Rates: []*dbModels.TaxRate{
+			newRate("GST", 5, defaultTaxClass), newRate("QST", 9.975, defaultTaxClass)}},
+	}
+	for _, zone := range zones {
+		if _, err := testDB.NewInsert().Model(zone).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if err := saveTaxRates(ctx, testDB, zone); err != nil {
+			t.Fatal(err)
+		}
+	}
+	actualProducts := []*dbModels.Product{{ID: 1}, {ID: 2, TaxClass: " Reduced"}, {ID: 3, TaxClass: "zero"}}
+	t.Cleanup(func() { ApiConfiguration.Taxes.PricesIncludeTax = false })
+
+	tests := []struct {
+		name             string
+		country, region  string
+		pricesIncludeTax bool
+		totals           []int64
+		discounts        []*dbModels.OrderDiscount
+		shippingPrice    int64
+		wantProductTaxes []int64
+		wantTaxTotal     int64
+	}{
+		{"exclusive", "DE", "", false, []int64{1000, 2000, 1000}, nil, 500,
+			[]int64{190, 140, 0}, 425},
+		{"inclusive", "DE", "", true, []int64{1000, 2000, 1000}, nil, 500,
+			[]int64{160, 131, 0}, 371},
+		{"exclusive with discounts", "DE", "", false, []int64{1000, 1000, 1000},
+			[]*dbModels.OrderDiscount{
+				{Kind: models.PromotionKindFixed, Amount: 100},
+				{Kind: models.PromotionKindFreeShipping, Amount: 500},
+			}, 500,
+			[]int64{184, 68, 0}, 252},
+		{"inclusive with discounts", "DE", "", true, []int64{1000, 1000, 1000},
+			[]*dbModels.OrderDiscount{{Kind: models.PromotionKindFixed, Amount: 100}}, 0,
+			[]int64{154, 63, 0}, 217},
+		{"exclusive compound rates", "CA", "QC", false, []int64{1000, 2000}, nil, 0,
+			[]int64{150, 0}, 150},
+		{"inclusive compound rates", "ca", "qc", true, []int64{1000}, nil, 0,
+			[]int64{130}, 130},
+		{"no tax zone", "US", "", false, []int64{1000}, nil, 500,
+			[]int64{0}, 0},
+	}
+	for i, tt := range tests {
+		ApiConfiguration.Taxes.PricesIncludeTax = tt.pricesIncludeTax
+		order := &dbModels.Order{ID: int64(i + 1), DeliveryCountry: tt.country, DeliveryRegion: tt.region,
+			Products: testOrderedProducts(tt.totals...), Discounts: tt.discounts, ShippingPrice: tt.shippingPrice}
+		taxTotal, err := applyTaxes(ctx, testDB, order, actualProducts)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		if taxTotal != tt.wantTaxTotal || order.TaxTotal != tt.wantTaxTotal {
+			t.Errorf("%s: tax total = %d, want %d", tt.name, taxTotal, tt.wantTaxTotal)
+		}
+		productTaxes := make([]int64, len(order.Products))
+		for j, product := range order.Products {
+			productTaxes[j] = product.TaxAmount
+		}
+		if !reflect.DeepEqual(productTaxes, tt.wantProductTaxes) {
+			t.Errorf("%s: product taxes = %v, want %v", tt.name, productTaxes, tt.wantProductTaxes)
+		}
+		var linesTotal int64 = 0
+		for _, line := range order.Taxes {
+			linesTotal += line.Amount
+			if line.Inclusive != tt.pricesIncludeTax {
+				t.Errorf("%s: tax line %s inclusive = %t", tt.name, line.Name, line.Inclusive)
+			}
+		}
+		if linesTotal != tt.wantTaxTotal {
+			t.Errorf("%s: tax lines add up to %d, want %d", tt.name, linesTotal, tt.wantTaxTotal)
+		}
+		saved, sqlErr := testDB.NewSelect().Model((*dbModels.OrderTaxLine)(nil)).Where("order_id = ?", order.ID).Count(ctx)
+		if sqlErr != nil {
+			t.Fatal(sqlErr)
+		}
+		if saved != len(order.Taxes) {
+			t.Errorf("%s: %d tax lines saved, want %d", tt.name, saved, len(order.Taxes))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package restapi
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-openapi/errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	tokenSecret     []byte
+	tokenSecretOnce sync.Once
+)
+
+// getTokenSecret returns the secret signing the tokens issued by the API (e. g., anonymous cart tokens).
+// If the secret is not configured, a random one is generated once, so issued tokens do not survive restarts.
+func getTokenSecret() []byte {
+	tokenSecretOnce.Do(func() {
+		if ApiConfiguration.TokenSecret != "" {
+			tokenSecret = []byte(ApiConfiguration.TokenSecret)
+			return
+		}
+		Logger.Info("TokenSecret is not configured; generating a random one, issued tokens will not survive restart")
+		tokenSecret = make([]byte, 32)
+		if _, err := rand.Read(tokenSecret); err != nil {
+			panic(fmt.Sprintf("Error: %s\nCould not generate token secret", err.Error()))
+		}
+	})
+	return tokenSecret
+}
+
+func tokenSignature(kind string, id int64, expires int64) string {
+	mac := hmac.New(sha256.New, getTokenSecret())
+	mac.Write([]byte(fmt.Sprintf("%s:%d:%d", kind, id, expires)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signToken issues a token of the given kind for the entity ID valid for the given time in form of
+// "<id>.<expiry Unix time>.<signature>"
+func signToken(kind string, id int64, ttl time.Duration) string {
+	expires := time.Now().In(time.UTC).Add(ttl).Unix()
+	return fmt.Sprintf("%d.%d.%s", id, expires, tokenSignature(kind, id, expires))
+}
+
+// parseSignedToken checks the token of the given kind and returns the entity ID it was issued for
+func parseSignedToken(kind string, token string) (int64, errors.Error) {
+	parts := strings.SplitN(token, ".", 3)
+	if len(parts) != 3 {
+		return 0, errors.New(400, "Malformed %s token!", kind)
+	}
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || id < 1 {
+		return 0, errors.New(400, "Malformed %s token!", kind)
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, errors.New(400, "Malformed %s token!", kind)
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(tokenSignature(kind, id, expires))) {
+		return 0, errors.New(403, "Invalid %s token!", kind)
+	}
+	if expires < time.Now().In(time.UTC).Unix() {
+		return 0, errors.New(403, "The %s token has expired!", kind)
+	}
+	return id, nil
+}
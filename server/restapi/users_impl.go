@@ -2,6 +2,7 @@ package restapi
 
 import (
 	"context"
+	"database/sql"
 	dbModels "estore-backend/server/database/models"
 	"estore-backend/server/models"
 	"estore-backend/server/restapi/operations/user"
@@ -27,12 +28,13 @@ func addDBUser(item *models.User) errors.Error {
 	nowUnixEpoch := time.Now().In(time.UTC).Unix()
 	dbModel.DateCreated = nowUnixEpoch
 	dbModel.DateUpdated = nowUnixEpoch
+	dbModel.Version = 1
 	query := db.NewInsert().Model(dbModel).ExcludeColumn("id")
 	Logger.Debug("Built the query %s\n", query)
 
 	res, err := query.Exec(context.Background())
 	if err != nil {
-		return errors.New(500, "ERROR %v: Could not add user %s!\n", err, item)
+		return errors.New(500, "ERROR %v: Could not add user %v!\n", err, item)
 	}
 	if id, err := res.LastInsertId(); err != nil {
 		item.ID = id
@@ -40,30 +42,42 @@ func addDBUser(item *models.User) errors.Error {
 	return nil
 }
 
-func updateUser(params *user.EditUserParams, principal *models.Principal) errors.Error {
+// updateUser replaces the user if it has not been modified since the version the If-Match header refers to;
+// the new version is returned
+func updateUser(params *user.EditUserParams, principal *models.Principal) (int64, errors.Error) {
 	Logger.Debug("\nupdateUser: request params: %s\nID:%d\nprincipal: %s\n", params.HTTPRequest, params.ID, principal)
 	err := isPrincipalOwnerOrAdmin(principal, params.ID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	item := params.Body
 	if item == nil {
-		return errors.New(500, "Empty user!")
+		return 0, errors.New(500, "Empty user!")
 	}
 	dbModel := dbModels.NewUserFrom(item)
 	nowUnixEpoch := time.Now().In(time.UTC).Unix()
 	dbModel.DateUpdated = nowUnixEpoch
-	query := db.NewUpdate().Where("id = ?", params.ID).
-		Model(dbModel).ExcludeColumn("id").ExcludeColumn("date_created")
-	Logger.Debug("Built the query %s\n", query)
-
-	_, sqlErr := query.Exec(context.Background())
+	err = runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		version, err := checkIfMatch(ctx, tx, "users", params.ID, params.IfMatch)
+		if err != nil {
+			return err
+		}
+		dbModel.Version = version + 1
+		query := tx.NewUpdate().Where("id = ?", params.ID).Where("COALESCE(version, 0) = ?", version).
+			Model(dbModel).ExcludeColumn("id").ExcludeColumn("date_created")
+		Logger.Debug("Built the query %s\n", query)
+
+		res, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			return errors.New(500, "ERROR %v: Could not update user %d!\n", sqlErr, params.ID)
+		}
+		return checkVersionedUpdate(res, "users", params.ID)
+	})
 	if err != nil {
-		return errors.New(500, "ERROR %v: Could not update user %d!\n", sqlErr, params.ID)
+		return 0, err
 	}
-
-	return nil
+	return dbModel.Version, nil
 }
 
 func deleteUser(params *user.DeleteUserParams, principal *models.Principal) errors.Error {
@@ -73,22 +87,60 @@ func deleteUser(params *user.DeleteUserParams, principal *models.Principal) erro
 		return err
 	}
 
-	query := db.NewDelete().TableExpr("users").Where("id = ?", params.ID)
-	Logger.Debug("Built the query %s\n", query)
+	return runInTx(params.HTTPRequest.Context(), func(ctx context.Context, tx bun.Tx) errors.Error {
+		query := tx.NewDelete().TableExpr("addresses").Where("user_id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
 
-	_, sqlErr := query.Exec(context.Background())
-	if sqlErr != nil {
-		return errors.New(500, "ERROR %v: Could not delete user %d!\n", sqlErr, params.ID)
+		_, sqlErr := query.Exec(ctx)
+		if sqlErr != nil {
+			return errors.New(500, "ERROR %v: Could not delete user %d addresses!\n", sqlErr, params.ID)
+		}
+
+		query = tx.NewDelete().TableExpr("users").Where("id = ?", params.ID)
+		Logger.Debug("Built the query %s\n", query)
+
+		_, sqlErr = query.Exec(ctx)
+		if sqlErr != nil {
+			return errors.New(500, "ERROR %v: Could not delete user %d!\n", sqlErr, params.ID)
+		}
+		return nil
+	})
+}
+
+// patchUser applies the merge patch to the user; the new version is returned
+func patchUser(params *user.PatchUserParams, principal *models.Principal) (*models.User, int64, errors.Error) {
+	current, version, err := getUser(&user.GetUserParams{HTTPRequest: params.HTTPRequest, ID: params.ID}, principal)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = checkPatchPrecondition("users", params.ID, params.IfMatch, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	item := new(models.User)
+	err = applyMergePatch("user", current, params.Body, item)
+	if err != nil {
+		return nil, 0, err
 	}
+	item.ID = params.ID
 
-	return nil
+	version, err = updateUser(&user.EditUserParams{
+		HTTPRequest: params.HTTPRequest,
+		ID:          params.ID,
+		IfMatch:     entityETag(version),
+		Body:        item,
+	}, principal)
+	if err != nil {
+		return nil, 0, err
+	}
+	return item, version, nil
 }
 
-func getUser(params *user.GetUserParams, principal *models.Principal) (result *models.User, err errors.Error) {
+func getUser(params *user.GetUserParams, principal *models.Principal) (result *models.User, version int64, err errors.Error) {
 	Logger.Debug("\ngetUser: request params: %s\nID:%d\nprincipal: %s\n", params.HTTPRequest, params.ID, principal)
 	err = isPrincipalOwnerOrAdmin(principal, params.ID)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	dbModel := new(dbModels.User)
@@ -98,11 +150,14 @@ func getUser(params *user.GetUserParams, principal *models.Principal) (result *m
 
 	sqlErr := query.Scan(context.Background())
 	if sqlErr != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find user %d!\n", sqlErr, params.ID)
+		if sqlErr == sql.ErrNoRows {
+			return nil, 0, errors.New(404, "Could not find user %d!", params.ID)
+		}
+		return nil, 0, errors.New(500, "ERROR %v: Could not find user %d!\n", sqlErr, params.ID)
 	}
 
 	result = dbModel.ToDTO()
-	return result, nil
+	return result, dbModel.Version, nil
 }
 
 func getOwnUserInfo(params *user.GetOwnUserParams, principal *models.Principal) (result *models.User, err errors.Error) {
@@ -130,7 +185,7 @@ func getUserByEmail(email string) (result *models.User, err errors.Error) {
 
 	sqlErr := query.Scan(context.Background())
 	if sqlErr != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find user %d!\n", sqlErr, email)
+		return nil, errors.New(500, "ERROR %v: Could not find user %s!\n", sqlErr, email)
 	}
 
 	result = dbModel.ToDTO()
@@ -156,7 +211,7 @@ func allUsers(params *users.ListUsersParams, principal *models.Principal) (resul
 
 	sqlErr := query.Scan(context.Background())
 	if sqlErr != nil {
-		return nil, errors.New(500, "ERROR %v: Could not find users matching %s!\n", sqlErr, params)
+		return nil, errors.New(500, "ERROR %v: Could not find users matching %v!\n", sqlErr, params)
 	}
 
 	result = make([]*models.User, len(dbModel))
@@ -1,9 +1,11 @@
 package restapi
 
 import (
+	"context"
 	"estore-backend/server/models"
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/uptrace/bun"
 	"net/http"
 )
 
@@ -29,6 +31,18 @@ func isPrincipalAdmin(principal *models.Principal) (bool, errors.Error) {
 	return false, nil
 }
 
+// checkPrincipalIsAdmin rejects non-admin users with 403
+func checkPrincipalIsAdmin(principal *models.Principal) errors.Error {
+	isAdmin, err := isPrincipalAdmin(principal)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return errors.New(403, "Only admins are allowed to perform this operation!")
+	}
+	return nil
+}
+
 func isContextPrincipalOwnerOrAdmin(request *http.Request, id int64) errors.Error {
 	currentUserInfo := middleware.SecurityPrincipalFrom(request)
 	Logger.Debug("\nisContextPrincipalOwnerOrAdmin: interface principal from context: %s\n", currentUserInfo)
@@ -45,3 +59,24 @@ func isContextPrincipalOwnerOrAdmin(request *http.Request, id int64) errors.Erro
 	}
 	return nil
 }
+
+// runInTx runs f in a DB transaction which is committed if f succeeds and rolled back otherwise,
+// so that multistep writes never leave half-written rows
+func runInTx(ctx context.Context, f func(ctx context.Context, tx bun.Tx) errors.Error) errors.Error {
+	var fErr errors.Error
+	txErr := db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		fErr = f(ctx, tx)
+		if fErr != nil {
+			return fErr
+		}
+		return nil
+	})
+	if fErr != nil {
+		return fErr
+	}
+	if txErr != nil {
+		Logger.Error("ERROR %v: Could not run DB transaction!\n", txErr)
+		return errors.New(500, "ERROR: Could not save changes!")
+	}
+	return nil
+}
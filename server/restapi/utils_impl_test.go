@@ -0,0 +1,38 @@
+package restapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/errors"
+	"github.com/uptrace/bun"
+)
+
+func TestRunInTx(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      errors.Error
+		wantRows int
+	}{
+		{"committed", nil, 1},
+		{"rolled back", errors.New(409, "Conflict"), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestStore(t)
+			err := runInTx(context.Background(), func(ctx context.Context, tx bun.Tx) errors.Error {
+				if _, sqlErr := tx.NewRaw("INSERT INTO categories (title, description) VALUES ('Books', 'Books')").
+					Exec(ctx); sqlErr != nil {
+					return errors.New(500, sqlErr.Error())
+				}
+				return tt.err
+			})
+			if err != tt.err {
+				t.Errorf("runInTx() = %v, want %v", err, tt.err)
+			}
+			if n := countTestRows(t, "categories", "1 = 1"); n != tt.wantRows {
+				t.Errorf("categories = %d, want %d", n, tt.wantRows)
+			}
+		})
+	}
+}
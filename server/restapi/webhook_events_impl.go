@@ -0,0 +1,335 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/webhooks"
+	"github.com/go-openapi/errors"
+	"time"
+)
+
+const (
+	defaultWebhookMaxAttempts  = 8
+	defaultWebhookRetryDelay   = 30
+	defaultWebhookPollInterval = 10
+	// the backoff of the retries doubles with every attempt up to an hour
+	maxWebhookRetryDelay = 60 * 60
+	// events claimed by the worker at once
+	webhookEventsBatchSize = 24
+	// seconds a claimed event is left to the worker processing it before it is considered interrupted
+	webhookEventLeaseSeconds = 10 * 60
+)
+
+// webhookWorkerWake wakes the worker up when an event is stored, so it is processed without waiting for the poll
+var webhookWorkerWake = make(chan struct{}, 1)
+
+func webhookMaxAttempts() int64 {
+	if ApiConfiguration.Payments.Webhooks.MaxAttempts > 0 {
+		return ApiConfiguration.Payments.Webhooks.MaxAttempts
+	}
+	return defaultWebhookMaxAttempts
+}
+
+// webhookRetryDelay returns the delay in seconds before the next attempt after the failed ones
+func webhookRetryDelay(attempts int64) int64 {
+	delay := ApiConfiguration.Payments.Webhooks.RetryDelay
+	if delay <= 0 {
+		delay = defaultWebhookRetryDelay
+	}
+	for i := int64(1); i < attempts && delay < maxWebhookRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxWebhookRetryDelay {
+		delay = maxWebhookRetryDelay
+	}
+	return delay
+}
+
+func wakeWebhookWorker() {
+	select {
+	case webhookWorkerWake <- struct{}{}:
+	default:
+	}
+}
+
+// storeWebhookEvent stores the verified event to be processed by the worker; the event
+// is stored once per gateway event ID, so the repeated deliveries are ignored
+func storeWebhookEvent(ctx context.Context, gatewayName string, event *PaymentEvent, payload []byte) errors.Error {
+	if event.ID == "" {
+		return errors.New(400, "The %s event has no ID", gatewayName)
+	}
+	eventJSON, jsonErr := json.Marshal(event)
+	if jsonErr != nil {
+		Logger.Error("ERROR %v: Could not serialize %s event %s!\n", jsonErr, gatewayName, event.ID)
+		return errors.New(500, "ERROR: Could not store %s event %s!", gatewayName, event.ID)
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel := &dbModels.WebhookEvent{
+		DateCreated:   nowUnixEpoch,
+		DateUpdated:   nowUnixEpoch,
+		Event:         string(eventJSON),
+		EventID:       event.ID,
+		Gateway:       gatewayName,
+		NextAttemptAt: nowUnixEpoch,
+		Payload:       string(payload),
+		Status:        models.WebhookEventStatusPending,
+		Type:          event.Type,
+	}
+	query := db.NewInsert().Model(dbModel).ExcludeColumn("id").On("CONFLICT DO NOTHING")
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not store %s event %s!\n", sqlErr, gatewayName, event.ID)
+		return errors.New(500, "ERROR: Could not store %s event %s!", gatewayName, event.ID)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		Logger.Info("Ignoring the repeated delivery of %s event %s", gatewayName, event.ID)
+		return nil
+	}
+	Logger.Debug("Stored %s event %s of type %s", gatewayName, event.ID, event.Type)
+	wakeWebhookWorker()
+	return nil
+}
+
+// startWebhookWorker processes the stored webhook events in background; the events
+// left processing by a previous run of the server are processed again once their lease expires
+func startWebhookWorker() {
+	resetInterruptedWebhookEvents(context.Background())
+	interval := ApiConfiguration.Payments.Webhooks.PollInterval
+	if interval <= 0 {
+		interval = defaultWebhookPollInterval
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for {
+			processDueWebhookEvents(context.Background())
+			select {
+			case <-ticker.C:
+			case <-webhookWorkerWake:
+			}
+		}
+	}()
+}
+
+// resetInterruptedWebhookEvents makes the processing events pending again if their lease has expired;
+// the events with a lease are still being processed by another instance of the server
+func resetInterruptedWebhookEvents(ctx context.Context) {
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	query := db.NewUpdate().Model((*dbModels.WebhookEvent)(nil)).
+		Set("status = ?", models.WebhookEventStatusPending).
+		Set("date_updated = ?", nowUnixEpoch).
+		Where("status = ?", models.WebhookEventStatusProcessing).
+		Where("COALESCE(locked_until, 0) < ?", nowUnixEpoch)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not reset the interrupted webhook events!\n", sqlErr)
+	}
+}
+
+// processDueWebhookEvents processes the pending events and the failed ones due for a retry, oldest first
+func processDueWebhookEvents(ctx context.Context) {
+	for {
+		dbEvents := make([]*dbModels.WebhookEvent, 0)
+		query := db.NewSelect().Model(&dbEvents).
+			Where("status IN (?, ?)", models.WebhookEventStatusPending, models.WebhookEventStatusFailed).
+			Where("next_attempt_at <= ?", time.Now().In(time.UTC).Unix()).
+			Order("next_attempt_at ASC", "id ASC").
+			Limit(webhookEventsBatchSize)
+		Logger.Debug("Built the query %s\n", query)
+		if sqlErr := query.Scan(ctx); sqlErr != nil {
+			Logger.Error("ERROR %v: Could not find the due webhook events!\n", sqlErr)
+			return
+		}
+		for _, dbModel := range dbEvents {
+			if claimWebhookEvent(ctx, dbModel) {
+				processWebhookEvent(ctx, dbModel)
+			}
+		}
+		if len(dbEvents) < webhookEventsBatchSize {
+			return
+		}
+	}
+}
+
+// claimWebhookEvent marks the event processing with a lease unless it has been claimed or replayed meanwhile
+func claimWebhookEvent(ctx context.Context, dbModel *dbModels.WebhookEvent) bool {
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	query := db.NewUpdate().Model((*dbModels.WebhookEvent)(nil)).
+		Set("status = ?", models.WebhookEventStatusProcessing).
+		Set("locked_until = ?", nowUnixEpoch+webhookEventLeaseSeconds).
+		Set("date_updated = ?", nowUnixEpoch).
+		Where("id = ?", dbModel.ID).
+		Where("status = ?", dbModel.Status).
+		Where("attempts = ?", dbModel.Attempts)
+	Logger.Debug("Built the query %s\n", query)
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not claim webhook event %d!\n", sqlErr, dbModel.ID)
+		return false
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return false
+	}
+	dbModel.Status = models.WebhookEventStatusProcessing
+	dbModel.DateUpdated = nowUnixEpoch
+	return true
+}
+
+// processWebhookEvent handles the claimed event and records the outcome; the event failing
+// the configured number of attempts is dead and is processed again only when replayed
+func processWebhookEvent(ctx context.Context, dbModel *dbModels.WebhookEvent) {
+	err := handleWebhookEvent(dbModel)
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	dbModel.Attempts++
+	dbModel.DateUpdated = nowUnixEpoch
+	if err == nil {
+		dbModel.Status = models.WebhookEventStatusProcessed
+		dbModel.DateProcessed = nowUnixEpoch
+		dbModel.LastError = ""
+	} else {
+		dbModel.LastError = err.Error()
+		if dbModel.Attempts >= webhookMaxAttempts() {
+			dbModel.Status = models.WebhookEventStatusDead
+			Logger.Error("Webhook event %d (%s event %s) is dead after %d attempts: %s", dbModel.ID,
+				dbModel.Gateway, dbModel.EventID, dbModel.Attempts, dbModel.LastError)
+		} else {
+			dbModel.Status = models.WebhookEventStatusFailed
+			dbModel.NextAttemptAt = nowUnixEpoch + webhookRetryDelay(dbModel.Attempts)
+			Logger.Info("Webhook event %d (%s event %s) attempt %d failed, retrying at %d: %s", dbModel.ID,
+				dbModel.Gateway, dbModel.EventID, dbModel.Attempts, dbModel.NextAttemptAt, dbModel.LastError)
+		}
+	}
+	query := db.NewUpdate().Model(dbModel).
+		Column("status", "attempts", "last_error", "next_attempt_at", "date_processed", "date_updated").
+		Where("id = ?", dbModel.ID).
+		Where("status = ?", models.WebhookEventStatusProcessing)
+	Logger.Debug("Built the query %s\n", query)
+	if _, sqlErr := query.Exec(ctx); sqlErr != nil {
+		Logger.Error("ERROR %v: Could not update webhook event %d!\n", sqlErr, dbModel.ID)
+	}
+}
+
+func handleWebhookEvent(dbModel *dbModels.WebhookEvent) (err errors.Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			Logger.Error("Processing webhook event %d panicked: %v", dbModel.ID, r)
+			err = errors.New(500, "Processing the event panicked: %v", r)
+		}
+	}()
+	gateway := getPaymentGateway(dbModel.Gateway)
+	if gateway == nil {
+		return errors.New(503, "Payment gateway %s is not available!", dbModel.Gateway)
+	}
+	event := new(PaymentEvent)
+	if jsonErr := json.Unmarshal([]byte(dbModel.Event), event); jsonErr != nil {
+		return errors.New(500, "Could not parse the stored event: %s", jsonErr.Error())
+	}
+	return handlePaymentEvent(gateway, event)
+}
+
+func allWebhookEvents(params *webhooks.ListWebhookEventsParams, principal *models.Principal) ([]*models.WebhookEvent, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbEvents := make([]*dbModels.WebhookEvent, 0)
+	query := db.NewSelect().Model(&dbEvents).ExcludeColumn("payload", "event")
+	if params.Status != nil {
+		query.Where("status = ?", *params.Status)
+	}
+	if params.Gateway != nil {
+		query.Where("gateway = ?", normalizePaymentGatewayName(*params.Gateway))
+	}
+	if params.Type != nil {
+		query.Where("type = ?", *params.Type)
+	}
+	if params.Limit != nil {
+		query.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		query.Offset(int(*params.Offset))
+	}
+	query.Order("id DESC")
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(params.HTTPRequest.Context())
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not find webhook events!\n", sqlErr)
+		return nil, errors.New(500, "ERROR: Could not find webhook events!")
+	}
+	return dbModels.WebhookEventDTOsFromWebhookEvents(dbEvents), nil
+}
+
+func getDBWebhookEvent(ctx context.Context, id int64) (*dbModels.WebhookEvent, errors.Error) {
+	dbModel := new(dbModels.WebhookEvent)
+	query := db.NewSelect().Model(dbModel).Where("id = ?", id)
+	Logger.Debug("Built the query %s\n", query)
+
+	sqlErr := query.Scan(ctx)
+	if sqlErr != nil {
+		if sqlErr == sql.ErrNoRows {
+			return nil, errors.New(404, "Could not find webhook event %d!", id)
+		}
+		Logger.Error("ERROR %v: Could not find webhook event %d!\n", sqlErr, id)
+		return nil, errors.New(500, "ERROR: Could not find webhook event %d!", id)
+	}
+	return dbModel, nil
+}
+
+func getWebhookEvent(params *webhooks.GetWebhookEventParams, principal *models.Principal) (*models.WebhookEvent, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	dbModel, err := getDBWebhookEvent(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(true), nil
+}
+
+// replayWebhookEvent schedules the event to be processed again with its attempts reset;
+// the handlers tolerate being run again, so the processed events may be replayed as well
+func replayWebhookEvent(params *webhooks.ReplayWebhookEventParams, principal *models.Principal) (*models.WebhookEvent, errors.Error) {
+	err := checkPrincipalIsAdmin(principal)
+	if err != nil {
+		return nil, err
+	}
+	ctx := params.HTTPRequest.Context()
+	dbModel, err := getDBWebhookEvent(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	nowUnixEpoch := time.Now().In(time.UTC).Unix()
+	query := db.NewUpdate().Model((*dbModels.WebhookEvent)(nil)).
+		Set("status = ?", models.WebhookEventStatusPending).
+		Set("attempts = 0").
+		Set("next_attempt_at = ?", nowUnixEpoch).
+		Set("date_updated = ?", nowUnixEpoch).
+		Where("id = ?", dbModel.ID).
+		Where("(status != ? OR COALESCE(locked_until, 0) < ?)", models.WebhookEventStatusProcessing, nowUnixEpoch)
+	Logger.Debug("Built the query %s\n", query)
+
+	res, sqlErr := query.Exec(ctx)
+	if sqlErr != nil {
+		Logger.Error("ERROR %v: Could not replay webhook event %d!\n", sqlErr, dbModel.ID)
+		return nil, errors.New(500, "ERROR: Could not replay webhook event %d!", dbModel.ID)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, errors.New(409, "Webhook event %d is being processed", dbModel.ID)
+	}
+	Logger.Info("Replaying webhook event %d (%s event %s) after %d attempts", dbModel.ID, dbModel.Gateway,
+		dbModel.EventID, dbModel.Attempts)
+	wakeWebhookWorker()
+
+	dbModel, err = getDBWebhookEvent(ctx, dbModel.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dbModel.ToDTO(false), nil
+}
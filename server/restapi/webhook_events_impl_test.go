@@ -0,0 +1,248 @@
+package restapi
+
+import (
+	"context"
+	dbModels "estore-backend/server/database/models"
+	"estore-backend/server/models"
+	"estore-backend/server/restapi/operations/webhooks"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// addTestWebhookEvent adds the event of the test gateway in the status, leased until the given time if it is set
+func addTestWebhookEvent(t *testing.T, status string, lockedUntil int64) *dbModels.WebhookEvent {
+	ctx := context.Background()
+	now := time.Now().Unix()
+	dbModel := &dbModels.WebhookEvent{DateCreated: now, DateUpdated: now, Event: "{}", Gateway: testGatewayName,
+		NextAttemptAt: now, Status: status, Type: paymentEventPaymentSucceeded}
+	dbModel.EventID = fmt.Sprintf("evt_%s_%d", status, time.Now().UnixNano())
+	if _, err := db.NewInsert().Model(dbModel).ExcludeColumn("id").Returning("id").Exec(ctx, &dbModel.ID); err != nil {
+		t.Fatal(err)
+	}
+	if lockedUntil != 0 {
+		if _, err := db.NewUpdate().TableExpr("webhook_events").Set("locked_until = ?", lockedUntil).
+			Where("id = ?", dbModel.ID).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dbModel
+}
+
+func TestResetInterruptedWebhookEvents(t *testing.T) {
+	newTestStore(t)
+	now := time.Now().Unix()
+	tests := []struct {
+		name        string
+		status      string
+		lockedUntil int64
+		want        string
+	}{
+		{"processing by another instance", models.WebhookEventStatusProcessing, now + 60, models.WebhookEventStatusProcessing},
+		{"lease expired", models.WebhookEventStatusProcessing, now - 1, models.WebhookEventStatusPending},
+		{"claimed before the leases", models.WebhookEventStatusProcessing, 0, models.WebhookEventStatusPending},
+		{"failed", models.WebhookEventStatusFailed, 0, models.WebhookEventStatusFailed},
+		{"processed", models.WebhookEventStatusProcessed, now - 1, models.WebhookEventStatusProcessed},
+	}
+	events := make([]*dbModels.WebhookEvent, len(tests))
+	for i, tt := range tests {
+		events[i] = addTestWebhookEvent(t, tt.status, tt.lockedUntil)
+	}
+
+	resetInterruptedWebhookEvents(context.Background())
+	for i, tt := range tests {
+		dbModel, err := getDBWebhookEvent(context.Background(), events[i].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dbModel.Status != tt.want {
+			t.Errorf("%s: status = %s, want %s", tt.name, dbModel.Status, tt.want)
+		}
+	}
+}
+
+func TestClaimWebhookEvent(t *testing.T) {
+	newTestStore(t)
+	ctx := context.Background()
+	dbModel := addTestWebhookEvent(t, models.WebhookEventStatusPending, 0)
+	duplicate := *dbModel
+
+	if !claimWebhookEvent(ctx, dbModel) {
+		t.Fatal("claimWebhookEvent() of the pending event = false, want true")
+	}
+	if claimWebhookEvent(ctx, &duplicate) {
+		t.Error("claimWebhookEvent() of the event claimed already = true, want false")
+	}
+	if n := countTestRows(t, "webhook_events", "id = ? AND locked_until > ?", dbModel.ID, time.Now().Unix()); n != 1 {
+		t.Error("claimed event has no lease")
+	}
+
+	// the claimed event is not reset on startup, as it may be processed by another instance
+	resetInterruptedWebhookEvents(ctx)
+	if claimWebhookEvent(ctx, &duplicate) {
+		t.Error("claimWebhookEvent() of the leased event after the reset = true, want false")
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	configured := ApiConfiguration.Payments.Webhooks.RetryDelay
+	t.Cleanup(func() { ApiConfiguration.Payments.Webhooks.RetryDelay = configured })
+	tests := []struct {
+		name       string
+		retryDelay int64
+		attempts   int64
+		want       int64
+	}{
+		{"first retry", 0, 1, defaultWebhookRetryDelay},
+		{"second retry", 0, 2, 2 * defaultWebhookRetryDelay},
+		{"fourth retry", 0, 4, 8 * defaultWebhookRetryDelay},
+		{"capped", 0, 8, maxWebhookRetryDelay},
+		{"many attempts", 0, 1000, maxWebhookRetryDelay},
+		{"configured", 10, 3, 40},
+		{"configured over the cap", 2 * maxWebhookRetryDelay, 1, maxWebhookRetryDelay},
+	}
+	for _, tt := range tests {
+		ApiConfiguration.Payments.Webhooks.RetryDelay = tt.retryDelay
+		if got := webhookRetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("%s: webhookRetryDelay(%d) = %d, want %d", tt.name, tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestProcessPaymentEventOfDuplicateDelivery(t *testing.T) {
+	newTestStore(t)
+	gateway := newFakeGateway("secret", "", nil)
+	registerPaymentGateway(gateway)
+	t.Cleanup(func() { delete(paymentGateways, fakeGatewayName) })
+	payload := []byte(`{"id":"fake_evt_1","type":"payment.succeeded","paymentIntentId":"fake_pi_1"}`)
+
+	tests := []struct {
+		name      string
+		signature string
+		wantCode  int32
+	}{
+		{"delivery", gateway.Sign(payload), 0},
+		{"repeated delivery", gateway.Sign(payload), 0},
+		{"forged delivery", "forged", 400},
+	}
+	for _, tt := range tests {
+		header := make(http.Header)
+		header.Set("Fake-Signature", tt.signature)
+		err := processPaymentEvent(fakeGatewayName, payload, header)
+		if tt.wantCode == 0 && err != nil || tt.wantCode != 0 && (err == nil || err.Code() != tt.wantCode) {
+			t.Errorf("%s: processPaymentEvent() = %v, want %d", tt.name, err, tt.wantCode)
+		}
+		if n := countTestRows(t, "webhook_events", "gateway = ? AND event_id = ?", fakeGatewayName, "fake_evt_1"); n != 1 {
+			t.Errorf("%s: stored events = %d, want 1", tt.name, n)
+		}
+	}
+	if err := processPaymentEvent("unknown", payload, make(http.Header)); err == nil || err.Code() != 404 {
+		t.Errorf("processPaymentEvent() of an unknown gateway = %v, want 404", err)
+	}
+}
+
+func TestProcessWebhookEvent(t *testing.T) {
+	newTestStore(t)
+	registerTestGateway(t)
+	configured := ApiConfiguration.Payments.Webhooks
+	t.Cleanup(func() { ApiConfiguration.Payments.Webhooks = configured })
+	ApiConfiguration.Payments.Webhooks.MaxAttempts = 3
+	ApiConfiguration.Payments.Webhooks.RetryDelay = 30
+
+	tests := []struct {
+		name string
+		// the event of an unavailable gateway fails
+		gateway       string
+		attempts      int64
+		wantStatus    string
+		wantAttempts  int64
+		wantNextDelay int64
+	}{
+		{"processed", testGatewayName, 0, models.WebhookEventStatusProcessed, 1, 0},
+		{"processed on a retry", testGatewayName, 2, models.WebhookEventStatusProcessed, 3, 0},
+		{"first failure", "unknown", 0, models.WebhookEventStatusFailed, 1, 30},
+		{"second failure", "unknown", 1, models.WebhookEventStatusFailed, 2, 60},
+		{"last attempt", "unknown", 2, models.WebhookEventStatusDead, 3, 0},
+	}
+	for _, tt := range tests {
+		dbModel := addTestWebhookEvent(t, models.WebhookEventStatusPending, 0)
+		dbModel.Attempts = tt.attempts
+		if _, err := db.NewUpdate().Model(dbModel).Column("attempts").Where("id = ?", dbModel.ID).
+			Exec(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if !claimWebhookEvent(context.Background(), dbModel) {
+			t.Fatalf("%s: claimWebhookEvent() = false, want true", tt.name)
+		}
+		dbModel.Gateway = tt.gateway
+		before := time.Now().Unix()
+		processWebhookEvent(context.Background(), dbModel)
+
+		stored, err := getDBWebhookEvent(context.Background(), dbModel.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored.Status != tt.wantStatus || stored.Attempts != tt.wantAttempts {
+			t.Errorf("%s: event = %s after %d attempts, want %s after %d", tt.name, stored.Status, stored.Attempts,
+				tt.wantStatus, tt.wantAttempts)
+		}
+		if (stored.LastError == "") != (tt.gateway == testGatewayName) {
+			t.Errorf("%s: last error = %q", tt.name, stored.LastError)
+		}
+		if tt.wantNextDelay > 0 && (stored.NextAttemptAt < before+tt.wantNextDelay ||
+			stored.NextAttemptAt > time.Now().Unix()+tt.wantNextDelay) {
+			t.Errorf("%s: next attempt in %d seconds, want %d", tt.name, stored.NextAttemptAt-before, tt.wantNextDelay)
+		}
+	}
+}
+
+func TestReplayWebhookEvent(t *testing.T) {
+	newTestStore(t)
+	now := time.Now().Unix()
+	tests := []struct {
+		name        string
+		status      string
+		lockedUntil int64
+		wantCode    int32
+		wantStatus  string
+	}{
+		{"dead", models.WebhookEventStatusDead, 0, 0, models.WebhookEventStatusPending},
+		{"processed", models.WebhookEventStatusProcessed, now - 1, 0, models.WebhookEventStatusPending},
+		{"processing", models.WebhookEventStatusProcessing, now + 60, 409, models.WebhookEventStatusProcessing},
+		{"processing of an expired lease", models.WebhookEventStatusProcessing, now - 1, 0,
+			models.WebhookEventStatusPending},
+	}
+	for _, tt := range tests {
+		dbModel := addTestWebhookEvent(t, tt.status, tt.lockedUntil)
+		if _, err := db.NewUpdate().TableExpr("webhook_events").Set("attempts = 8").Where("id = ?", dbModel.ID).
+			Exec(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		replayed, err := replayWebhookEvent(&webhooks.ReplayWebhookEventParams{HTTPRequest: testRequest(),
+			ID: dbModel.ID}, testAdmin())
+		if tt.wantCode != 0 {
+			if err == nil || err.Code() != tt.wantCode {
+				t.Errorf("%s: replayWebhookEvent() = %v, want %d", tt.name, err, tt.wantCode)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		} else if replayed.Attempts != 0 {
+			t.Errorf("%s: replayed attempts = %d, want 0", tt.name, replayed.Attempts)
+		}
+		stored, err := getDBWebhookEvent(context.Background(), dbModel.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored.Status != tt.wantStatus {
+			t.Errorf("%s: status = %s, want %s", tt.name, stored.Status, tt.wantStatus)
+		}
+	}
+
+	dbModel := addTestWebhookEvent(t, models.WebhookEventStatusDead, 0)
+	_, err := replayWebhookEvent(&webhooks.ReplayWebhookEventParams{HTTPRequest: testRequest(), ID: dbModel.ID},
+		testCustomer(1))
+	if err == nil || err.Code() != 403 {
+		t.Errorf("replayWebhookEvent() by a customer = %v, want 403", err)
+	}
+}